@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/feedextras (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	feed "github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	feedextras "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/feedextras"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedextrasClient is a mock of Client interface.
+type MockFeedextrasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedextrasClientMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedextrasClientMockRecorder is the mock recorder for MockFeedextrasClient.
+type MockFeedextrasClientMockRecorder struct {
+	mock *MockFeedextrasClient
+}
+
+// NewMockFeedextrasClient creates a new mock instance.
+func NewMockFeedextrasClient(ctrl *gomock.Controller) *MockFeedextrasClient {
+	mock := &MockFeedextrasClient{ctrl: ctrl}
+	mock.recorder = &MockFeedextrasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedextrasClient) EXPECT() *MockFeedextrasClientMockRecorder {
+	return m.recorder
+}
+
+// GetFeed mocks base method.
+func (m *MockFeedextrasClient) GetFeed(arg0 context.Context, arg1 feed.GetFeedArgs) (*feedextras.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", arg0, arg1)
+	ret0, _ := ret[0].(*feedextras.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockFeedextrasClientMockRecorder) GetFeed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockFeedextrasClient)(nil).GetFeed), arg0, arg1)
+}
@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v7/maven (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	maven "github.com/microsoft/azure-devops-go-api/azuredevops/v7/maven"
+	packagingshared "github.com/microsoft/azure-devops-go-api/azuredevops/v7/packagingshared"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMavenClient is a mock of Client interface.
+type MockMavenClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMavenClientMockRecorder
+	isgomock struct{}
+}
+
+// MockMavenClientMockRecorder is the mock recorder for MockMavenClient.
+type MockMavenClientMockRecorder struct {
+	mock *MockMavenClient
+}
+
+// NewMockMavenClient creates a new mock instance.
+func NewMockMavenClient(ctrl *gomock.Controller) *MockMavenClient {
+	mock := &MockMavenClient{ctrl: ctrl}
+	mock.recorder = &MockMavenClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMavenClient) EXPECT() *MockMavenClientMockRecorder {
+	return m.recorder
+}
+
+// DeletePackageVersionFromRecycleBin mocks base method.
+func (m *MockMavenClient) DeletePackageVersionFromRecycleBin(arg0 context.Context, arg1 maven.DeletePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePackageVersionFromRecycleBin indicates an expected call of DeletePackageVersionFromRecycleBin.
+func (mr *MockMavenClientMockRecorder) DeletePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePackageVersionFromRecycleBin", reflect.TypeOf((*MockMavenClient)(nil).DeletePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// DownloadPackage mocks base method.
+func (m *MockMavenClient) DownloadPackage(arg0 context.Context, arg1 maven.DownloadPackageArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadPackage", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadPackage indicates an expected call of DownloadPackage.
+func (mr *MockMavenClientMockRecorder) DownloadPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPackage", reflect.TypeOf((*MockMavenClient)(nil).DownloadPackage), arg0, arg1)
+}
+
+// GetPackageVersion mocks base method.
+func (m *MockMavenClient) GetPackageVersion(arg0 context.Context, arg1 maven.GetPackageVersionArgs) (*maven.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(*maven.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersion indicates an expected call of GetPackageVersion.
+func (mr *MockMavenClientMockRecorder) GetPackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersion", reflect.TypeOf((*MockMavenClient)(nil).GetPackageVersion), arg0, arg1)
+}
+
+// GetPackageVersionMetadataFromRecycleBin mocks base method.
+func (m *MockMavenClient) GetPackageVersionMetadataFromRecycleBin(arg0 context.Context, arg1 maven.GetPackageVersionMetadataFromRecycleBinArgs) (*maven.MavenPackageVersionDeletionState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersionMetadataFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(*maven.MavenPackageVersionDeletionState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersionMetadataFromRecycleBin indicates an expected call of GetPackageVersionMetadataFromRecycleBin.
+func (mr *MockMavenClientMockRecorder) GetPackageVersionMetadataFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersionMetadataFromRecycleBin", reflect.TypeOf((*MockMavenClient)(nil).GetPackageVersionMetadataFromRecycleBin), arg0, arg1)
+}
+
+// GetUpstreamingBehavior mocks base method.
+func (m *MockMavenClient) GetUpstreamingBehavior(arg0 context.Context, arg1 maven.GetUpstreamingBehaviorArgs) (*packagingshared.UpstreamingBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(*packagingshared.UpstreamingBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpstreamingBehavior indicates an expected call of GetUpstreamingBehavior.
+func (mr *MockMavenClientMockRecorder) GetUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamingBehavior", reflect.TypeOf((*MockMavenClient)(nil).GetUpstreamingBehavior), arg0, arg1)
+}
+
+// PackageDelete mocks base method.
+func (m *MockMavenClient) PackageDelete(arg0 context.Context, arg1 maven.PackageDeleteArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PackageDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PackageDelete indicates an expected call of PackageDelete.
+func (mr *MockMavenClientMockRecorder) PackageDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PackageDelete", reflect.TypeOf((*MockMavenClient)(nil).PackageDelete), arg0, arg1)
+}
+
+// RestorePackageVersionFromRecycleBin mocks base method.
+func (m *MockMavenClient) RestorePackageVersionFromRecycleBin(arg0 context.Context, arg1 maven.RestorePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePackageVersionFromRecycleBin indicates an expected call of RestorePackageVersionFromRecycleBin.
+func (mr *MockMavenClientMockRecorder) RestorePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePackageVersionFromRecycleBin", reflect.TypeOf((*MockMavenClient)(nil).RestorePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// SetUpstreamingBehavior mocks base method.
+func (m *MockMavenClient) SetUpstreamingBehavior(arg0 context.Context, arg1 maven.SetUpstreamingBehaviorArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUpstreamingBehavior indicates an expected call of SetUpstreamingBehavior.
+func (mr *MockMavenClientMockRecorder) SetUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUpstreamingBehavior", reflect.TypeOf((*MockMavenClient)(nil).SetUpstreamingBehavior), arg0, arg1)
+}
+
+// UpdatePackageVersion mocks base method.
+func (m *MockMavenClient) UpdatePackageVersion(arg0 context.Context, arg1 maven.UpdatePackageVersionArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageVersion indicates an expected call of UpdatePackageVersion.
+func (mr *MockMavenClientMockRecorder) UpdatePackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageVersion", reflect.TypeOf((*MockMavenClient)(nil).UpdatePackageVersion), arg0, arg1)
+}
+
+// UpdatePackageVersions mocks base method.
+func (m *MockMavenClient) UpdatePackageVersions(arg0 context.Context, arg1 maven.UpdatePackageVersionsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageVersions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageVersions indicates an expected call of UpdatePackageVersions.
+func (mr *MockMavenClientMockRecorder) UpdatePackageVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageVersions", reflect.TypeOf((*MockMavenClient)(nil).UpdatePackageVersions), arg0, arg1)
+}
+
+// UpdateRecycleBinPackages mocks base method.
+func (m *MockMavenClient) UpdateRecycleBinPackages(arg0 context.Context, arg1 maven.UpdateRecycleBinPackagesArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecycleBinPackages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecycleBinPackages indicates an expected call of UpdateRecycleBinPackages.
+func (mr *MockMavenClientMockRecorder) UpdateRecycleBinPackages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecycleBinPackages", reflect.TypeOf((*MockMavenClient)(nil).UpdateRecycleBinPackages), arg0, arg1)
+}
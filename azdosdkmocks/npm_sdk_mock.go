@@ -0,0 +1,361 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	npm "github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm"
+	packagingshared "github.com/microsoft/azure-devops-go-api/azuredevops/v7/packagingshared"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNpmClient is a mock of Client interface.
+type MockNpmClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNpmClientMockRecorder
+	isgomock struct{}
+}
+
+// MockNpmClientMockRecorder is the mock recorder for MockNpmClient.
+type MockNpmClientMockRecorder struct {
+	mock *MockNpmClient
+}
+
+// NewMockNpmClient creates a new mock instance.
+func NewMockNpmClient(ctrl *gomock.Controller) *MockNpmClient {
+	mock := &MockNpmClient{ctrl: ctrl}
+	mock.recorder = &MockNpmClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNpmClient) EXPECT() *MockNpmClientMockRecorder {
+	return m.recorder
+}
+
+// DeletePackageVersionFromRecycleBin mocks base method.
+func (m *MockNpmClient) DeletePackageVersionFromRecycleBin(arg0 context.Context, arg1 npm.DeletePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePackageVersionFromRecycleBin indicates an expected call of DeletePackageVersionFromRecycleBin.
+func (mr *MockNpmClientMockRecorder) DeletePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePackageVersionFromRecycleBin", reflect.TypeOf((*MockNpmClient)(nil).DeletePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// DeleteScopedPackageVersionFromRecycleBin mocks base method.
+func (m *MockNpmClient) DeleteScopedPackageVersionFromRecycleBin(arg0 context.Context, arg1 npm.DeleteScopedPackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScopedPackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScopedPackageVersionFromRecycleBin indicates an expected call of DeleteScopedPackageVersionFromRecycleBin.
+func (mr *MockNpmClientMockRecorder) DeleteScopedPackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScopedPackageVersionFromRecycleBin", reflect.TypeOf((*MockNpmClient)(nil).DeleteScopedPackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// GetContentScopedPackage mocks base method.
+func (m *MockNpmClient) GetContentScopedPackage(arg0 context.Context, arg1 npm.GetContentScopedPackageArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContentScopedPackage", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContentScopedPackage indicates an expected call of GetContentScopedPackage.
+func (mr *MockNpmClientMockRecorder) GetContentScopedPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentScopedPackage", reflect.TypeOf((*MockNpmClient)(nil).GetContentScopedPackage), arg0, arg1)
+}
+
+// GetContentUnscopedPackage mocks base method.
+func (m *MockNpmClient) GetContentUnscopedPackage(arg0 context.Context, arg1 npm.GetContentUnscopedPackageArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContentUnscopedPackage", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContentUnscopedPackage indicates an expected call of GetContentUnscopedPackage.
+func (mr *MockNpmClientMockRecorder) GetContentUnscopedPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentUnscopedPackage", reflect.TypeOf((*MockNpmClient)(nil).GetContentUnscopedPackage), arg0, arg1)
+}
+
+// GetPackageInfo mocks base method.
+func (m *MockNpmClient) GetPackageInfo(arg0 context.Context, arg1 npm.GetPackageInfoArgs) (*npm.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageInfo", arg0, arg1)
+	ret0, _ := ret[0].(*npm.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageInfo indicates an expected call of GetPackageInfo.
+func (mr *MockNpmClientMockRecorder) GetPackageInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageInfo", reflect.TypeOf((*MockNpmClient)(nil).GetPackageInfo), arg0, arg1)
+}
+
+// GetPackageVersionMetadataFromRecycleBin mocks base method.
+func (m *MockNpmClient) GetPackageVersionMetadataFromRecycleBin(arg0 context.Context, arg1 npm.GetPackageVersionMetadataFromRecycleBinArgs) (*npm.NpmPackageVersionDeletionState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersionMetadataFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(*npm.NpmPackageVersionDeletionState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersionMetadataFromRecycleBin indicates an expected call of GetPackageVersionMetadataFromRecycleBin.
+func (mr *MockNpmClientMockRecorder) GetPackageVersionMetadataFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersionMetadataFromRecycleBin", reflect.TypeOf((*MockNpmClient)(nil).GetPackageVersionMetadataFromRecycleBin), arg0, arg1)
+}
+
+// GetReadmeScopedPackage mocks base method.
+func (m *MockNpmClient) GetReadmeScopedPackage(arg0 context.Context, arg1 npm.GetReadmeScopedPackageArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadmeScopedPackage", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadmeScopedPackage indicates an expected call of GetReadmeScopedPackage.
+func (mr *MockNpmClientMockRecorder) GetReadmeScopedPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadmeScopedPackage", reflect.TypeOf((*MockNpmClient)(nil).GetReadmeScopedPackage), arg0, arg1)
+}
+
+// GetReadmeUnscopedPackage mocks base method.
+func (m *MockNpmClient) GetReadmeUnscopedPackage(arg0 context.Context, arg1 npm.GetReadmeUnscopedPackageArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadmeUnscopedPackage", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadmeUnscopedPackage indicates an expected call of GetReadmeUnscopedPackage.
+func (mr *MockNpmClientMockRecorder) GetReadmeUnscopedPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadmeUnscopedPackage", reflect.TypeOf((*MockNpmClient)(nil).GetReadmeUnscopedPackage), arg0, arg1)
+}
+
+// GetScopedPackageInfo mocks base method.
+func (m *MockNpmClient) GetScopedPackageInfo(arg0 context.Context, arg1 npm.GetScopedPackageInfoArgs) (*npm.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScopedPackageInfo", arg0, arg1)
+	ret0, _ := ret[0].(*npm.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScopedPackageInfo indicates an expected call of GetScopedPackageInfo.
+func (mr *MockNpmClientMockRecorder) GetScopedPackageInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScopedPackageInfo", reflect.TypeOf((*MockNpmClient)(nil).GetScopedPackageInfo), arg0, arg1)
+}
+
+// GetScopedPackageVersionMetadataFromRecycleBin mocks base method.
+func (m *MockNpmClient) GetScopedPackageVersionMetadataFromRecycleBin(arg0 context.Context, arg1 npm.GetScopedPackageVersionMetadataFromRecycleBinArgs) (*npm.NpmPackageVersionDeletionState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScopedPackageVersionMetadataFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(*npm.NpmPackageVersionDeletionState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScopedPackageVersionMetadataFromRecycleBin indicates an expected call of GetScopedPackageVersionMetadataFromRecycleBin.
+func (mr *MockNpmClientMockRecorder) GetScopedPackageVersionMetadataFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScopedPackageVersionMetadataFromRecycleBin", reflect.TypeOf((*MockNpmClient)(nil).GetScopedPackageVersionMetadataFromRecycleBin), arg0, arg1)
+}
+
+// GetScopedUpstreamingBehavior mocks base method.
+func (m *MockNpmClient) GetScopedUpstreamingBehavior(arg0 context.Context, arg1 npm.GetScopedUpstreamingBehaviorArgs) (*packagingshared.UpstreamingBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScopedUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(*packagingshared.UpstreamingBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScopedUpstreamingBehavior indicates an expected call of GetScopedUpstreamingBehavior.
+func (mr *MockNpmClientMockRecorder) GetScopedUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScopedUpstreamingBehavior", reflect.TypeOf((*MockNpmClient)(nil).GetScopedUpstreamingBehavior), arg0, arg1)
+}
+
+// GetUpstreamingBehavior mocks base method.
+func (m *MockNpmClient) GetUpstreamingBehavior(arg0 context.Context, arg1 npm.GetUpstreamingBehaviorArgs) (*packagingshared.UpstreamingBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(*packagingshared.UpstreamingBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpstreamingBehavior indicates an expected call of GetUpstreamingBehavior.
+func (mr *MockNpmClientMockRecorder) GetUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamingBehavior", reflect.TypeOf((*MockNpmClient)(nil).GetUpstreamingBehavior), arg0, arg1)
+}
+
+// RestorePackageVersionFromRecycleBin mocks base method.
+func (m *MockNpmClient) RestorePackageVersionFromRecycleBin(arg0 context.Context, arg1 npm.RestorePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePackageVersionFromRecycleBin indicates an expected call of RestorePackageVersionFromRecycleBin.
+func (mr *MockNpmClientMockRecorder) RestorePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePackageVersionFromRecycleBin", reflect.TypeOf((*MockNpmClient)(nil).RestorePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// RestoreScopedPackageVersionFromRecycleBin mocks base method.
+func (m *MockNpmClient) RestoreScopedPackageVersionFromRecycleBin(arg0 context.Context, arg1 npm.RestoreScopedPackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreScopedPackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreScopedPackageVersionFromRecycleBin indicates an expected call of RestoreScopedPackageVersionFromRecycleBin.
+func (mr *MockNpmClientMockRecorder) RestoreScopedPackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreScopedPackageVersionFromRecycleBin", reflect.TypeOf((*MockNpmClient)(nil).RestoreScopedPackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// SetScopedUpstreamingBehavior mocks base method.
+func (m *MockNpmClient) SetScopedUpstreamingBehavior(arg0 context.Context, arg1 npm.SetScopedUpstreamingBehaviorArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScopedUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetScopedUpstreamingBehavior indicates an expected call of SetScopedUpstreamingBehavior.
+func (mr *MockNpmClientMockRecorder) SetScopedUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScopedUpstreamingBehavior", reflect.TypeOf((*MockNpmClient)(nil).SetScopedUpstreamingBehavior), arg0, arg1)
+}
+
+// SetUpstreamingBehavior mocks base method.
+func (m *MockNpmClient) SetUpstreamingBehavior(arg0 context.Context, arg1 npm.SetUpstreamingBehaviorArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUpstreamingBehavior indicates an expected call of SetUpstreamingBehavior.
+func (mr *MockNpmClientMockRecorder) SetUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUpstreamingBehavior", reflect.TypeOf((*MockNpmClient)(nil).SetUpstreamingBehavior), arg0, arg1)
+}
+
+// UnpublishPackage mocks base method.
+func (m *MockNpmClient) UnpublishPackage(arg0 context.Context, arg1 npm.UnpublishPackageArgs) (*npm.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishPackage", arg0, arg1)
+	ret0, _ := ret[0].(*npm.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpublishPackage indicates an expected call of UnpublishPackage.
+func (mr *MockNpmClientMockRecorder) UnpublishPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishPackage", reflect.TypeOf((*MockNpmClient)(nil).UnpublishPackage), arg0, arg1)
+}
+
+// UnpublishScopedPackage mocks base method.
+func (m *MockNpmClient) UnpublishScopedPackage(arg0 context.Context, arg1 npm.UnpublishScopedPackageArgs) (*npm.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishScopedPackage", arg0, arg1)
+	ret0, _ := ret[0].(*npm.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpublishScopedPackage indicates an expected call of UnpublishScopedPackage.
+func (mr *MockNpmClientMockRecorder) UnpublishScopedPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishScopedPackage", reflect.TypeOf((*MockNpmClient)(nil).UnpublishScopedPackage), arg0, arg1)
+}
+
+// UpdatePackage mocks base method.
+func (m *MockNpmClient) UpdatePackage(arg0 context.Context, arg1 npm.UpdatePackageArgs) (*npm.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackage", arg0, arg1)
+	ret0, _ := ret[0].(*npm.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePackage indicates an expected call of UpdatePackage.
+func (mr *MockNpmClientMockRecorder) UpdatePackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackage", reflect.TypeOf((*MockNpmClient)(nil).UpdatePackage), arg0, arg1)
+}
+
+// UpdatePackages mocks base method.
+func (m *MockNpmClient) UpdatePackages(arg0 context.Context, arg1 npm.UpdatePackagesArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackages indicates an expected call of UpdatePackages.
+func (mr *MockNpmClientMockRecorder) UpdatePackages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackages", reflect.TypeOf((*MockNpmClient)(nil).UpdatePackages), arg0, arg1)
+}
+
+// UpdateRecycleBinPackages mocks base method.
+func (m *MockNpmClient) UpdateRecycleBinPackages(arg0 context.Context, arg1 npm.UpdateRecycleBinPackagesArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecycleBinPackages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecycleBinPackages indicates an expected call of UpdateRecycleBinPackages.
+func (mr *MockNpmClientMockRecorder) UpdateRecycleBinPackages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecycleBinPackages", reflect.TypeOf((*MockNpmClient)(nil).UpdateRecycleBinPackages), arg0, arg1)
+}
+
+// UpdateScopedPackage mocks base method.
+func (m *MockNpmClient) UpdateScopedPackage(arg0 context.Context, arg1 npm.UpdateScopedPackageArgs) (*npm.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScopedPackage", arg0, arg1)
+	ret0, _ := ret[0].(*npm.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScopedPackage indicates an expected call of UpdateScopedPackage.
+func (mr *MockNpmClientMockRecorder) UpdateScopedPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScopedPackage", reflect.TypeOf((*MockNpmClient)(nil).UpdateScopedPackage), arg0, arg1)
+}
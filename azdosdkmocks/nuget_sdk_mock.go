@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	nuget "github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget"
+	packagingshared "github.com/microsoft/azure-devops-go-api/azuredevops/v7/packagingshared"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNugetClient is a mock of Client interface.
+type MockNugetClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNugetClientMockRecorder
+	isgomock struct{}
+}
+
+// MockNugetClientMockRecorder is the mock recorder for MockNugetClient.
+type MockNugetClientMockRecorder struct {
+	mock *MockNugetClient
+}
+
+// NewMockNugetClient creates a new mock instance.
+func NewMockNugetClient(ctrl *gomock.Controller) *MockNugetClient {
+	mock := &MockNugetClient{ctrl: ctrl}
+	mock.recorder = &MockNugetClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNugetClient) EXPECT() *MockNugetClientMockRecorder {
+	return m.recorder
+}
+
+// DeletePackageVersion mocks base method.
+func (m *MockNugetClient) DeletePackageVersion(arg0 context.Context, arg1 nuget.DeletePackageVersionArgs) (*nuget.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(*nuget.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePackageVersion indicates an expected call of DeletePackageVersion.
+func (mr *MockNugetClientMockRecorder) DeletePackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePackageVersion", reflect.TypeOf((*MockNugetClient)(nil).DeletePackageVersion), arg0, arg1)
+}
+
+// DeletePackageVersionFromRecycleBin mocks base method.
+func (m *MockNugetClient) DeletePackageVersionFromRecycleBin(arg0 context.Context, arg1 nuget.DeletePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePackageVersionFromRecycleBin indicates an expected call of DeletePackageVersionFromRecycleBin.
+func (mr *MockNugetClientMockRecorder) DeletePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePackageVersionFromRecycleBin", reflect.TypeOf((*MockNugetClient)(nil).DeletePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// DownloadPackage mocks base method.
+func (m *MockNugetClient) DownloadPackage(arg0 context.Context, arg1 nuget.DownloadPackageArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadPackage", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadPackage indicates an expected call of DownloadPackage.
+func (mr *MockNugetClientMockRecorder) DownloadPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPackage", reflect.TypeOf((*MockNugetClient)(nil).DownloadPackage), arg0, arg1)
+}
+
+// GetPackageVersion mocks base method.
+func (m *MockNugetClient) GetPackageVersion(arg0 context.Context, arg1 nuget.GetPackageVersionArgs) (*nuget.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(*nuget.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersion indicates an expected call of GetPackageVersion.
+func (mr *MockNugetClientMockRecorder) GetPackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersion", reflect.TypeOf((*MockNugetClient)(nil).GetPackageVersion), arg0, arg1)
+}
+
+// GetPackageVersionMetadataFromRecycleBin mocks base method.
+func (m *MockNugetClient) GetPackageVersionMetadataFromRecycleBin(arg0 context.Context, arg1 nuget.GetPackageVersionMetadataFromRecycleBinArgs) (*nuget.NuGetPackageVersionDeletionState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersionMetadataFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(*nuget.NuGetPackageVersionDeletionState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersionMetadataFromRecycleBin indicates an expected call of GetPackageVersionMetadataFromRecycleBin.
+func (mr *MockNugetClientMockRecorder) GetPackageVersionMetadataFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersionMetadataFromRecycleBin", reflect.TypeOf((*MockNugetClient)(nil).GetPackageVersionMetadataFromRecycleBin), arg0, arg1)
+}
+
+// GetUpstreamingBehavior mocks base method.
+func (m *MockNugetClient) GetUpstreamingBehavior(arg0 context.Context, arg1 nuget.GetUpstreamingBehaviorArgs) (*packagingshared.UpstreamingBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(*packagingshared.UpstreamingBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpstreamingBehavior indicates an expected call of GetUpstreamingBehavior.
+func (mr *MockNugetClientMockRecorder) GetUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamingBehavior", reflect.TypeOf((*MockNugetClient)(nil).GetUpstreamingBehavior), arg0, arg1)
+}
+
+// RestorePackageVersionFromRecycleBin mocks base method.
+func (m *MockNugetClient) RestorePackageVersionFromRecycleBin(arg0 context.Context, arg1 nuget.RestorePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePackageVersionFromRecycleBin indicates an expected call of RestorePackageVersionFromRecycleBin.
+func (mr *MockNugetClientMockRecorder) RestorePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePackageVersionFromRecycleBin", reflect.TypeOf((*MockNugetClient)(nil).RestorePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// SetUpstreamingBehavior mocks base method.
+func (m *MockNugetClient) SetUpstreamingBehavior(arg0 context.Context, arg1 nuget.SetUpstreamingBehaviorArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUpstreamingBehavior indicates an expected call of SetUpstreamingBehavior.
+func (mr *MockNugetClientMockRecorder) SetUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUpstreamingBehavior", reflect.TypeOf((*MockNugetClient)(nil).SetUpstreamingBehavior), arg0, arg1)
+}
+
+// UpdatePackageVersion mocks base method.
+func (m *MockNugetClient) UpdatePackageVersion(arg0 context.Context, arg1 nuget.UpdatePackageVersionArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageVersion indicates an expected call of UpdatePackageVersion.
+func (mr *MockNugetClientMockRecorder) UpdatePackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageVersion", reflect.TypeOf((*MockNugetClient)(nil).UpdatePackageVersion), arg0, arg1)
+}
+
+// UpdatePackageVersions mocks base method.
+func (m *MockNugetClient) UpdatePackageVersions(arg0 context.Context, arg1 nuget.UpdatePackageVersionsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageVersions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageVersions indicates an expected call of UpdatePackageVersions.
+func (mr *MockNugetClientMockRecorder) UpdatePackageVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageVersions", reflect.TypeOf((*MockNugetClient)(nil).UpdatePackageVersions), arg0, arg1)
+}
+
+// UpdateRecycleBinPackageVersions mocks base method.
+func (m *MockNugetClient) UpdateRecycleBinPackageVersions(arg0 context.Context, arg1 nuget.UpdateRecycleBinPackageVersionsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecycleBinPackageVersions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecycleBinPackageVersions indicates an expected call of UpdateRecycleBinPackageVersions.
+func (mr *MockNugetClientMockRecorder) UpdateRecycleBinPackageVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecycleBinPackageVersions", reflect.TypeOf((*MockNugetClient)(nil).UpdateRecycleBinPackageVersions), arg0, arg1)
+}
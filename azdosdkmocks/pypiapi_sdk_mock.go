@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v7/pypiapi (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	packagingshared "github.com/microsoft/azure-devops-go-api/azuredevops/v7/packagingshared"
+	pypiapi "github.com/microsoft/azure-devops-go-api/azuredevops/v7/pypiapi"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPypiapiClient is a mock of Client interface.
+type MockPypiapiClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPypiapiClientMockRecorder
+	isgomock struct{}
+}
+
+// MockPypiapiClientMockRecorder is the mock recorder for MockPypiapiClient.
+type MockPypiapiClientMockRecorder struct {
+	mock *MockPypiapiClient
+}
+
+// NewMockPypiapiClient creates a new mock instance.
+func NewMockPypiapiClient(ctrl *gomock.Controller) *MockPypiapiClient {
+	mock := &MockPypiapiClient{ctrl: ctrl}
+	mock.recorder = &MockPypiapiClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPypiapiClient) EXPECT() *MockPypiapiClientMockRecorder {
+	return m.recorder
+}
+
+// DeletePackageVersion mocks base method.
+func (m *MockPypiapiClient) DeletePackageVersion(arg0 context.Context, arg1 pypiapi.DeletePackageVersionArgs) (*pypiapi.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(*pypiapi.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePackageVersion indicates an expected call of DeletePackageVersion.
+func (mr *MockPypiapiClientMockRecorder) DeletePackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePackageVersion", reflect.TypeOf((*MockPypiapiClient)(nil).DeletePackageVersion), arg0, arg1)
+}
+
+// DeletePackageVersionFromRecycleBin mocks base method.
+func (m *MockPypiapiClient) DeletePackageVersionFromRecycleBin(arg0 context.Context, arg1 pypiapi.DeletePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePackageVersionFromRecycleBin indicates an expected call of DeletePackageVersionFromRecycleBin.
+func (mr *MockPypiapiClientMockRecorder) DeletePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePackageVersionFromRecycleBin", reflect.TypeOf((*MockPypiapiClient)(nil).DeletePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// DownloadPackage mocks base method.
+func (m *MockPypiapiClient) DownloadPackage(arg0 context.Context, arg1 pypiapi.DownloadPackageArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadPackage", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadPackage indicates an expected call of DownloadPackage.
+func (mr *MockPypiapiClientMockRecorder) DownloadPackage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPackage", reflect.TypeOf((*MockPypiapiClient)(nil).DownloadPackage), arg0, arg1)
+}
+
+// GetPackageVersion mocks base method.
+func (m *MockPypiapiClient) GetPackageVersion(arg0 context.Context, arg1 pypiapi.GetPackageVersionArgs) (*pypiapi.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(*pypiapi.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersion indicates an expected call of GetPackageVersion.
+func (mr *MockPypiapiClientMockRecorder) GetPackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersion", reflect.TypeOf((*MockPypiapiClient)(nil).GetPackageVersion), arg0, arg1)
+}
+
+// GetPackageVersionMetadataFromRecycleBin mocks base method.
+func (m *MockPypiapiClient) GetPackageVersionMetadataFromRecycleBin(arg0 context.Context, arg1 pypiapi.GetPackageVersionMetadataFromRecycleBinArgs) (*pypiapi.PyPiPackageVersionDeletionState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersionMetadataFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(*pypiapi.PyPiPackageVersionDeletionState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersionMetadataFromRecycleBin indicates an expected call of GetPackageVersionMetadataFromRecycleBin.
+func (mr *MockPypiapiClientMockRecorder) GetPackageVersionMetadataFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersionMetadataFromRecycleBin", reflect.TypeOf((*MockPypiapiClient)(nil).GetPackageVersionMetadataFromRecycleBin), arg0, arg1)
+}
+
+// GetUpstreamingBehavior mocks base method.
+func (m *MockPypiapiClient) GetUpstreamingBehavior(arg0 context.Context, arg1 pypiapi.GetUpstreamingBehaviorArgs) (*packagingshared.UpstreamingBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(*packagingshared.UpstreamingBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpstreamingBehavior indicates an expected call of GetUpstreamingBehavior.
+func (mr *MockPypiapiClientMockRecorder) GetUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamingBehavior", reflect.TypeOf((*MockPypiapiClient)(nil).GetUpstreamingBehavior), arg0, arg1)
+}
+
+// RestorePackageVersionFromRecycleBin mocks base method.
+func (m *MockPypiapiClient) RestorePackageVersionFromRecycleBin(arg0 context.Context, arg1 pypiapi.RestorePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePackageVersionFromRecycleBin indicates an expected call of RestorePackageVersionFromRecycleBin.
+func (mr *MockPypiapiClientMockRecorder) RestorePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePackageVersionFromRecycleBin", reflect.TypeOf((*MockPypiapiClient)(nil).RestorePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// SetUpstreamingBehavior mocks base method.
+func (m *MockPypiapiClient) SetUpstreamingBehavior(arg0 context.Context, arg1 pypiapi.SetUpstreamingBehaviorArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUpstreamingBehavior", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUpstreamingBehavior indicates an expected call of SetUpstreamingBehavior.
+func (mr *MockPypiapiClientMockRecorder) SetUpstreamingBehavior(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUpstreamingBehavior", reflect.TypeOf((*MockPypiapiClient)(nil).SetUpstreamingBehavior), arg0, arg1)
+}
+
+// UpdatePackageVersion mocks base method.
+func (m *MockPypiapiClient) UpdatePackageVersion(arg0 context.Context, arg1 pypiapi.UpdatePackageVersionArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageVersion indicates an expected call of UpdatePackageVersion.
+func (mr *MockPypiapiClientMockRecorder) UpdatePackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageVersion", reflect.TypeOf((*MockPypiapiClient)(nil).UpdatePackageVersion), arg0, arg1)
+}
+
+// UpdatePackageVersions mocks base method.
+func (m *MockPypiapiClient) UpdatePackageVersions(arg0 context.Context, arg1 pypiapi.UpdatePackageVersionsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageVersions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageVersions indicates an expected call of UpdatePackageVersions.
+func (mr *MockPypiapiClientMockRecorder) UpdatePackageVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageVersions", reflect.TypeOf((*MockPypiapiClient)(nil).UpdatePackageVersions), arg0, arg1)
+}
+
+// UpdateRecycleBinPackageVersions mocks base method.
+func (m *MockPypiapiClient) UpdateRecycleBinPackageVersions(arg0 context.Context, arg1 pypiapi.UpdateRecycleBinPackageVersionsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecycleBinPackageVersions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecycleBinPackageVersions indicates an expected call of UpdateRecycleBinPackageVersions.
+func (mr *MockPypiapiClientMockRecorder) UpdateRecycleBinPackageVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecycleBinPackageVersions", reflect.TypeOf((*MockPypiapiClient)(nil).UpdateRecycleBinPackageVersions), arg0, arg1)
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/taskagentextras (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	taskagent "github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	taskagentextras "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/taskagentextras"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskagentextrasClient is a mock of Client interface.
+type MockTaskagentextrasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskagentextrasClientMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskagentextrasClientMockRecorder is the mock recorder for MockTaskagentextrasClient.
+type MockTaskagentextrasClientMockRecorder struct {
+	mock *MockTaskagentextrasClient
+}
+
+// NewMockTaskagentextrasClient creates a new mock instance.
+func NewMockTaskagentextrasClient(ctrl *gomock.Controller) *MockTaskagentextrasClient {
+	mock := &MockTaskagentextrasClient{ctrl: ctrl}
+	mock.recorder = &MockTaskagentextrasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskagentextrasClient) EXPECT() *MockTaskagentextrasClientMockRecorder {
+	return m.recorder
+}
+
+// UpdateAgentUserCapabilities mocks base method.
+func (m *MockTaskagentextrasClient) UpdateAgentUserCapabilities(arg0 context.Context, arg1 taskagentextras.UpdateAgentUserCapabilitiesArgs) (*taskagent.TaskAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAgentUserCapabilities", arg0, arg1)
+	ret0, _ := ret[0].(*taskagent.TaskAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAgentUserCapabilities indicates an expected call of UpdateAgentUserCapabilities.
+func (mr *MockTaskagentextrasClientMockRecorder) UpdateAgentUserCapabilities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAgentUserCapabilities", reflect.TypeOf((*MockTaskagentextrasClient)(nil).UpdateAgentUserCapabilities), arg0, arg1)
+}
+
+// UpdateTaskGroupProperties mocks base method.
+func (m *MockTaskagentextrasClient) UpdateTaskGroupProperties(arg0 context.Context, arg1 taskagentextras.UpdateTaskGroupPropertiesArgs) (*[]taskagent.TaskGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskGroupProperties", arg0, arg1)
+	ret0, _ := ret[0].(*[]taskagent.TaskGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskGroupProperties indicates an expected call of UpdateTaskGroupProperties.
+func (mr *MockTaskagentextrasClientMockRecorder) UpdateTaskGroupProperties(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskGroupProperties", reflect.TypeOf((*MockTaskagentextrasClient)(nil).UpdateTaskGroupProperties), arg0, arg1)
+}
@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v7/universal (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	universal "github.com/microsoft/azure-devops-go-api/azuredevops/v7/universal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUniversalClient is a mock of Client interface.
+type MockUniversalClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockUniversalClientMockRecorder
+	isgomock struct{}
+}
+
+// MockUniversalClientMockRecorder is the mock recorder for MockUniversalClient.
+type MockUniversalClientMockRecorder struct {
+	mock *MockUniversalClient
+}
+
+// NewMockUniversalClient creates a new mock instance.
+func NewMockUniversalClient(ctrl *gomock.Controller) *MockUniversalClient {
+	mock := &MockUniversalClient{ctrl: ctrl}
+	mock.recorder = &MockUniversalClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUniversalClient) EXPECT() *MockUniversalClientMockRecorder {
+	return m.recorder
+}
+
+// DeletePackageVersion mocks base method.
+func (m *MockUniversalClient) DeletePackageVersion(arg0 context.Context, arg1 universal.DeletePackageVersionArgs) (*universal.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(*universal.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePackageVersion indicates an expected call of DeletePackageVersion.
+func (mr *MockUniversalClientMockRecorder) DeletePackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePackageVersion", reflect.TypeOf((*MockUniversalClient)(nil).DeletePackageVersion), arg0, arg1)
+}
+
+// DeletePackageVersionFromRecycleBin mocks base method.
+func (m *MockUniversalClient) DeletePackageVersionFromRecycleBin(arg0 context.Context, arg1 universal.DeletePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePackageVersionFromRecycleBin indicates an expected call of DeletePackageVersionFromRecycleBin.
+func (mr *MockUniversalClientMockRecorder) DeletePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePackageVersionFromRecycleBin", reflect.TypeOf((*MockUniversalClient)(nil).DeletePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// GetPackageVersion mocks base method.
+func (m *MockUniversalClient) GetPackageVersion(arg0 context.Context, arg1 universal.GetPackageVersionArgs) (*universal.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(*universal.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersion indicates an expected call of GetPackageVersion.
+func (mr *MockUniversalClientMockRecorder) GetPackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersion", reflect.TypeOf((*MockUniversalClient)(nil).GetPackageVersion), arg0, arg1)
+}
+
+// GetPackageVersionMetadataFromRecycleBin mocks base method.
+func (m *MockUniversalClient) GetPackageVersionMetadataFromRecycleBin(arg0 context.Context, arg1 universal.GetPackageVersionMetadataFromRecycleBinArgs) (*universal.UPackPackageVersionDeletionState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageVersionMetadataFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(*universal.UPackPackageVersionDeletionState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageVersionMetadataFromRecycleBin indicates an expected call of GetPackageVersionMetadataFromRecycleBin.
+func (mr *MockUniversalClientMockRecorder) GetPackageVersionMetadataFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageVersionMetadataFromRecycleBin", reflect.TypeOf((*MockUniversalClient)(nil).GetPackageVersionMetadataFromRecycleBin), arg0, arg1)
+}
+
+// RestorePackageVersionFromRecycleBin mocks base method.
+func (m *MockUniversalClient) RestorePackageVersionFromRecycleBin(arg0 context.Context, arg1 universal.RestorePackageVersionFromRecycleBinArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePackageVersionFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePackageVersionFromRecycleBin indicates an expected call of RestorePackageVersionFromRecycleBin.
+func (mr *MockUniversalClientMockRecorder) RestorePackageVersionFromRecycleBin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePackageVersionFromRecycleBin", reflect.TypeOf((*MockUniversalClient)(nil).RestorePackageVersionFromRecycleBin), arg0, arg1)
+}
+
+// UpdatePackageVersion mocks base method.
+func (m *MockUniversalClient) UpdatePackageVersion(arg0 context.Context, arg1 universal.UpdatePackageVersionArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageVersion indicates an expected call of UpdatePackageVersion.
+func (mr *MockUniversalClientMockRecorder) UpdatePackageVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageVersion", reflect.TypeOf((*MockUniversalClient)(nil).UpdatePackageVersion), arg0, arg1)
+}
+
+// UpdatePackageVersions mocks base method.
+func (m *MockUniversalClient) UpdatePackageVersions(arg0 context.Context, arg1 universal.UpdatePackageVersionsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageVersions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageVersions indicates an expected call of UpdatePackageVersions.
+func (mr *MockUniversalClientMockRecorder) UpdatePackageVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageVersions", reflect.TypeOf((*MockUniversalClient)(nil).UpdatePackageVersions), arg0, arg1)
+}
+
+// UpdateRecycleBinPackageVersions mocks base method.
+func (m *MockUniversalClient) UpdateRecycleBinPackageVersions(arg0 context.Context, arg1 universal.UpdateRecycleBinPackageVersionsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecycleBinPackageVersions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecycleBinPackageVersions indicates an expected call of UpdateRecycleBinPackageVersions.
+func (mr *MockUniversalClientMockRecorder) UpdateRecycleBinPackageVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecycleBinPackageVersions", reflect.TypeOf((*MockUniversalClient)(nil).UpdateRecycleBinPackageVersions), arg0, arg1)
+}
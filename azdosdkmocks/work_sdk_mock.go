@@ -0,0 +1,842 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v7/work (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	work "github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkClient is a mock of Client interface.
+type MockWorkClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkClientMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkClientMockRecorder is the mock recorder for MockWorkClient.
+type MockWorkClientMockRecorder struct {
+	mock *MockWorkClient
+}
+
+// NewMockWorkClient creates a new mock instance.
+func NewMockWorkClient(ctrl *gomock.Controller) *MockWorkClient {
+	mock := &MockWorkClient{ctrl: ctrl}
+	mock.recorder = &MockWorkClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkClient) EXPECT() *MockWorkClientMockRecorder {
+	return m.recorder
+}
+
+// CreatePlan mocks base method.
+func (m *MockWorkClient) CreatePlan(arg0 context.Context, arg1 work.CreatePlanArgs) (*work.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlan", arg0, arg1)
+	ret0, _ := ret[0].(*work.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlan indicates an expected call of CreatePlan.
+func (mr *MockWorkClientMockRecorder) CreatePlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlan", reflect.TypeOf((*MockWorkClient)(nil).CreatePlan), arg0, arg1)
+}
+
+// DeletePlan mocks base method.
+func (m *MockWorkClient) DeletePlan(arg0 context.Context, arg1 work.DeletePlanArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlan", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlan indicates an expected call of DeletePlan.
+func (mr *MockWorkClientMockRecorder) DeletePlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlan", reflect.TypeOf((*MockWorkClient)(nil).DeletePlan), arg0, arg1)
+}
+
+// DeleteTeamIteration mocks base method.
+func (m *MockWorkClient) DeleteTeamIteration(arg0 context.Context, arg1 work.DeleteTeamIterationArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeamIteration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeamIteration indicates an expected call of DeleteTeamIteration.
+func (mr *MockWorkClientMockRecorder) DeleteTeamIteration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamIteration", reflect.TypeOf((*MockWorkClient)(nil).DeleteTeamIteration), arg0, arg1)
+}
+
+// GetBacklog mocks base method.
+func (m *MockWorkClient) GetBacklog(arg0 context.Context, arg1 work.GetBacklogArgs) (*work.BacklogLevelConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*work.BacklogLevelConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklog indicates an expected call of GetBacklog.
+func (mr *MockWorkClientMockRecorder) GetBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklog", reflect.TypeOf((*MockWorkClient)(nil).GetBacklog), arg0, arg1)
+}
+
+// GetBacklogConfigurations mocks base method.
+func (m *MockWorkClient) GetBacklogConfigurations(arg0 context.Context, arg1 work.GetBacklogConfigurationsArgs) (*work.BacklogConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklogConfigurations", arg0, arg1)
+	ret0, _ := ret[0].(*work.BacklogConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklogConfigurations indicates an expected call of GetBacklogConfigurations.
+func (mr *MockWorkClientMockRecorder) GetBacklogConfigurations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklogConfigurations", reflect.TypeOf((*MockWorkClient)(nil).GetBacklogConfigurations), arg0, arg1)
+}
+
+// GetBacklogLevelWorkItems mocks base method.
+func (m *MockWorkClient) GetBacklogLevelWorkItems(arg0 context.Context, arg1 work.GetBacklogLevelWorkItemsArgs) (*work.BacklogLevelWorkItems, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklogLevelWorkItems", arg0, arg1)
+	ret0, _ := ret[0].(*work.BacklogLevelWorkItems)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklogLevelWorkItems indicates an expected call of GetBacklogLevelWorkItems.
+func (mr *MockWorkClientMockRecorder) GetBacklogLevelWorkItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklogLevelWorkItems", reflect.TypeOf((*MockWorkClient)(nil).GetBacklogLevelWorkItems), arg0, arg1)
+}
+
+// GetBacklogs mocks base method.
+func (m *MockWorkClient) GetBacklogs(arg0 context.Context, arg1 work.GetBacklogsArgs) (*[]work.BacklogLevelConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklogs", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BacklogLevelConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklogs indicates an expected call of GetBacklogs.
+func (mr *MockWorkClientMockRecorder) GetBacklogs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklogs", reflect.TypeOf((*MockWorkClient)(nil).GetBacklogs), arg0, arg1)
+}
+
+// GetBoard mocks base method.
+func (m *MockWorkClient) GetBoard(arg0 context.Context, arg1 work.GetBoardArgs) (*work.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoard", arg0, arg1)
+	ret0, _ := ret[0].(*work.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoard indicates an expected call of GetBoard.
+func (mr *MockWorkClientMockRecorder) GetBoard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockWorkClient)(nil).GetBoard), arg0, arg1)
+}
+
+// GetBoardCardRuleSettings mocks base method.
+func (m *MockWorkClient) GetBoardCardRuleSettings(arg0 context.Context, arg1 work.GetBoardCardRuleSettingsArgs) (*work.BoardCardRuleSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardCardRuleSettings", arg0, arg1)
+	ret0, _ := ret[0].(*work.BoardCardRuleSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardCardRuleSettings indicates an expected call of GetBoardCardRuleSettings.
+func (mr *MockWorkClientMockRecorder) GetBoardCardRuleSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardCardRuleSettings", reflect.TypeOf((*MockWorkClient)(nil).GetBoardCardRuleSettings), arg0, arg1)
+}
+
+// GetBoardCardSettings mocks base method.
+func (m *MockWorkClient) GetBoardCardSettings(arg0 context.Context, arg1 work.GetBoardCardSettingsArgs) (*work.BoardCardSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardCardSettings", arg0, arg1)
+	ret0, _ := ret[0].(*work.BoardCardSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardCardSettings indicates an expected call of GetBoardCardSettings.
+func (mr *MockWorkClientMockRecorder) GetBoardCardSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardCardSettings", reflect.TypeOf((*MockWorkClient)(nil).GetBoardCardSettings), arg0, arg1)
+}
+
+// GetBoardChart mocks base method.
+func (m *MockWorkClient) GetBoardChart(arg0 context.Context, arg1 work.GetBoardChartArgs) (*work.BoardChart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardChart", arg0, arg1)
+	ret0, _ := ret[0].(*work.BoardChart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardChart indicates an expected call of GetBoardChart.
+func (mr *MockWorkClientMockRecorder) GetBoardChart(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardChart", reflect.TypeOf((*MockWorkClient)(nil).GetBoardChart), arg0, arg1)
+}
+
+// GetBoardCharts mocks base method.
+func (m *MockWorkClient) GetBoardCharts(arg0 context.Context, arg1 work.GetBoardChartsArgs) (*[]work.BoardChartReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardCharts", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardChartReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardCharts indicates an expected call of GetBoardCharts.
+func (mr *MockWorkClientMockRecorder) GetBoardCharts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardCharts", reflect.TypeOf((*MockWorkClient)(nil).GetBoardCharts), arg0, arg1)
+}
+
+// GetBoardColumns mocks base method.
+func (m *MockWorkClient) GetBoardColumns(arg0 context.Context, arg1 work.GetBoardColumnsArgs) (*[]work.BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardColumns", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardColumns indicates an expected call of GetBoardColumns.
+func (mr *MockWorkClientMockRecorder) GetBoardColumns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardColumns", reflect.TypeOf((*MockWorkClient)(nil).GetBoardColumns), arg0, arg1)
+}
+
+// GetBoardMappingParentItems mocks base method.
+func (m *MockWorkClient) GetBoardMappingParentItems(arg0 context.Context, arg1 work.GetBoardMappingParentItemsArgs) (*[]work.ParentChildWIMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardMappingParentItems", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.ParentChildWIMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardMappingParentItems indicates an expected call of GetBoardMappingParentItems.
+func (mr *MockWorkClientMockRecorder) GetBoardMappingParentItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardMappingParentItems", reflect.TypeOf((*MockWorkClient)(nil).GetBoardMappingParentItems), arg0, arg1)
+}
+
+// GetBoardRows mocks base method.
+func (m *MockWorkClient) GetBoardRows(arg0 context.Context, arg1 work.GetBoardRowsArgs) (*[]work.BoardRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardRows", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardRows indicates an expected call of GetBoardRows.
+func (mr *MockWorkClientMockRecorder) GetBoardRows(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardRows", reflect.TypeOf((*MockWorkClient)(nil).GetBoardRows), arg0, arg1)
+}
+
+// GetBoardUserSettings mocks base method.
+func (m *MockWorkClient) GetBoardUserSettings(arg0 context.Context, arg1 work.GetBoardUserSettingsArgs) (*work.BoardUserSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardUserSettings", arg0, arg1)
+	ret0, _ := ret[0].(*work.BoardUserSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardUserSettings indicates an expected call of GetBoardUserSettings.
+func (mr *MockWorkClientMockRecorder) GetBoardUserSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardUserSettings", reflect.TypeOf((*MockWorkClient)(nil).GetBoardUserSettings), arg0, arg1)
+}
+
+// GetBoards mocks base method.
+func (m *MockWorkClient) GetBoards(arg0 context.Context, arg1 work.GetBoardsArgs) (*[]work.BoardReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoards", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoards indicates an expected call of GetBoards.
+func (mr *MockWorkClientMockRecorder) GetBoards(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoards", reflect.TypeOf((*MockWorkClient)(nil).GetBoards), arg0, arg1)
+}
+
+// GetCapacitiesWithIdentityRefAndTotals mocks base method.
+func (m *MockWorkClient) GetCapacitiesWithIdentityRefAndTotals(arg0 context.Context, arg1 work.GetCapacitiesWithIdentityRefAndTotalsArgs) (*work.TeamCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacitiesWithIdentityRefAndTotals", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacitiesWithIdentityRefAndTotals indicates an expected call of GetCapacitiesWithIdentityRefAndTotals.
+func (mr *MockWorkClientMockRecorder) GetCapacitiesWithIdentityRefAndTotals(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacitiesWithIdentityRefAndTotals", reflect.TypeOf((*MockWorkClient)(nil).GetCapacitiesWithIdentityRefAndTotals), arg0, arg1)
+}
+
+// GetCapacityWithIdentityRef mocks base method.
+func (m *MockWorkClient) GetCapacityWithIdentityRef(arg0 context.Context, arg1 work.GetCapacityWithIdentityRefArgs) (*work.TeamMemberCapacityIdentityRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacityWithIdentityRef", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamMemberCapacityIdentityRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacityWithIdentityRef indicates an expected call of GetCapacityWithIdentityRef.
+func (mr *MockWorkClientMockRecorder) GetCapacityWithIdentityRef(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacityWithIdentityRef", reflect.TypeOf((*MockWorkClient)(nil).GetCapacityWithIdentityRef), arg0, arg1)
+}
+
+// GetColumnSuggestedValues mocks base method.
+func (m *MockWorkClient) GetColumnSuggestedValues(arg0 context.Context, arg1 work.GetColumnSuggestedValuesArgs) (*[]work.BoardSuggestedValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumnSuggestedValues", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardSuggestedValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumnSuggestedValues indicates an expected call of GetColumnSuggestedValues.
+func (mr *MockWorkClientMockRecorder) GetColumnSuggestedValues(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumnSuggestedValues", reflect.TypeOf((*MockWorkClient)(nil).GetColumnSuggestedValues), arg0, arg1)
+}
+
+// GetColumns mocks base method.
+func (m *MockWorkClient) GetColumns(arg0 context.Context, arg1 work.GetColumnsArgs) (*work.TaskboardColumns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumns", arg0, arg1)
+	ret0, _ := ret[0].(*work.TaskboardColumns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumns indicates an expected call of GetColumns.
+func (mr *MockWorkClientMockRecorder) GetColumns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumns", reflect.TypeOf((*MockWorkClient)(nil).GetColumns), arg0, arg1)
+}
+
+// GetDeliveryTimelineData mocks base method.
+func (m *MockWorkClient) GetDeliveryTimelineData(arg0 context.Context, arg1 work.GetDeliveryTimelineDataArgs) (*work.DeliveryViewData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryTimelineData", arg0, arg1)
+	ret0, _ := ret[0].(*work.DeliveryViewData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryTimelineData indicates an expected call of GetDeliveryTimelineData.
+func (mr *MockWorkClientMockRecorder) GetDeliveryTimelineData(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryTimelineData", reflect.TypeOf((*MockWorkClient)(nil).GetDeliveryTimelineData), arg0, arg1)
+}
+
+// GetIterationWorkItems mocks base method.
+func (m *MockWorkClient) GetIterationWorkItems(arg0 context.Context, arg1 work.GetIterationWorkItemsArgs) (*work.IterationWorkItems, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIterationWorkItems", arg0, arg1)
+	ret0, _ := ret[0].(*work.IterationWorkItems)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIterationWorkItems indicates an expected call of GetIterationWorkItems.
+func (mr *MockWorkClientMockRecorder) GetIterationWorkItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIterationWorkItems", reflect.TypeOf((*MockWorkClient)(nil).GetIterationWorkItems), arg0, arg1)
+}
+
+// GetPlan mocks base method.
+func (m *MockWorkClient) GetPlan(arg0 context.Context, arg1 work.GetPlanArgs) (*work.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlan", arg0, arg1)
+	ret0, _ := ret[0].(*work.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlan indicates an expected call of GetPlan.
+func (mr *MockWorkClientMockRecorder) GetPlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlan", reflect.TypeOf((*MockWorkClient)(nil).GetPlan), arg0, arg1)
+}
+
+// GetPlans mocks base method.
+func (m *MockWorkClient) GetPlans(arg0 context.Context, arg1 work.GetPlansArgs) (*[]work.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlans", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlans indicates an expected call of GetPlans.
+func (mr *MockWorkClientMockRecorder) GetPlans(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlans", reflect.TypeOf((*MockWorkClient)(nil).GetPlans), arg0, arg1)
+}
+
+// GetProcessConfiguration mocks base method.
+func (m *MockWorkClient) GetProcessConfiguration(arg0 context.Context, arg1 work.GetProcessConfigurationArgs) (*work.ProcessConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*work.ProcessConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessConfiguration indicates an expected call of GetProcessConfiguration.
+func (mr *MockWorkClientMockRecorder) GetProcessConfiguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessConfiguration", reflect.TypeOf((*MockWorkClient)(nil).GetProcessConfiguration), arg0, arg1)
+}
+
+// GetRowSuggestedValues mocks base method.
+func (m *MockWorkClient) GetRowSuggestedValues(arg0 context.Context, arg1 work.GetRowSuggestedValuesArgs) (*[]work.BoardSuggestedValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRowSuggestedValues", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardSuggestedValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRowSuggestedValues indicates an expected call of GetRowSuggestedValues.
+func (mr *MockWorkClientMockRecorder) GetRowSuggestedValues(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowSuggestedValues", reflect.TypeOf((*MockWorkClient)(nil).GetRowSuggestedValues), arg0, arg1)
+}
+
+// GetTeamDaysOff mocks base method.
+func (m *MockWorkClient) GetTeamDaysOff(arg0 context.Context, arg1 work.GetTeamDaysOffArgs) (*work.TeamSettingsDaysOff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamDaysOff", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamSettingsDaysOff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamDaysOff indicates an expected call of GetTeamDaysOff.
+func (mr *MockWorkClientMockRecorder) GetTeamDaysOff(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamDaysOff", reflect.TypeOf((*MockWorkClient)(nil).GetTeamDaysOff), arg0, arg1)
+}
+
+// GetTeamFieldValues mocks base method.
+func (m *MockWorkClient) GetTeamFieldValues(arg0 context.Context, arg1 work.GetTeamFieldValuesArgs) (*work.TeamFieldValues, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamFieldValues", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamFieldValues)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamFieldValues indicates an expected call of GetTeamFieldValues.
+func (mr *MockWorkClientMockRecorder) GetTeamFieldValues(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamFieldValues", reflect.TypeOf((*MockWorkClient)(nil).GetTeamFieldValues), arg0, arg1)
+}
+
+// GetTeamIteration mocks base method.
+func (m *MockWorkClient) GetTeamIteration(arg0 context.Context, arg1 work.GetTeamIterationArgs) (*work.TeamSettingsIteration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamIteration", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamSettingsIteration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamIteration indicates an expected call of GetTeamIteration.
+func (mr *MockWorkClientMockRecorder) GetTeamIteration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamIteration", reflect.TypeOf((*MockWorkClient)(nil).GetTeamIteration), arg0, arg1)
+}
+
+// GetTeamIterations mocks base method.
+func (m *MockWorkClient) GetTeamIterations(arg0 context.Context, arg1 work.GetTeamIterationsArgs) (*[]work.TeamSettingsIteration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamIterations", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.TeamSettingsIteration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamIterations indicates an expected call of GetTeamIterations.
+func (mr *MockWorkClientMockRecorder) GetTeamIterations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamIterations", reflect.TypeOf((*MockWorkClient)(nil).GetTeamIterations), arg0, arg1)
+}
+
+// GetTeamSettings mocks base method.
+func (m *MockWorkClient) GetTeamSettings(arg0 context.Context, arg1 work.GetTeamSettingsArgs) (*work.TeamSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSettings", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamSettings indicates an expected call of GetTeamSettings.
+func (mr *MockWorkClientMockRecorder) GetTeamSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSettings", reflect.TypeOf((*MockWorkClient)(nil).GetTeamSettings), arg0, arg1)
+}
+
+// GetTotalIterationCapacities mocks base method.
+func (m *MockWorkClient) GetTotalIterationCapacities(arg0 context.Context, arg1 work.GetTotalIterationCapacitiesArgs) (*work.IterationCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalIterationCapacities", arg0, arg1)
+	ret0, _ := ret[0].(*work.IterationCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalIterationCapacities indicates an expected call of GetTotalIterationCapacities.
+func (mr *MockWorkClientMockRecorder) GetTotalIterationCapacities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalIterationCapacities", reflect.TypeOf((*MockWorkClient)(nil).GetTotalIterationCapacities), arg0, arg1)
+}
+
+// GetWorkItemColumns mocks base method.
+func (m *MockWorkClient) GetWorkItemColumns(arg0 context.Context, arg1 work.GetWorkItemColumnsArgs) (*[]work.TaskboardWorkItemColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkItemColumns", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.TaskboardWorkItemColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkItemColumns indicates an expected call of GetWorkItemColumns.
+func (mr *MockWorkClientMockRecorder) GetWorkItemColumns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkItemColumns", reflect.TypeOf((*MockWorkClient)(nil).GetWorkItemColumns), arg0, arg1)
+}
+
+// PostTeamIteration mocks base method.
+func (m *MockWorkClient) PostTeamIteration(arg0 context.Context, arg1 work.PostTeamIterationArgs) (*work.TeamSettingsIteration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostTeamIteration", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamSettingsIteration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostTeamIteration indicates an expected call of PostTeamIteration.
+func (mr *MockWorkClientMockRecorder) PostTeamIteration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostTeamIteration", reflect.TypeOf((*MockWorkClient)(nil).PostTeamIteration), arg0, arg1)
+}
+
+// ReorderBacklogWorkItems mocks base method.
+func (m *MockWorkClient) ReorderBacklogWorkItems(arg0 context.Context, arg1 work.ReorderBacklogWorkItemsArgs) (*[]work.ReorderResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderBacklogWorkItems", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.ReorderResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderBacklogWorkItems indicates an expected call of ReorderBacklogWorkItems.
+func (mr *MockWorkClientMockRecorder) ReorderBacklogWorkItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderBacklogWorkItems", reflect.TypeOf((*MockWorkClient)(nil).ReorderBacklogWorkItems), arg0, arg1)
+}
+
+// ReorderIterationWorkItems mocks base method.
+func (m *MockWorkClient) ReorderIterationWorkItems(arg0 context.Context, arg1 work.ReorderIterationWorkItemsArgs) (*[]work.ReorderResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderIterationWorkItems", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.ReorderResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderIterationWorkItems indicates an expected call of ReorderIterationWorkItems.
+func (mr *MockWorkClientMockRecorder) ReorderIterationWorkItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderIterationWorkItems", reflect.TypeOf((*MockWorkClient)(nil).ReorderIterationWorkItems), arg0, arg1)
+}
+
+// ReplaceCapacitiesWithIdentityRef mocks base method.
+func (m *MockWorkClient) ReplaceCapacitiesWithIdentityRef(arg0 context.Context, arg1 work.ReplaceCapacitiesWithIdentityRefArgs) (*[]work.TeamMemberCapacityIdentityRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceCapacitiesWithIdentityRef", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.TeamMemberCapacityIdentityRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceCapacitiesWithIdentityRef indicates an expected call of ReplaceCapacitiesWithIdentityRef.
+func (mr *MockWorkClientMockRecorder) ReplaceCapacitiesWithIdentityRef(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceCapacitiesWithIdentityRef", reflect.TypeOf((*MockWorkClient)(nil).ReplaceCapacitiesWithIdentityRef), arg0, arg1)
+}
+
+// SetBoardOptions mocks base method.
+func (m *MockWorkClient) SetBoardOptions(arg0 context.Context, arg1 work.SetBoardOptionsArgs) (*map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBoardOptions", arg0, arg1)
+	ret0, _ := ret[0].(*map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBoardOptions indicates an expected call of SetBoardOptions.
+func (mr *MockWorkClientMockRecorder) SetBoardOptions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBoardOptions", reflect.TypeOf((*MockWorkClient)(nil).SetBoardOptions), arg0, arg1)
+}
+
+// UpdateBoardCardRuleSettings mocks base method.
+func (m *MockWorkClient) UpdateBoardCardRuleSettings(arg0 context.Context, arg1 work.UpdateBoardCardRuleSettingsArgs) (*work.BoardCardRuleSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardCardRuleSettings", arg0, arg1)
+	ret0, _ := ret[0].(*work.BoardCardRuleSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardCardRuleSettings indicates an expected call of UpdateBoardCardRuleSettings.
+func (mr *MockWorkClientMockRecorder) UpdateBoardCardRuleSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardCardRuleSettings", reflect.TypeOf((*MockWorkClient)(nil).UpdateBoardCardRuleSettings), arg0, arg1)
+}
+
+// UpdateBoardCardSettings mocks base method.
+func (m *MockWorkClient) UpdateBoardCardSettings(arg0 context.Context, arg1 work.UpdateBoardCardSettingsArgs) (*work.BoardCardSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardCardSettings", arg0, arg1)
+	ret0, _ := ret[0].(*work.BoardCardSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardCardSettings indicates an expected call of UpdateBoardCardSettings.
+func (mr *MockWorkClientMockRecorder) UpdateBoardCardSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardCardSettings", reflect.TypeOf((*MockWorkClient)(nil).UpdateBoardCardSettings), arg0, arg1)
+}
+
+// UpdateBoardChart mocks base method.
+func (m *MockWorkClient) UpdateBoardChart(arg0 context.Context, arg1 work.UpdateBoardChartArgs) (*work.BoardChart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardChart", arg0, arg1)
+	ret0, _ := ret[0].(*work.BoardChart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardChart indicates an expected call of UpdateBoardChart.
+func (mr *MockWorkClientMockRecorder) UpdateBoardChart(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardChart", reflect.TypeOf((*MockWorkClient)(nil).UpdateBoardChart), arg0, arg1)
+}
+
+// UpdateBoardColumns mocks base method.
+func (m *MockWorkClient) UpdateBoardColumns(arg0 context.Context, arg1 work.UpdateBoardColumnsArgs) (*[]work.BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardColumns", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardColumns indicates an expected call of UpdateBoardColumns.
+func (mr *MockWorkClientMockRecorder) UpdateBoardColumns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardColumns", reflect.TypeOf((*MockWorkClient)(nil).UpdateBoardColumns), arg0, arg1)
+}
+
+// UpdateBoardRows mocks base method.
+func (m *MockWorkClient) UpdateBoardRows(arg0 context.Context, arg1 work.UpdateBoardRowsArgs) (*[]work.BoardRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardRows", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardRows indicates an expected call of UpdateBoardRows.
+func (mr *MockWorkClientMockRecorder) UpdateBoardRows(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardRows", reflect.TypeOf((*MockWorkClient)(nil).UpdateBoardRows), arg0, arg1)
+}
+
+// UpdateBoardUserSettings mocks base method.
+func (m *MockWorkClient) UpdateBoardUserSettings(arg0 context.Context, arg1 work.UpdateBoardUserSettingsArgs) (*work.BoardUserSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardUserSettings", arg0, arg1)
+	ret0, _ := ret[0].(*work.BoardUserSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardUserSettings indicates an expected call of UpdateBoardUserSettings.
+func (mr *MockWorkClientMockRecorder) UpdateBoardUserSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardUserSettings", reflect.TypeOf((*MockWorkClient)(nil).UpdateBoardUserSettings), arg0, arg1)
+}
+
+// UpdateCapacityWithIdentityRef mocks base method.
+func (m *MockWorkClient) UpdateCapacityWithIdentityRef(arg0 context.Context, arg1 work.UpdateCapacityWithIdentityRefArgs) (*work.TeamMemberCapacityIdentityRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCapacityWithIdentityRef", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamMemberCapacityIdentityRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCapacityWithIdentityRef indicates an expected call of UpdateCapacityWithIdentityRef.
+func (mr *MockWorkClientMockRecorder) UpdateCapacityWithIdentityRef(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCapacityWithIdentityRef", reflect.TypeOf((*MockWorkClient)(nil).UpdateCapacityWithIdentityRef), arg0, arg1)
+}
+
+// UpdateColumns mocks base method.
+func (m *MockWorkClient) UpdateColumns(arg0 context.Context, arg1 work.UpdateColumnsArgs) (*work.TaskboardColumns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateColumns", arg0, arg1)
+	ret0, _ := ret[0].(*work.TaskboardColumns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateColumns indicates an expected call of UpdateColumns.
+func (mr *MockWorkClientMockRecorder) UpdateColumns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateColumns", reflect.TypeOf((*MockWorkClient)(nil).UpdateColumns), arg0, arg1)
+}
+
+// UpdatePlan mocks base method.
+func (m *MockWorkClient) UpdatePlan(arg0 context.Context, arg1 work.UpdatePlanArgs) (*work.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlan", arg0, arg1)
+	ret0, _ := ret[0].(*work.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePlan indicates an expected call of UpdatePlan.
+func (mr *MockWorkClientMockRecorder) UpdatePlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlan", reflect.TypeOf((*MockWorkClient)(nil).UpdatePlan), arg0, arg1)
+}
+
+// UpdateTaskboardCardRuleSettings mocks base method.
+func (m *MockWorkClient) UpdateTaskboardCardRuleSettings(arg0 context.Context, arg1 work.UpdateTaskboardCardRuleSettingsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskboardCardRuleSettings", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskboardCardRuleSettings indicates an expected call of UpdateTaskboardCardRuleSettings.
+func (mr *MockWorkClientMockRecorder) UpdateTaskboardCardRuleSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskboardCardRuleSettings", reflect.TypeOf((*MockWorkClient)(nil).UpdateTaskboardCardRuleSettings), arg0, arg1)
+}
+
+// UpdateTaskboardCardSettings mocks base method.
+func (m *MockWorkClient) UpdateTaskboardCardSettings(arg0 context.Context, arg1 work.UpdateTaskboardCardSettingsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskboardCardSettings", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskboardCardSettings indicates an expected call of UpdateTaskboardCardSettings.
+func (mr *MockWorkClientMockRecorder) UpdateTaskboardCardSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskboardCardSettings", reflect.TypeOf((*MockWorkClient)(nil).UpdateTaskboardCardSettings), arg0, arg1)
+}
+
+// UpdateTeamDaysOff mocks base method.
+func (m *MockWorkClient) UpdateTeamDaysOff(arg0 context.Context, arg1 work.UpdateTeamDaysOffArgs) (*work.TeamSettingsDaysOff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamDaysOff", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamSettingsDaysOff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamDaysOff indicates an expected call of UpdateTeamDaysOff.
+func (mr *MockWorkClientMockRecorder) UpdateTeamDaysOff(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamDaysOff", reflect.TypeOf((*MockWorkClient)(nil).UpdateTeamDaysOff), arg0, arg1)
+}
+
+// UpdateTeamFieldValues mocks base method.
+func (m *MockWorkClient) UpdateTeamFieldValues(arg0 context.Context, arg1 work.UpdateTeamFieldValuesArgs) (*work.TeamFieldValues, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamFieldValues", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamFieldValues)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamFieldValues indicates an expected call of UpdateTeamFieldValues.
+func (mr *MockWorkClientMockRecorder) UpdateTeamFieldValues(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamFieldValues", reflect.TypeOf((*MockWorkClient)(nil).UpdateTeamFieldValues), arg0, arg1)
+}
+
+// UpdateTeamSettings mocks base method.
+func (m *MockWorkClient) UpdateTeamSettings(arg0 context.Context, arg1 work.UpdateTeamSettingsArgs) (*work.TeamSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamSettings", arg0, arg1)
+	ret0, _ := ret[0].(*work.TeamSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamSettings indicates an expected call of UpdateTeamSettings.
+func (mr *MockWorkClientMockRecorder) UpdateTeamSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamSettings", reflect.TypeOf((*MockWorkClient)(nil).UpdateTeamSettings), arg0, arg1)
+}
+
+// UpdateWorkItemColumn mocks base method.
+func (m *MockWorkClient) UpdateWorkItemColumn(arg0 context.Context, arg1 work.UpdateWorkItemColumnArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkItemColumn", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkItemColumn indicates an expected call of UpdateWorkItemColumn.
+func (mr *MockWorkClientMockRecorder) UpdateWorkItemColumn(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkItemColumn", reflect.TypeOf((*MockWorkClient)(nil).UpdateWorkItemColumn), arg0, arg1)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workextras (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	work "github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	workextras "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workextras"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkextrasClient is a mock of Client interface.
+type MockWorkextrasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkextrasClientMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkextrasClientMockRecorder is the mock recorder for MockWorkextrasClient.
+type MockWorkextrasClientMockRecorder struct {
+	mock *MockWorkextrasClient
+}
+
+// NewMockWorkextrasClient creates a new mock instance.
+func NewMockWorkextrasClient(ctrl *gomock.Controller) *MockWorkextrasClient {
+	mock := &MockWorkextrasClient{ctrl: ctrl}
+	mock.recorder = &MockWorkextrasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkextrasClient) EXPECT() *MockWorkextrasClientMockRecorder {
+	return m.recorder
+}
+
+// GetBoardCardRuleSettings mocks base method.
+func (m *MockWorkextrasClient) GetBoardCardRuleSettings(arg0 context.Context, arg1 workextras.GetBoardCardRuleSettingsArgs) (*workextras.BoardCardRuleSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardCardRuleSettings", arg0, arg1)
+	ret0, _ := ret[0].(*workextras.BoardCardRuleSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardCardRuleSettings indicates an expected call of GetBoardCardRuleSettings.
+func (mr *MockWorkextrasClientMockRecorder) GetBoardCardRuleSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardCardRuleSettings", reflect.TypeOf((*MockWorkextrasClient)(nil).GetBoardCardRuleSettings), arg0, arg1)
+}
+
+// GetBoardCardSettings mocks base method.
+func (m *MockWorkextrasClient) GetBoardCardSettings(arg0 context.Context, arg1 workextras.GetBoardCardSettingsArgs) (*workextras.BoardCardSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardCardSettings", arg0, arg1)
+	ret0, _ := ret[0].(*workextras.BoardCardSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardCardSettings indicates an expected call of GetBoardCardSettings.
+func (mr *MockWorkextrasClientMockRecorder) GetBoardCardSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardCardSettings", reflect.TypeOf((*MockWorkextrasClient)(nil).GetBoardCardSettings), arg0, arg1)
+}
+
+// GetBoardColumns mocks base method.
+func (m *MockWorkextrasClient) GetBoardColumns(arg0 context.Context, arg1 workextras.GetBoardColumnsArgs) (*workextras.BoardColumns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardColumns", arg0, arg1)
+	ret0, _ := ret[0].(*workextras.BoardColumns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardColumns indicates an expected call of GetBoardColumns.
+func (mr *MockWorkextrasClientMockRecorder) GetBoardColumns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardColumns", reflect.TypeOf((*MockWorkextrasClient)(nil).GetBoardColumns), arg0, arg1)
+}
+
+// GetBoardRows mocks base method.
+func (m *MockWorkextrasClient) GetBoardRows(arg0 context.Context, arg1 workextras.GetBoardRowsArgs) (*workextras.BoardRows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardRows", arg0, arg1)
+	ret0, _ := ret[0].(*workextras.BoardRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardRows indicates an expected call of GetBoardRows.
+func (mr *MockWorkextrasClientMockRecorder) GetBoardRows(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardRows", reflect.TypeOf((*MockWorkextrasClient)(nil).GetBoardRows), arg0, arg1)
+}
+
+// UpdateBoardCardRuleSettings mocks base method.
+func (m *MockWorkextrasClient) UpdateBoardCardRuleSettings(arg0 context.Context, arg1 workextras.UpdateBoardCardRuleSettingsArgs) (*workextras.BoardCardRuleSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardCardRuleSettings", arg0, arg1)
+	ret0, _ := ret[0].(*workextras.BoardCardRuleSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardCardRuleSettings indicates an expected call of UpdateBoardCardRuleSettings.
+func (mr *MockWorkextrasClientMockRecorder) UpdateBoardCardRuleSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardCardRuleSettings", reflect.TypeOf((*MockWorkextrasClient)(nil).UpdateBoardCardRuleSettings), arg0, arg1)
+}
+
+// UpdateBoardCardSettings mocks base method.
+func (m *MockWorkextrasClient) UpdateBoardCardSettings(arg0 context.Context, arg1 workextras.UpdateBoardCardSettingsArgs) (*workextras.BoardCardSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardCardSettings", arg0, arg1)
+	ret0, _ := ret[0].(*workextras.BoardCardSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardCardSettings indicates an expected call of UpdateBoardCardSettings.
+func (mr *MockWorkextrasClientMockRecorder) UpdateBoardCardSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardCardSettings", reflect.TypeOf((*MockWorkextrasClient)(nil).UpdateBoardCardSettings), arg0, arg1)
+}
+
+// UpdateBoardColumns mocks base method.
+func (m *MockWorkextrasClient) UpdateBoardColumns(arg0 context.Context, arg1 workextras.UpdateBoardColumnsArgs) (*[]work.BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardColumns", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardColumns indicates an expected call of UpdateBoardColumns.
+func (mr *MockWorkextrasClientMockRecorder) UpdateBoardColumns(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardColumns", reflect.TypeOf((*MockWorkextrasClient)(nil).UpdateBoardColumns), arg0, arg1)
+}
+
+// UpdateBoardRows mocks base method.
+func (m *MockWorkextrasClient) UpdateBoardRows(arg0 context.Context, arg1 workextras.UpdateBoardRowsArgs) (*[]work.BoardRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoardRows", arg0, arg1)
+	ret0, _ := ret[0].(*[]work.BoardRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoardRows indicates an expected call of UpdateBoardRows.
+func (mr *MockWorkextrasClientMockRecorder) UpdateBoardRows(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoardRows", reflect.TypeOf((*MockWorkextrasClient)(nil).UpdateBoardRows), arg0, arg1)
+}
@@ -0,0 +1,44 @@
+//go:build (all || data_sources || data_agents) && (!exclude_data_sources || !exclude_data_agents)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAgentsDataSource_emptyPool(t *testing.T) {
+	poolName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_agents.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclAgentsDataSource(poolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "agents.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclAgentsDataSource(poolName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_agent_pool" "test" {
+  name           = "%s"
+  auto_provision = false
+  auto_update    = false
+}
+
+data "azuredevops_agents" "test" {
+  pool_id = azuredevops_agent_pool.test.id
+}
+`, poolName)
+}
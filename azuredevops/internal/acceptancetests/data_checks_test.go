@@ -0,0 +1,78 @@
+//go:build (all || data_sources || data_checks) && (!exclude_data_sources || !exclude_data_checks)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccChecksDataSource_environment(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_checks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclChecksDataSource(projectName, environmentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "checks.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "checks.*", map[string]string{
+						"type_name": "Approval",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "checks.*", map[string]string{
+						"display_name": "Business hours",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func hclChecksDataSource(projectName, environmentName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_client_config" "current" {
+}
+
+resource "azuredevops_environment" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+}
+
+resource "azuredevops_check_approval" "test" {
+  project_id           = azuredevops_project.project.id
+  target_resource_id   = azuredevops_environment.test.id
+  target_resource_type = "environment"
+
+  requester_can_approve = true
+  approvers             = [data.azuredevops_client_config.current.id]
+}
+
+resource "azuredevops_check_business_hours" "test" {
+  project_id           = azuredevops_project.project.id
+  target_resource_id   = azuredevops_environment.test.id
+  target_resource_type = "environment"
+  display_name         = "Business hours"
+  time_zone            = "UTC"
+  start_time           = "07:00"
+  end_time             = "15:30"
+  monday               = true
+}
+
+data "azuredevops_checks" "test" {
+  project_id           = azuredevops_project.project.id
+  target_resource_id   = azuredevops_environment.test.id
+  target_resource_type = "environment"
+
+  depends_on = [azuredevops_check_approval.test, azuredevops_check_business_hours.test]
+}
+`, testutils.HclProjectResource(projectName), environmentName)
+}
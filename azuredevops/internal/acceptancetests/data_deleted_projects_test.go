@@ -0,0 +1,37 @@
+//go:build (all || core || data_sources || data_deleted_projects) && (!exclude_data_sources || !exclude_data_deleted_projects)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDeletedProjectsDataSource_unknownName(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_deleted_projects.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeletedProjectsDataSource(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclDeletedProjectsDataSource(name string) string {
+	return fmt.Sprintf(`
+data "azuredevops_deleted_projects" "test" {
+  name = "%s"
+}`, name)
+}
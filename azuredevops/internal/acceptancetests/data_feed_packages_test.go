@@ -0,0 +1,74 @@
+//go:build (all || data_sources || data_feed_packages) && (!exclude_data_sources || !exclude_data_feed_packages)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccFeedPackagesDataSource_emptyFeed(t *testing.T) {
+	name := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_feed_packages.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedPackagesDataSourceEmptyFeed(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "packages.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFeedPackageDataSource_nuget(t *testing.T) {
+	tfNode := "data.azuredevops_feed_package.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_FEED_ID", "AZDO_TEST_NUGET_PACKAGE_NAME", "AZDO_TEST_NUGET_PACKAGE_VERSION"})
+		},
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedPackageDataSource(os.Getenv("AZDO_TEST_FEED_ID"), os.Getenv("AZDO_TEST_NUGET_PACKAGE_NAME")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "normalized_name"),
+					resource.TestCheckResourceAttrSet(tfNode, "latest_version"),
+					resource.TestCheckResourceAttrSet(tfNode, "versions.#"),
+				),
+			},
+		},
+	})
+}
+
+func hclFeedPackagesDataSourceEmptyFeed(name string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_feed" "test" {
+  name = "%s"
+}
+
+data "azuredevops_feed_packages" "test" {
+  feed_id              = azuredevops_feed.test.id
+  include_all_versions = true
+}
+`, name)
+}
+
+func hclFeedPackageDataSource(feedId string, packageName string) string {
+	return fmt.Sprintf(`
+data "azuredevops_feed_package" "test" {
+  feed_id       = "%s"
+  protocol_type = "nuget"
+  name          = "%s"
+}
+`, feedId, packageName)
+}
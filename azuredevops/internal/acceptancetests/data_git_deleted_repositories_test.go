@@ -0,0 +1,40 @@
+//go:build (all || data_sources || git || data_git_deleted_repositories) && (!exclude_data_sources || !exclude_data_git_deleted_repositories)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitDeletedRepositoriesDataSource_emptyRecycleBin(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_git_deleted_repositories.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitDeletedRepositoriesEmptyRecycleBin(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "repositories.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclGitDeletedRepositoriesEmptyRecycleBin(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_git_deleted_repositories" "test" {
+  project_id = azuredevops_project.project.id
+}
+`, testutils.HclProjectResource(projectName))
+}
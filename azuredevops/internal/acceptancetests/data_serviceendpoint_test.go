@@ -0,0 +1,70 @@
+//go:build (all || data_sources || data_serviceendpoint) && (!exclude_data_sources || !exclude_data_serviceendpoint)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpoint_dataSource_with_serviceEndpointID(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_serviceendpoint" "test" {
+  project_id          = azuredevops_project.project.id
+  service_endpoint_id = azuredevops_serviceendpoint_generic.test.id
+}
+`, testutils.HclServiceEndpointGenericResource(projectName, serviceEndpointName, "https://example.com", "username", "password"))
+
+	tfNode := "data.azuredevops_serviceendpoint.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "service_endpoint_name", serviceEndpointName),
+					resource.TestCheckResourceAttr(tfNode, "type", "generic"),
+					resource.TestCheckResourceAttr(tfNode, "url", "https://example.com"),
+					resource.TestCheckResourceAttr(tfNode, "authorization.scheme", "UsernamePassword"),
+					resource.TestCheckResourceAttrSet(tfNode, "is_ready"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceEndpoint_dataSource_with_serviceEndpointName(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_serviceendpoint" "test" {
+  project_id            = azuredevops_project.project.id
+  service_endpoint_name = azuredevops_serviceendpoint_generic.test.service_endpoint_name
+}
+`, testutils.HclServiceEndpointGenericResource(projectName, serviceEndpointName, "https://example.com", "username", "password"))
+
+	tfNode := "data.azuredevops_serviceendpoint.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "service_endpoint_id", "azuredevops_serviceendpoint_generic.test", "id"),
+					resource.TestCheckResourceAttr(tfNode, "type", "generic"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,40 @@
+//go:build (all || data_sources || data_serviceendpoint_usage) && (!exclude_data_sources || !exclude_data_serviceendpoint_usage)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointUsage_dataSource_unused(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_serviceendpoint_usage" "test" {
+  project_id          = azuredevops_project.project.id
+  service_endpoint_id = azuredevops_serviceendpoint_generic.test.id
+}
+`, testutils.HclServiceEndpointGenericResource(projectName, serviceEndpointName, "https://example.com", "username", "password"))
+
+	tfNode := "data.azuredevops_serviceendpoint_usage.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "last_used", ""),
+					resource.TestCheckResourceAttr(tfNode, "records.#", "0"),
+				),
+			},
+		},
+	})
+}
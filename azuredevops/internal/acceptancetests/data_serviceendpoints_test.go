@@ -0,0 +1,44 @@
+//go:build (all || data_sources || data_serviceendpoints) && (!exclude_data_sources || !exclude_data_serviceendpoints)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpoints_dataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_serviceendpoints" "test" {
+  project_id           = azuredevops_project.project.id
+  type                 = "generic"
+  authorization_scheme = "UsernamePassword"
+
+  depends_on = [azuredevops_serviceendpoint_generic.test]
+}
+`, testutils.HclServiceEndpointGenericResource(projectName, serviceEndpointName, "https://example.com", "username", "password"))
+
+	tfNode := "data.azuredevops_serviceendpoints.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.0.name", serviceEndpointName),
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.0.type", "generic"),
+					resource.TestCheckResourceAttrPair(tfNode, "service_endpoints.0.id", "azuredevops_serviceendpoint_generic.test", "id"),
+				),
+			},
+		},
+	})
+}
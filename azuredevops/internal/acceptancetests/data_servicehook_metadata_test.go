@@ -0,0 +1,36 @@
+//go:build (all || data_sources || data_servicehook_metadata) && (!exclude_data_sources || !exclude_data_servicehook_metadata)
+
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookMetadataDataSource_basic(t *testing.T) {
+	tfNode := "data.azuredevops_servicehook_metadata.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "azuredevops_servicehook_metadata" "test" {
+  publisher_id = "tfs"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "publishers.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "publishers.0.id", "tfs"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "publishers.0.event_types.*", map[string]string{
+						"id": "git.push",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "consumers.*", map[string]string{
+						"id": "webHooks",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,51 @@
+//go:build (all || data_sources || data_workitem_type) && (!exclude_data_sources || !exclude_data_workitem_type)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccWorkItemType_DataSource_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_workitem_type.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemTypeDataSourceBasic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", "Bug"),
+					resource.TestCheckResourceAttr(tfNode, "reference_name", "Microsoft.VSTS.WorkItemTypes.Bug"),
+					resource.TestCheckResourceAttrSet(tfNode, "color"),
+					resource.TestCheckResourceAttrSet(tfNode, "fields.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "fields.*", map[string]string{
+						"reference_name":   "Microsoft.VSTS.Common.Priority",
+						"allowed_values.#": "4",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "states.*", map[string]string{
+						"name":     "Active",
+						"category": "InProgress",
+					}),
+					resource.TestCheckResourceAttrSet(tfNode, "transitions.#"),
+				),
+			},
+		},
+	})
+}
+
+func hclWorkItemTypeDataSourceBasic(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_workitem_type" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "Bug"
+}
+`, testutils.HclProjectResource(projectName))
+}
@@ -0,0 +1,120 @@
+//go:build (all || data_sources || data_workitems) && (!exclude_data_sources || !exclude_data_workitems)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccWorkItems_DataSource_filters(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	title := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_workitems.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemsDataSourceFilters(projectName, title),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "ids.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "work_items.#", "2"),
+					resource.TestCheckResourceAttrSet(tfNode, "work_items.0.id"),
+					resource.TestCheckResourceAttrSet(tfNode, "work_items.0.url"),
+					resource.TestCheckResourceAttr(tfNode, "work_items.0.fields.System.WorkItemType", "User Story"),
+					resource.TestCheckResourceAttr(tfNode, "work_items.0.relations.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "work_items.0.relations.0.rel", "System.LinkTypes.Hierarchy-Reverse"),
+					resource.TestCheckResourceAttrPair(tfNode, "work_items.0.relations.0.target_id", "azuredevops_workitem.epic", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkItems_DataSource_query(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	title := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_workitems.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemsDataSourceQuery(projectName, title),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(tfNode, "ids.0", "azuredevops_workitem.epic", "id"),
+					resource.TestCheckResourceAttr(tfNode, "work_items.0.fields.%", "1"),
+					resource.TestCheckResourceAttr(tfNode, "work_items.0.fields.System.Title", title+" Epic"),
+					resource.TestCheckResourceAttr(tfNode, "work_items.0.relations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclWorkItemsDataSourceTemplate(projectName string, title string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "project" {
+  name               = "%[1]s"
+  description        = "%[1]s-description"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_workitem" "epic" {
+  project_id = azuredevops_project.project.id
+  title      = "%[2]s Epic"
+  type       = "Epic"
+}
+
+resource "azuredevops_workitem" "story" {
+  count      = 2
+  project_id = azuredevops_project.project.id
+  title      = "%[2]s Story ${count.index}"
+  type       = "User Story"
+  tags       = ["backlog"]
+  parent_id  = azuredevops_workitem.epic.id
+}
+
+resource "azuredevops_workitem" "untagged" {
+  project_id = azuredevops_project.project.id
+  title      = "%[2]s Untagged"
+  type       = "User Story"
+}
+`, projectName, title)
+}
+
+func hclWorkItemsDataSourceFilters(projectName string, title string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_workitems" "test" {
+  project_id        = azuredevops_project.project.id
+  type              = "User Story"
+  tags              = ["backlog"]
+  include_relations = true
+
+  depends_on = [azuredevops_workitem.story, azuredevops_workitem.untagged]
+}
+`, hclWorkItemsDataSourceTemplate(projectName, title))
+}
+
+func hclWorkItemsDataSourceQuery(projectName string, title string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_workitems" "test" {
+  project_id = azuredevops_project.project.id
+  query      = "SELECT [System.Id] FROM WorkItems WHERE [System.TeamProject] = @project AND [System.WorkItemType] = 'Epic'"
+  fields     = ["System.Title"]
+
+  depends_on = [azuredevops_workitem.story, azuredevops_workitem.untagged]
+}
+`, hclWorkItemsDataSourceTemplate(projectName, title))
+}
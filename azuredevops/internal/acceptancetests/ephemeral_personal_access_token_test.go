@@ -0,0 +1,61 @@
+//go:build (all || ephemeral_personal_access_token) && !exclude_ephemeral_personal_access_token
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccPersonalAccessToken_writeOnlyConsumer(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	variableGroupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_variable_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testutils.PreCheck(t, nil) },
+		ProtoV6ProviderFactories: testutils.GetProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclPersonalAccessTokenWriteOnlyConsumer(projectName, variableGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "secret_variable.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "secret_variable.0.name", "token"),
+					resource.TestCheckNoResourceAttr(tfNode, "secret_variable.0.value_wo"),
+				),
+			},
+		},
+	})
+}
+
+func hclPersonalAccessTokenWriteOnlyConsumer(projectName, variableGroupName string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azuredevops_personal_access_token" "test" {
+  display_name = "%[2]s"
+  scope        = ["vso.code"]
+  valid_to     = timeadd(plantimestamp(), "1h")
+}
+
+resource "azuredevops_variable_group" "test" {
+  project_id   = azuredevops_project.project.id
+  name         = "%[2]s"
+  allow_access = false
+
+  variable {
+    name  = "key1"
+    value = "value1"
+  }
+
+  secret_variable {
+    name             = "token"
+    value_wo         = ephemeral.azuredevops_personal_access_token.test.token
+    value_wo_version = 1
+  }
+}
+`, testutils.HclProjectResource(projectName), variableGroupName)
+}
@@ -0,0 +1,64 @@
+//go:build (all || resource_check_azure_function) && !exclude_approvalsandchecks
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccCheckAzureFunction_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	displayName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_check_azure_function.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckPipelineCheckDestroyed("azuredevops_check_azure_function"),
+		Steps: []resource.TestStep{
+			{
+				Config: hclCheckAzureFunctionResource(projectName, displayName, "Callback"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckPipelineCheckExistsWithName(tfCheckNode, displayName),
+					resource.TestCheckResourceAttr(tfCheckNode, "method", "POST"),
+					resource.TestCheckResourceAttr(tfCheckNode, "completion_event", "Callback"),
+				),
+			},
+			{
+				Config: hclCheckAzureFunctionResource(projectName, displayName, "ApiResponse"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckPipelineCheckExistsWithName(tfCheckNode, displayName),
+					resource.TestCheckResourceAttr(tfCheckNode, "completion_event", "ApiResponse"),
+				),
+			},
+		},
+	})
+}
+
+func hclCheckAzureFunctionResource(projectName, displayName, completionEvent string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+resource "azuredevops_environment" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+}
+
+resource "azuredevops_check_azure_function" "test" {
+  project_id           = azuredevops_project.test.id
+  target_resource_id   = azuredevops_environment.test.id
+  target_resource_type = "environment"
+
+  display_name     = "%[2]s"
+  function_url     = "https://example.azurewebsites.net/api/check"
+  function_key     = "dummy"
+  body             = "{\"planId\":\"$(system.PlanId)\"}"
+  completion_event = "%[3]s"
+}`, projectName, displayName, completionEvent)
+}
@@ -0,0 +1,73 @@
+//go:build (all || resource_check_azure_monitor_alerts) && !exclude_approvalsandchecks
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccCheckAzureMonitorAlerts_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	displayName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_check_azure_monitor_alerts.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckPipelineCheckDestroyed("azuredevops_check_azure_monitor_alerts"),
+		Steps: []resource.TestStep{
+			{
+				Config: hclCheckAzureMonitorAlertsResource(projectName, displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckPipelineCheckExistsWithName(tfCheckNode, displayName),
+					resource.TestCheckResourceAttr(tfCheckNode, "resource_group_name", "example-rg"),
+					resource.TestCheckResourceAttr(tfCheckNode, "time_range", "1d"),
+					resource.TestCheckResourceAttr(tfCheckNode, "severities.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func hclCheckAzureMonitorAlertsResource(projectName, displayName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+resource "azuredevops_environment" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+}
+
+resource "azuredevops_serviceendpoint_azurerm" "test" {
+  project_id            = azuredevops_project.test.id
+  service_endpoint_name = "%[2]s"
+  credentials {
+    serviceprincipalid  = "e318e66b-ec4b-4dff-9124-41129b9d7150"
+    serviceprincipalkey = "d9d210dd-f9f0-4176-afb8-a4df60e1ae72"
+  }
+  azurerm_spn_tenantid      = "9c59cbe5-2ca1-4516-b303-8968a070edd2"
+  azurerm_subscription_id   = "3b0fee91-c36d-4d70-b1e9-fc4b9d608c3d"
+  azurerm_subscription_name = "Microsoft Azure DEMO"
+  features {
+    validate = false
+  }
+}
+
+resource "azuredevops_check_azure_monitor_alerts" "test" {
+  project_id           = azuredevops_project.test.id
+  target_resource_id   = azuredevops_environment.test.id
+  target_resource_type = "environment"
+
+  display_name                  = "%[2]s"
+  azurerm_service_connection_id = azuredevops_serviceendpoint_azurerm.test.id
+  resource_group_name           = "example-rg"
+  severities                    = ["Sev0", "Sev1"]
+  time_range                    = "1d"
+}`, projectName, displayName)
+}
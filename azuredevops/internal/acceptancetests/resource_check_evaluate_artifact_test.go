@@ -0,0 +1,61 @@
+//go:build (all || resource_check_evaluate_artifact) && !exclude_approvalsandchecks
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccCheckEvaluateArtifact_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	displayName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_check_evaluate_artifact.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckPipelineCheckDestroyed("azuredevops_check_evaluate_artifact"),
+		Steps: []resource.TestStep{
+			{
+				Config: hclCheckEvaluateArtifactResource(projectName, displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckPipelineCheckExistsWithName(tfCheckNode, displayName),
+					resource.TestCheckResourceAttr(tfCheckNode, "artifact_type", "container"),
+					resource.TestCheckResourceAttrSet(tfCheckNode, "policy"),
+				),
+			},
+		},
+	})
+}
+
+func hclCheckEvaluateArtifactResource(projectName, displayName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+resource "azuredevops_environment" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[2]s"
+}
+
+resource "azuredevops_check_evaluate_artifact" "test" {
+  project_id           = azuredevops_project.test.id
+  target_resource_id   = azuredevops_environment.test.id
+  target_resource_type = "environment"
+
+  display_name = "%[2]s"
+  policy       = <<-EOT
+    package imageprovenance
+    checkBuilder[errors] {
+      trace("Check if the image was built by Azure Pipelines")
+      not input.provenance
+      errors := "No provenance found"
+    }
+  EOT
+}`, projectName, displayName)
+}
@@ -0,0 +1,73 @@
+//go:build (all || permissions || resource_delivery_plan_permissions) && (!exclude_permissions || !exclude_resource_delivery_plan_permissions)
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/datahelper"
+)
+
+func TestAccDeliveryPlanPermissions_SetPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+	planName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_delivery_plan_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeliveryPlanPermissions(projectName, teamName, planName, map[string]string{
+					"View":   "allow",
+					"Edit":   "allow",
+					"Delete": "deny",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "delivery_plan_id"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Edit", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Delete", "deny"),
+				),
+			},
+			{
+				Config: hclDeliveryPlanPermissions(projectName, teamName, planName, map[string]string{
+					"View":   "allow",
+					"Edit":   "notset",
+					"Delete": "notset",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Edit", "notset"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Delete", "notset"),
+				),
+			},
+		},
+	})
+}
+
+func hclDeliveryPlanPermissions(projectName string, teamName string, planName string, permissions map[string]string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_group" "tf-project-readers" {
+  project_id = azuredevops_project.project.id
+  name       = "Readers"
+}
+
+resource "azuredevops_delivery_plan_permissions" "permissions" {
+  project_id       = azuredevops_project.project.id
+  delivery_plan_id = azuredevops_delivery_plan.test.id
+  principal        = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+    %s
+  }
+}
+`, hclDeliveryPlan(projectName, teamName, planName, ""), datahelper.JoinMap(permissions, "=", "\n"))
+}
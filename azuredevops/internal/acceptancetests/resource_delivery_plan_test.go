@@ -0,0 +1,104 @@
+//go:build (all || resource_delivery_plan) && !exclude_resource_delivery_plan
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDeliveryPlan_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+	planName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_delivery_plan.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeliveryPlan(projectName, teamName, planName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", planName),
+					resource.TestCheckResourceAttr(tfNode, "team.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "team.0.backlog_level", "Microsoft.RequirementCategory"),
+					resource.TestCheckResourceAttrSet(tfNode, "revision"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeliveryPlan_update(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+	planName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_delivery_plan.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeliveryPlan(projectName, teamName, planName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "marker.#", "0"),
+				),
+			},
+			{
+				Config: hclDeliveryPlan(projectName, teamName, planName+"-updated", `
+  criteria {
+    field_name = "System.Tags"
+    operator   = "contains"
+    value      = "release"
+  }
+
+  marker {
+    date  = "2030-01-31"
+    label = "Release"
+    color = "#FF0000"
+  }
+
+  card {
+    show_tags                  = false
+    assigned_to_display_format = "avatarOnly"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", planName+"-updated"),
+					resource.TestCheckResourceAttr(tfNode, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "marker.0.date", "2030-01-31"),
+					resource.TestCheckResourceAttr(tfNode, "card.0.show_tags", "false"),
+					resource.TestCheckResourceAttr(tfNode, "card.0.assigned_to_display_format", "avatarOnly"),
+				),
+			},
+		},
+	})
+}
+
+func hclDeliveryPlan(projectName string, teamName string, planName string, settings string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_delivery_plan" "test" {
+  project_id = azuredevops_team.team.project_id
+  name       = "%s"
+
+  team {
+    team_id       = azuredevops_team.team.id
+    backlog_level = "Microsoft.RequirementCategory"
+  }
+%s
+}
+`, testutils.HclTeamConfiguration(projectName, teamName, "", nil, nil), planName, settings)
+}
@@ -0,0 +1,185 @@
+//go:build (all || resource_deployment_group) && !exclude_resource_deployment_group
+
+package acceptancetests
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func TestAccDeploymentGroup_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkDeploymentGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeploymentGroupBasic(projectName, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", name),
+					resource.TestCheckResourceAttr(tfNode, "description", ""),
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "pool_id"),
+					resource.TestCheckResourceAttr(tfNode, "machine_count", "0"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeploymentGroup_update(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+	nameUpdated := testutils.GenerateResourceName()
+	tfNode := "azuredevops_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkDeploymentGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeploymentGroupBasic(projectName, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", name),
+				),
+			},
+			{
+				Config: hclDeploymentGroupBasic(projectName, nameUpdated, "Managed by Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", nameUpdated),
+					resource.TestCheckResourceAttr(tfNode, "description", "Managed by Terraform"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeploymentGroup_existingPool(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkDeploymentGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeploymentGroupWithPool(projectName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", name),
+					resource.TestCheckResourceAttrPair(tfNode, "pool_id", "azuredevops_agent_pool.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeploymentGroupTargetsDataSource_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_deployment_group_targets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeploymentGroupTargetsDataSource(projectName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "targets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func checkDeploymentGroupDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_deployment_group" {
+			continue
+		}
+
+		id, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Deployment Group ID=%s cannot be parsed!. Error=%v", res.Primary.ID, err)
+		}
+
+		group, err := clients.TaskAgentClient.GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+			Project:           converter.String(res.Primary.Attributes["project_id"]),
+			DeploymentGroupId: &id,
+		})
+		if err == nil && group != nil && group.Id != nil {
+			return fmt.Errorf("Deployment Group ID %d should not exist", id)
+		}
+	}
+	return nil
+}
+
+func hclDeploymentGroupBasic(projectName, name, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_deployment_group" "test" {
+  project_id  = azuredevops_project.project.id
+  name        = "%s"
+  description = "%s"
+}
+`, testutils.HclProjectResource(projectName), name, description)
+}
+
+func hclDeploymentGroupWithPool(projectName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_agent_pool" "test" {
+  name      = "%[2]s"
+  pool_type = "deployment"
+}
+
+resource "azuredevops_deployment_group" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%[2]s"
+  pool_id    = azuredevops_agent_pool.test.id
+}
+`, testutils.HclProjectResource(projectName), name)
+}
+
+func hclDeploymentGroupTargetsDataSource(projectName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_deployment_group_targets" "test" {
+  project_id          = azuredevops_project.project.id
+  deployment_group_id = azuredevops_deployment_group.test.id
+}
+`, hclDeploymentGroupBasic(projectName, name, ""))
+}
@@ -0,0 +1,71 @@
+//go:build (all || resource_feed_package_version) && !exclude_resource_feed_package_version
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// TestAccFeedPackageVersion_nuget promotes an existing NuGet package version to the Release view and unlists it.
+// Promotion can not be reverted, the package version is left in the feed after the test.
+func TestAccFeedPackageVersion_nuget(t *testing.T) {
+	feedId := os.Getenv("AZDO_TEST_FEED_ID")
+	packageName := os.Getenv("AZDO_TEST_NUGET_PACKAGE_NAME")
+	version := os.Getenv("AZDO_TEST_NUGET_PACKAGE_VERSION")
+
+	tfNode := "azuredevops_feed_package_version.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_FEED_ID", "AZDO_TEST_NUGET_PACKAGE_NAME", "AZDO_TEST_NUGET_PACKAGE_VERSION"})
+		},
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedPackageVersionNuGet(feedId, packageName, version, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "package_id"),
+					resource.TestCheckResourceAttr(tfNode, "views.#", "1"),
+					resource.TestCheckTypeSetElemAttr(tfNode, "views.*", "Release"),
+					resource.TestCheckResourceAttr(tfNode, "listed", "true"),
+				),
+			},
+			{
+				Config: hclFeedPackageVersionNuGet(feedId, packageName, version, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "listed", "false"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/nuget/%s/%s", feedId, packageName, version),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "views"},
+			},
+			{
+				Config: hclFeedPackageVersionNuGet(feedId, packageName, version, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "listed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func hclFeedPackageVersionNuGet(feedId string, packageName string, version string, listed bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_feed_package_version" "test" {
+  feed_id       = "%s"
+  protocol_type = "nuget"
+  package_name  = "%s"
+  version       = "%s"
+  views         = ["Release"]
+  listed        = %t
+}
+`, feedId, packageName, version, listed)
+}
@@ -127,6 +127,52 @@ func TestAccFeed_requiresImportErrorProject(t *testing.T) {
 	})
 }
 
+func TestAccFeed_upstreamSourcesAndViews(t *testing.T) {
+	feedName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_feed.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkFeedDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclFeedUpstreamSources(feedName, "collection"),
+				Check: resource.ComposeTestCheckFunc(
+					CheckFeedExist(feedName),
+					resource.TestCheckResourceAttr(tfNode, "upstream_source.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_source.0.type", "public"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_source.0.location", "https://api.nuget.org/v3/index.json"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_source.1.type", "internal"),
+					resource.TestCheckResourceAttrPair(tfNode, "upstream_source.1.feed_id", "azuredevops_feed.upstream", "id"),
+					resource.TestCheckResourceAttr(tfNode, "view.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "view.0.name", "Release"),
+					resource.TestCheckResourceAttr(tfNode, "view.0.visibility", "collection"),
+					resource.TestCheckResourceAttr(tfNode, "view.1.name", "Candidate"),
+					resource.TestCheckResourceAttrSet(tfNode, "view.1.id"),
+				),
+			},
+			{
+				Config: hclFeedUpstreamSources(feedName, "organization"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "view.0.visibility", "organization"),
+				),
+			},
+			{
+				// Removing all blocks clears the upstream sources and deletes the views
+				Config: hclFeedBasic(feedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "upstream_source.#", "0"),
+					resource.TestCheckResourceAttr(tfNode, "view.#", "0"),
+				),
+			},
+			{
+				Config:   hclFeedBasic(feedName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func checkFeedDestroyed(s *terraform.State) error {
 	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
 	for _, res := range s.RootModule().Resources {
@@ -232,3 +278,44 @@ resource "azuredevops_feed" "import" {
 }
 `, hclFeedWithProject(projectName, feedName))
 }
+
+func hclFeedUpstreamSources(name string, visibility string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_feed" "upstream" {
+  name = "%[1]s-upstream"
+
+  view {
+    name       = "Release"
+    visibility = "collection"
+  }
+}
+
+resource "azuredevops_feed" "test" {
+  name                     = "%[1]s"
+  allow_upstream_overrides = true
+
+  upstream_source {
+    name     = "nuget.org"
+    protocol = "nuget"
+    location = "https://api.nuget.org/v3/index.json"
+  }
+
+  upstream_source {
+    name     = "%[1]s-upstream@Release"
+    protocol = "nuget"
+    feed_id  = azuredevops_feed.upstream.id
+    view_id  = one([for view in azuredevops_feed.upstream.view : view.id if view.name == "Release"])
+  }
+
+  view {
+    name       = "Release"
+    visibility = "%[2]s"
+  }
+
+  view {
+    name       = "Candidate"
+    visibility = "private"
+  }
+}
+`, name, visibility)
+}
@@ -399,6 +399,56 @@ func TestAccGitRepository_privateUserNamePasswordImportBranchNotEmpty(t *testing
 }
 
 // or not the definition (1) exists in the state and (2) exist in AzDO and (3) has the correct name
+func TestAccGitRepository_restoreIfSoftDeleted(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfRepoNode := "azuredevops_git_repository.test"
+	var repoID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepositoryRecycleBin(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					func(s *terraform.State) error {
+						repoID = s.RootModule().Resources[tfRepoNode].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: hclGitDeletedRepositoriesDataSource(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azuredevops_git_deleted_repositories.test", "repositories.#", "1"),
+					resource.TestCheckResourceAttr("data.azuredevops_git_deleted_repositories.test", "repositories.0.name", gitRepoName),
+				),
+			},
+			{
+				Config: hclGitRepositoryRecycleBin(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[tfRepoNode].Primary.ID; id != repoID {
+							return fmt.Errorf("Expected the deleted repository %s to be restored, got repository %s", repoID, id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: hclGitDeletedRepositoriesDataSource(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azuredevops_git_deleted_repositories.test", "repositories.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func checkGitRepoExists(expectedName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
@@ -494,6 +544,37 @@ resource "azuredevops_git_repository" "test" {
 `, projectName, repoName, initType)
 }
 
+func hclGitRepositoryRecycleBin(projectName, repoName string, purgeOnDestroy bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id              = azuredevops_project.test.id
+  name                    = "%s"
+  restore_if_soft_deleted = true
+  purge_on_destroy        = %t
+  initialization {
+    init_type = "Clean"
+  }
+}
+`, projectName, repoName, purgeOnDestroy)
+}
+
+func hclGitDeletedRepositoriesDataSource(projectName, repoName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+data "azuredevops_git_deleted_repositories" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%s"
+}
+`, projectName, repoName)
+}
+
 func hclGitRepositoryWithDefaultBranch(projectName, repoName, initType string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
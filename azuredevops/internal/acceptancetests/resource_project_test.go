@@ -208,6 +208,53 @@ func TestAccProject_requireImportError(t *testing.T) {
 	})
 }
 
+func TestAccProject_restoreIfSoftDeleted(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_project.test"
+	var projectId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclProjectRestore(projectName, "initial", false),
+				Check: resource.ComposeTestCheckFunc(
+					checkProjectExists(projectName),
+					func(s *terraform.State) error {
+						projectId = s.RootModule().Resources[tfNode].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "azuredevops_deleted_projects" "test" {
+  name = "%s"
+}`, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azuredevops_deleted_projects.test", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.azuredevops_deleted_projects.test", "projects.0.name", projectName),
+				),
+			},
+			{
+				Config: hclProjectRestore(projectName, "restored", true),
+				Check: resource.ComposeTestCheckFunc(
+					checkProjectExists(projectName),
+					resource.TestCheckResourceAttr(tfNode, "description", "restored"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[tfNode].Primary.ID; id != projectId {
+							return fmt.Errorf("Expected the deleted project %s to be restored, got project %s", projectId, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func checkProjectExists(expectedName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		state, ok := s.RootModule().Resources["azuredevops_project.test"]
@@ -286,6 +333,16 @@ resource "azuredevops_project" "test" {
 }`, projectName, projectName, testPlans, stateArtifacts)
 }
 
+func hclProjectRestore(name string, description string, permanentDelete bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name                    = "%s"
+  description             = "%s"
+  restore_if_soft_deleted = true
+  permanent_delete        = %t
+}`, name, description, permanentDelete)
+}
+
 func hclProjectImport(name string) string {
 	template := hclProjectBasic(name)
 	return fmt.Sprintf(`
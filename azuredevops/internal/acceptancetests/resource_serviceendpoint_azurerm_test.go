@@ -263,6 +263,51 @@ func TestAccServiceEndpointAzureRm_WorkloadFederation_Manual_CreateAndUpdate(t *
 	})
 }
 
+// validates that a service principal service endpoint is converted to workload identity federation without being recreated
+func TestAccServiceEndpointAzureRm_ConvertServicePrincipalToWorkloadFederation(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	serviceprincipalid := uuid.New().String()
+	serviceprincipalkey := uuid.New().String()
+
+	resourceType := "azuredevops_serviceendpoint_azurerm"
+	tfSvcEpNode := resourceType + ".serviceendpointrm"
+	var serviceEndpointID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclServiceEndpointAzureRMResource(projectName, serviceEndpointName, serviceprincipalid, serviceprincipalkey, "ServicePrincipal"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_authentication_scheme", "ServicePrincipal"),
+					resource.TestCheckResourceAttrWith(tfSvcEpNode, "id", func(value string) error {
+						serviceEndpointID = value
+						return nil
+					}),
+				),
+			}, {
+				Config: testutils.HclServiceEndpointAzureRMNoKeyResource(projectName, serviceEndpointName, serviceprincipalid, "WorkloadIdentityFederation"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_authentication_scheme", "WorkloadIdentityFederation"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "credentials.0.serviceprincipalid", serviceprincipalid),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "workload_identity_federation_issuer"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "workload_identity_federation_subject"),
+					resource.TestCheckResourceAttrWith(tfSvcEpNode, "id", func(value string) error {
+						if value != serviceEndpointID {
+							return fmt.Errorf("service endpoint was recreated, ID changed from %s to %s", serviceEndpointID, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 // validates that an automatic workload federation service endpoint can be created and updated
 func TestAccServiceEndpointAzureRm_WorkloadFederation_Automatic_CreateAndUpdate(t *testing.T) {
 	projectName := testutils.GenerateResourceName()
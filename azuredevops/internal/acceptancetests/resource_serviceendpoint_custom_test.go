@@ -0,0 +1,80 @@
+//go:build (all || resource_serviceendpoint_custom) && !exclude_serviceendpoints
+
+package acceptancetests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpointCustom_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resourceType := "azuredevops_serviceendpoint_custom"
+	tfSvcEpNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckServiceEndpointDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclSvcEndpointCustomResource(projectName, serviceEndpointName, "UsernamePassword"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckServiceEndpointExistsWithName(tfSvcEpNode, serviceEndpointName),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "type", "generic"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://some-server.example.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authorization_scheme", "UsernamePassword"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authorization.scheme", "UsernamePassword"),
+				),
+			},
+			{
+				ResourceName:            tfSvcEpNode,
+				ImportState:             true,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfSvcEpNode),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authorization_parameters"},
+			},
+		},
+	})
+}
+
+func TestAccServiceEndpointCustom_unsupportedScheme(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      hclSvcEndpointCustomResource(projectName, serviceEndpointName, "WorkloadIdentityFederation"),
+				ExpectError: regexp.MustCompile(`Authorization scheme WorkloadIdentityFederation is not supported by service endpoint type generic`),
+			},
+		},
+	})
+}
+
+func hclSvcEndpointCustomResource(projectName string, serviceEndpointName string, scheme string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_custom" "test" {
+  project_id            = azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  description           = "Managed by Terraform"
+  type                  = "generic"
+  url                   = "https://some-server.example.com"
+  authorization_scheme  = "%s"
+  authorization_parameters = {
+    username = "username"
+    password = "password"
+  }
+}`, serviceEndpointName, scheme)
+
+	projectResource := testutils.HclProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
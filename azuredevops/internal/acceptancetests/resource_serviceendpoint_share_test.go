@@ -0,0 +1,143 @@
+//go:build (all || resource_serviceendpoint_share) && !exclude_serviceendpoints
+
+package acceptancetests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func TestAccServiceEndpointShare_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	sharedProjectName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_serviceendpoint_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkServiceEndpointShareDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclServiceEndpointShare(projectName, sharedProjectName, serviceEndpointName, "https://some-server.example.com", "shared"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "service_endpoint_id", "azuredevops_serviceendpoint_generic.test", "id"),
+					resource.TestCheckResourceAttrPair(tfNode, "project_id", "azuredevops_project.shared", "id"),
+					resource.TestCheckResourceAttrPair(tfNode, "owner_project_id", "azuredevops_project.project", "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", serviceEndpointName+"-shared"),
+					resource.TestCheckResourceAttr(tfNode, "description", "shared"),
+				),
+			},
+			{
+				Config: hclServiceEndpointShare(projectName, sharedProjectName, serviceEndpointName, "https://some-server.example.com", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "description", "updated"),
+				),
+			},
+			{
+				// Updating the owning service endpoint must keep its share with the other project
+				Config: hclServiceEndpointShare(projectName, sharedProjectName, serviceEndpointName, "https://other-server.example.com", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_serviceendpoint_generic.test", "server_url", "https://other-server.example.com"),
+					checkServiceEndpointShareExists(tfNode),
+				),
+			},
+			{
+				Config:   hclServiceEndpointShare(projectName, sharedProjectName, serviceEndpointName, "https://other-server.example.com", "updated"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: serviceEndpointShareImportStateIdFunc(tfNode, "project_id"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: serviceEndpointShareImportStateIdFunc(tfNode, "owner_project_id"),
+				ExpectError:       regexp.MustCompile("owns service endpoint"),
+			},
+		},
+	})
+}
+
+// serviceEndpointShareImportStateIdFunc builds the import ID of the share, taking the shared project from projectAttribute
+func serviceEndpointShareImportStateIdFunc(tfNode string, projectAttribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return "", fmt.Errorf("Did not find a resource in the TF state: %s", tfNode)
+		}
+		return fmt.Sprintf("%s/%s/%s", res.Primary.Attributes["owner_project_id"], res.Primary.Attributes[projectAttribute], res.Primary.Attributes["service_endpoint_id"]), nil
+	}
+}
+
+// checkServiceEndpointShareExists verifies that the shared project still sees the service endpoint
+func checkServiceEndpointShareExists(tfNode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return fmt.Errorf("Did not find a resource in the TF state: %s", tfNode)
+		}
+
+		clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+		serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			Project:    converter.String(res.Primary.Attributes["project_id"]),
+			EndpointId: converter.UUID(res.Primary.Attributes["service_endpoint_id"]),
+		})
+		if err != nil {
+			return err
+		}
+		if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+			return fmt.Errorf("Service endpoint %s is no longer shared with project %s", res.Primary.Attributes["service_endpoint_id"], res.Primary.Attributes["project_id"])
+		}
+		return nil
+	}
+}
+
+// checkServiceEndpointShareDestroyed verifies that the shared projects no longer see the service endpoint
+func checkServiceEndpointShareDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_serviceendpoint_share" {
+			continue
+		}
+
+		serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			Project:    converter.String(res.Primary.Attributes["project_id"]),
+			EndpointId: converter.UUID(res.Primary.Attributes["service_endpoint_id"]),
+		})
+		if err == nil && serviceEndpoint != nil && serviceEndpoint.Id != nil {
+			return fmt.Errorf("Service endpoint %s is still shared with project %s", res.Primary.Attributes["service_endpoint_id"], res.Primary.Attributes["project_id"])
+		}
+	}
+	return nil
+}
+
+func hclServiceEndpointShare(projectName, sharedProjectName, serviceEndpointName, serverUrl, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_project" "shared" {
+  name = "%s"
+}
+
+resource "azuredevops_serviceendpoint_share" "test" {
+  service_endpoint_id = azuredevops_serviceendpoint_generic.test.id
+  project_id          = azuredevops_project.shared.id
+  owner_project_id    = azuredevops_project.project.id
+  name                = "%s-shared"
+  description         = "%s"
+}
+`, testutils.HclServiceEndpointGenericResource(projectName, serviceEndpointName, serverUrl, "username", "password"), sharedProjectName, serviceEndpointName, description)
+}
@@ -0,0 +1,117 @@
+//go:build (all || resource_servicehook_azure_service_bus) && !exclude_subscriptions
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookAzureServiceBus_queue(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_servicehook_azure_service_bus.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, &[]string{"AZDO_TEST_SERVICE_BUS_CONNECTION_STRING"}) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkServicehookAzureServiceBusDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookAzureServiceBusResourceQueue(projectName, os.Getenv("AZDO_TEST_SERVICE_BUS_CONNECTION_STRING")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfCheckNode, "project_id"),
+					resource.TestCheckResourceAttr(tfCheckNode, "queue_name", "testqueue"),
+					resource.TestCheckResourceAttr(tfCheckNode, "build_completed_event.0.build_status", "Failed"),
+				),
+			},
+			{
+				ResourceName:            tfCheckNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"connection_string"},
+			},
+		},
+	})
+}
+
+func TestAccServicehookAzureServiceBus_topic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_servicehook_azure_service_bus.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, &[]string{"AZDO_TEST_SERVICE_BUS_CONNECTION_STRING"}) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkServicehookAzureServiceBusDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookAzureServiceBusResourceTopic(projectName, os.Getenv("AZDO_TEST_SERVICE_BUS_CONNECTION_STRING")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfCheckNode, "project_id"),
+					resource.TestCheckResourceAttr(tfCheckNode, "topic_name", "testtopic"),
+					resource.TestCheckResourceAttr(tfCheckNode, "send_as_non_serialized_string", "true"),
+					resource.TestCheckResourceAttr(tfCheckNode, "git_pull_request_created_event.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func checkServicehookAzureServiceBusDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuredevops_servicehook_azure_service_bus" {
+			continue
+		}
+
+		if _, err := getServicehookSubscriptionFromResource(rs); err == nil {
+			return fmt.Errorf("Unexpectedly found a service hook subscription that should be deleted")
+		}
+	}
+
+	return nil
+}
+
+func hclServicehookAzureServiceBusResourceQueue(projectName string, connectionString string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  work_item_template = "Agile"
+  version_control    = "Git"
+  visibility         = "private"
+}
+
+resource "azuredevops_servicehook_azure_service_bus" "test" {
+  project_id        = azuredevops_project.test.id
+  connection_string = "%s"
+  queue_name        = "testqueue"
+
+  build_completed_event {
+    build_status = "Failed"
+  }
+}
+`, projectName, connectionString)
+}
+
+func hclServicehookAzureServiceBusResourceTopic(projectName string, connectionString string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  work_item_template = "Agile"
+  version_control    = "Git"
+  visibility         = "private"
+}
+
+resource "azuredevops_servicehook_azure_service_bus" "test" {
+  project_id                    = azuredevops_project.test.id
+  connection_string             = "%s"
+  topic_name                    = "testtopic"
+  send_as_non_serialized_string = true
+
+  git_pull_request_created_event {}
+}
+`, projectName, connectionString)
+}
@@ -4,6 +4,7 @@ package acceptancetests
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -67,6 +68,22 @@ func TestAccServicehookSubscription_update(t *testing.T) {
 	})
 }
 
+func TestAccServicehookSubscription_invalidInputs(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkServicehookSubscriptionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config:      hclServicehookSubscriptionResourceInvalidInputs(projectName),
+				ExpectError: regexp.MustCompile("`consumer_inputs` is missing inputs required by action httpRequest of consumer webHooks: url"),
+			},
+		},
+	})
+}
+
 func checkServicehookSubscriptionDestroyed(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azuredevops_servicehook_subscription" {
@@ -152,3 +169,26 @@ resource "azuredevops_servicehook_subscription" "test" {
 }
 `, projectName)
 }
+
+func hclServicehookSubscriptionResourceInvalidInputs(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  work_item_template = "Agile"
+  version_control    = "Git"
+  visibility         = "private"
+}
+
+resource "azuredevops_servicehook_subscription" "test" {
+  project_id         = azuredevops_project.test.id
+  publisher_id       = "tfs"
+  event_type         = "workitem.created"
+  consumer_id        = "webHooks"
+  consumer_action_id = "httpRequest"
+
+  consumer_inputs = {
+    uri = "https://example.com/webhook"
+  }
+}
+`, projectName)
+}
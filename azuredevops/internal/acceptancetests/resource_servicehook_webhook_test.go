@@ -0,0 +1,129 @@
+//go:build (all || resource_servicehook_webhook) && !exclude_subscriptions
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServicehookWebhook_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_servicehook_webhook.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkServicehookWebhookDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookWebhookResourceGitPush(projectName, "https://example.com/webhook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfCheckNode, "project_id"),
+					resource.TestCheckResourceAttr(tfCheckNode, "url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr(tfCheckNode, "basic_auth_username", "user"),
+					resource.TestCheckResourceAttr(tfCheckNode, "git_push_event.0.branch", "refs/heads/main"),
+				),
+			},
+			{
+				ResourceName:            tfCheckNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"basic_auth_password", "http_headers"},
+			},
+		},
+	})
+}
+
+func TestAccServicehookWebhook_update(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfCheckNode := "azuredevops_servicehook_webhook.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkServicehookWebhookDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclServicehookWebhookResourceGitPush(projectName, "https://example.com/webhook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfCheckNode, "url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr(tfCheckNode, "git_push_event.#", "1"),
+				),
+			},
+			{
+				Config: hclServicehookWebhookResourceWorkItemUpdated(projectName, "https://example.com/updated-webhook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfCheckNode, "url", "https://example.com/updated-webhook"),
+					resource.TestCheckResourceAttr(tfCheckNode, "git_push_event.#", "0"),
+					resource.TestCheckResourceAttr(tfCheckNode, "work_item_updated_event.0.work_item_type", "Bug"),
+					resource.TestCheckResourceAttr(tfCheckNode, "work_item_updated_event.0.changed_fields", "System.State"),
+				),
+			},
+		},
+	})
+}
+
+func checkServicehookWebhookDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuredevops_servicehook_webhook" {
+			continue
+		}
+
+		if _, err := getServicehookSubscriptionFromResource(rs); err == nil {
+			return fmt.Errorf("Unexpectedly found a service hook subscription that should be deleted")
+		}
+	}
+
+	return nil
+}
+
+func hclServicehookWebhookResourceGitPush(projectName string, url string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  work_item_template = "Agile"
+  version_control    = "Git"
+  visibility         = "private"
+}
+
+resource "azuredevops_servicehook_webhook" "test" {
+  project_id          = azuredevops_project.test.id
+  url                 = "%s"
+  basic_auth_username = "user"
+  basic_auth_password = "password"
+  http_headers = {
+    X-Test = "value"
+  }
+
+  git_push_event {
+    branch = "refs/heads/main"
+  }
+}
+`, projectName, url)
+}
+
+func hclServicehookWebhookResourceWorkItemUpdated(projectName string, url string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%s"
+  work_item_template = "Agile"
+  version_control    = "Git"
+  visibility         = "private"
+}
+
+resource "azuredevops_servicehook_webhook" "test" {
+  project_id = azuredevops_project.test.id
+  url        = "%s"
+
+  work_item_updated_event {
+    work_item_type = "Bug"
+    changed_fields = "System.State"
+  }
+}
+`, projectName, url)
+}
@@ -0,0 +1,200 @@
+//go:build (all || resource_task_group) && !exclude_resource_task_group
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func TestAccTaskGroup_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_task_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkTaskGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclTaskGroupBasic(projectName, name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", name),
+					resource.TestCheckResourceAttr(tfNode, "major_version", "1"),
+					resource.TestCheckResourceAttr(tfNode, "task.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "input.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "revision"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"comment", "disable_prior_versions"},
+			},
+		},
+	})
+}
+
+func TestAccTaskGroup_newMajorVersion(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_task_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkTaskGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclTaskGroupBasic(projectName, name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "major_version", "1"),
+				),
+			},
+			{
+				Config: hclTaskGroupBasic(projectName, name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "major_version", "2"),
+					resource.TestCheckResourceAttr(tfNode, "version", "2.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTaskGroup_buildDefinitionReference(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+	tfNode := "azuredevops_build_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkTaskGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclTaskGroupBuildDefinition(projectName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "jobs.0.task.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "jobs.0.task.0.definition_type", "metaTask"),
+					resource.TestCheckResourceAttrPair(tfNode, "jobs.0.task.0.task_id", "azuredevops_task_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func checkTaskGroupDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_task_group" {
+			continue
+		}
+
+		id, err := uuid.Parse(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Task Group ID=%s cannot be parsed!. Error=%v", res.Primary.ID, err)
+		}
+
+		taskGroups, err := clients.TaskAgentClient.GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+			Project:     converter.String(res.Primary.Attributes["project_id"]),
+			TaskGroupId: &id,
+		})
+		if err == nil && taskGroups != nil {
+			for _, taskGroup := range *taskGroups {
+				if !converter.ToBool(taskGroup.Deleted, false) {
+					return fmt.Errorf("Task Group ID %s should not exist", id)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func hclTaskGroupBasic(projectName, name string, majorVersion int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_task_group" "test" {
+  project_id    = azuredevops_project.project.id
+  name          = "%s"
+  description   = "Managed by Terraform"
+  category      = "Utility"
+  runs_on       = ["Agent"]
+  major_version = %d
+
+  input {
+    name          = "message"
+    default_value = "hello"
+  }
+
+  task {
+    task_id      = "d9bafed4-0b18-4f58-968d-86655b4d2ce9" # CmdLine
+    version      = "2.*"
+    display_name = "Echo"
+    inputs = {
+      script = "echo $(message)"
+    }
+  }
+}
+`, testutils.HclProjectResource(projectName), name, majorVersion)
+}
+
+func hclTaskGroupBuildDefinition(projectName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_build_definition" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%[2]s"
+
+  repository {
+    repo_type   = "TfsGit"
+    repo_id     = azuredevops_git_repository.test.id
+    branch_name = azuredevops_git_repository.test.default_branch
+  }
+
+  jobs {
+    name      = "Agent job1"
+    ref_name  = "agent_job1"
+    condition = "succeeded()"
+    target {
+      type = "AgentJob"
+      execution_options {
+        type = "None"
+      }
+    }
+
+    task {
+      task_id         = azuredevops_task_group.test.id
+      version         = "1.*"
+      definition_type = "metaTask"
+      display_name    = "Run task group"
+      inputs = {
+        message = "from build"
+      }
+    }
+  }
+}
+`, hclTaskGroupBasic(projectName, name, 1), name)
+}
@@ -0,0 +1,140 @@
+//go:build (all || resource_team_board) && !exclude_resource_team_board
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccTeamBoard_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_team_board.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclTeamBoard(projectName, teamName, `
+  column {
+    name           = "New"
+    state_mappings = { "User Story" = "New" }
+  }
+  column {
+    name               = "Active"
+    item_limit         = 5
+    split              = true
+    definition_of_done = "Reviewed"
+    state_mappings     = { "User Story" = "Active" }
+  }
+  column {
+    name           = "Closed"
+    state_mappings = { "User Story" = "Closed" }
+  }
+
+  row {
+    name  = "Expedite"
+    color = "#FF0000"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "column.#", "3"),
+					resource.TestCheckResourceAttr(tfNode, "column.0.column_type", "incoming"),
+					resource.TestCheckResourceAttr(tfNode, "column.1.item_limit", "5"),
+					resource.TestCheckResourceAttr(tfNode, "column.1.split", "true"),
+					resource.TestCheckResourceAttr(tfNode, "column.2.column_type", "outgoing"),
+					resource.TestCheckResourceAttr(tfNode, "row.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "row.0.id"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"card"},
+			},
+		},
+	})
+}
+
+func TestAccTeamBoard_cards(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_team_board.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclTeamBoard(projectName, teamName, `
+  card {
+    work_item_type = "User Story"
+    field {
+      reference_name = "System.Id"
+    }
+    field {
+      reference_name = "System.Title"
+    }
+    field {
+      reference_name = "System.AssignedTo"
+      display_format = "AvatarOnly"
+    }
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "card.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "card.0.field.#", "3"),
+					resource.TestCheckResourceAttr(tfNode, "card.0.field.2.display_format", "AvatarOnly"),
+				),
+			},
+			{
+				Config: hclTeamBoard(projectName, teamName, `
+  card_style_rule {
+    name             = "Blocked"
+    background_color = "#FFCCCC"
+    title_bold       = true
+    clause {
+      field_name = "System.Tags"
+      operator   = "contains"
+      value      = "Blocked"
+    }
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "card.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "card_style_rule.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "card_style_rule.0.title_bold", "true"),
+					resource.TestCheckResourceAttr(tfNode, "card_style_rule.0.clause.0.value", "Blocked"),
+				),
+			},
+		},
+	})
+}
+
+// hclTeamBoard hides the bugs from the backlog, so that the columns only have to map the user story states
+func hclTeamBoard(projectName string, teamName string, board string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_team_settings" "test" {
+  project_id    = azuredevops_team.team.project_id
+  team_id       = azuredevops_team.team.id
+  bugs_behavior = "off"
+}
+
+resource "azuredevops_team_board" "test" {
+  project_id = azuredevops_team.team.project_id
+  team_id    = azuredevops_team.team.id
+  board      = "Stories"
+%s
+
+  depends_on = [azuredevops_team_settings.test]
+}
+`, testutils.HclTeamConfiguration(projectName, teamName, "", nil, nil), board)
+}
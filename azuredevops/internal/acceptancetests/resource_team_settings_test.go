@@ -0,0 +1,105 @@
+//go:build (all || resource_team_settings) && !exclude_resource_team_settings
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccTeamSettings_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_team_settings.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclTeamSettings(projectName, teamName, `
+  working_days  = ["monday", "tuesday", "wednesday", "thursday"]
+  bugs_behavior = "asTasks"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "working_days.#", "4"),
+					resource.TestCheckResourceAttr(tfNode, "bugs_behavior", "asTasks"),
+					resource.TestCheckResourceAttrSet(tfNode, "default_iteration_macro"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTeamSettings_update(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_team_settings.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclTeamSettings(projectName, teamName, `
+  bugs_behavior = "asRequirements"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "bugs_behavior", "asRequirements"),
+				),
+			},
+			{
+				Config: hclTeamSettings(projectName, teamName, `
+  bugs_behavior           = "off"
+  default_iteration_macro = "@currentIteration + 1"
+  backlog_visibilities = {
+    "Microsoft.EpicCategory" = false
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "bugs_behavior", "off"),
+					resource.TestCheckResourceAttr(tfNode, "default_iteration_macro", "@currentIteration + 1"),
+					resource.TestCheckResourceAttr(tfNode, "backlog_visibilities.%", "1"),
+					resource.TestCheckResourceAttr(tfNode, "backlog_visibilities.Microsoft.EpicCategory", "false"),
+				),
+			},
+			{
+				Config: hclTeamSettings(projectName, teamName, `
+  working_days  = []
+  bugs_behavior = "off"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "working_days.#", "0"),
+				),
+			},
+			{
+				Config: hclTeamSettings(projectName, teamName, `
+  working_days  = []
+  bugs_behavior = "off"
+`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func hclTeamSettings(projectName string, teamName string, settings string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_team_settings" "test" {
+  project_id = azuredevops_team.team.project_id
+  team_id    = azuredevops_team.team.id
+%s
+}
+`, testutils.HclTeamConfiguration(projectName, teamName, "", nil, nil), settings)
+}
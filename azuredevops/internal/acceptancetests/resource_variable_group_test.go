@@ -145,6 +145,36 @@ func TestAccVariableGroup_secretValue(t *testing.T) {
 	})
 }
 
+func TestAccVariableGroup_writeOnlySecretVariable(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	vgName := testutils.GenerateResourceName()
+	tfVarGroupNode := "azuredevops_variable_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkVariableGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclVariableGroupWriteOnlySecretVariable(projectName, vgName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					checkVariableGroupExists(vgName, true),
+					resource.TestCheckResourceAttr(tfVarGroupNode, "variable.#", "1"),
+					resource.TestCheckResourceAttr(tfVarGroupNode, "secret_variable.#", "1"),
+					resource.TestCheckResourceAttr(tfVarGroupNode, "secret_variable.0.name", "key2"),
+					resource.TestCheckNoResourceAttr(tfVarGroupNode, "secret_variable.0.value_wo"),
+				),
+			},
+			{
+				Config: hclVariableGroupWriteOnlySecretVariable(projectName, vgName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfVarGroupNode, "secret_variable.0.value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVariableGroup_keyVault_basic(t *testing.T) {
 	if os.Getenv("TEST_SERVICE_PRINCIPAL_ID") == "" || os.Getenv("TEST_SERVICE_PRINCIPAL_KEY") == "" ||
 		os.Getenv("TEST_ARM_TENANT_ID") == "" || os.Getenv("TEST_ARM_SUBSCRIPTION_ID") == "" ||
@@ -336,6 +366,29 @@ resource "azuredevops_variable_group" "test" {
 }`, projectName, variableGroupName)
 }
 
+func hclVariableGroupWriteOnlySecretVariable(projectName, variableGroupName string, version int) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+resource "azuredevops_variable_group" "test" {
+  project_id   = azuredevops_project.test.id
+  name         = "%s"
+  description  = "test description"
+  allow_access = true
+  variable {
+    name  = "key1"
+    value = "value1"
+  }
+  secret_variable {
+    name             = "key2"
+    value_wo         = "value2-%[3]d"
+    value_wo_version = %[3]d
+  }
+}`, projectName, variableGroupName, version)
+}
+
 func hclVariableGroupAzureKeyVault(projectName, variableGroupName string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
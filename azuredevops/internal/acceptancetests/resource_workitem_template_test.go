@@ -0,0 +1,99 @@
+//go:build (all || resource_workitem_template) && !exclude_resource_workitem_template
+
+package acceptancetests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccWorkItemTemplate_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_workitem_template.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemTemplate(projectName, teamName, `
+    "System.Title" = "Incident: "
+    "System.Tags"  = "incident"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "work_item_type", "Bug"),
+					resource.TestCheckResourceAttr(tfNode, "fields.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "fields.System.Tags", "incident"),
+				),
+			},
+			{
+				Config: hclWorkItemTemplate(projectName, teamName, `
+    "System.Tags"                     = "incident; production"
+    "Microsoft.VSTS.Common.Priority"  = "1"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "fields.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "fields.Microsoft.VSTS.Common.Priority", "1"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: workItemTemplateImportId(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkItemTemplate_unknownField(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemTemplate(projectName, teamName, `
+    "Custom.DoesNotExist" = "value"
+`),
+				ExpectError: regexp.MustCompile(`not defined by work item type Bug: Custom.DoesNotExist`),
+			},
+		},
+	})
+}
+
+func workItemTemplateImportId(node string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res, ok := s.RootModule().Resources[node]
+		if !ok {
+			return "", fmt.Errorf(" Resource %s not found in state", node)
+		}
+		return fmt.Sprintf("%s/%s/%s", res.Primary.Attributes["project_id"], res.Primary.Attributes["team_id"], res.Primary.ID), nil
+	}
+}
+
+func hclWorkItemTemplate(projectName string, teamName string, fields string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_workitem_template" "test" {
+  project_id     = azuredevops_team.team.project_id
+  team_id        = azuredevops_team.team.id
+  work_item_type = "Bug"
+  name           = "New bug from production incident"
+  description    = "Managed by Terraform"
+
+  fields = {
+%s
+  }
+}
+`, testutils.HclTeamConfiguration(projectName, teamName, "", nil, nil), fields)
+}
@@ -4,6 +4,7 @@ package acceptancetests
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -260,6 +261,34 @@ func TestAccWorkItem_parentDelete(t *testing.T) {
 	})
 }
 
+func TestAccWorkItem_invalidMetadata(t *testing.T) {
+	workItemTitle := testutils.GenerateResourceName()
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_workitem.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: workItemBasic(projectName, workItemTitle),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "title", workItemTitle),
+				),
+			},
+			{
+				Config:      workItemState(projectName, workItemTitle, "Resolved"),
+				ExpectError: regexp.MustCompile(`State Resolved is not defined by work item type Issue`),
+			},
+			{
+				Config:      workItemCustomField(projectName, workItemTitle, "NotExisting", "value"),
+				ExpectError: regexp.MustCompile("`custom_fields` contains fields which are not defined by work item type Issue: NotExisting"),
+			},
+		},
+	})
+}
+
 func workItemTemplate(name string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "project" {
@@ -363,3 +392,33 @@ resource "azuredevops_workitem" "test" {
 }
 `, template, title)
 }
+
+func workItemState(projectNane string, title string, state string) string {
+	template := workItemTemplate(projectNane)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_workitem" "test" {
+  title      = "%s"
+  project_id = azuredevops_project.project.id
+  type       = "Issue"
+  state      = "%s"
+}
+`, template, title, state)
+}
+
+func workItemCustomField(projectNane string, title string, name string, value string) string {
+	template := workItemTemplate(projectNane)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_workitem" "test" {
+  title      = "%s"
+  project_id = azuredevops_project.project.id
+  type       = "Issue"
+  custom_fields = {
+    %s = "%s"
+  }
+}
+`, template, title, name, value)
+}
@@ -1,11 +1,13 @@
 package testutils
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +32,19 @@ func GetProviderFactories() map[string]func() (*schema.Provider, error) {
 	}
 }
 
+// GetProtoV6ProviderFactories returns the provider factories serving the SDKv2 and framework providers muxed over protocol v6
+func GetProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"azuredevops": func() (tfprotov6.ProviderServer, error) {
+			providerServer, err := azuredevops.ProviderServer(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return providerServer(), nil
+		},
+	}
+}
+
 // GetProviders returns a map of all providers needed for the project
 func GetProviders() map[string]*schema.Provider {
 	return map[string]*schema.Provider{
@@ -18,23 +18,33 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/maven"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/memberentitlementmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/operations"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinepermissions"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelines"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelineschecks"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pypiapi"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/universal"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/dashboardextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/feedextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/organization"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/securityroles"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/taskagentextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/tokens"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workextras"
 	"github.com/microsoft/terraform-provider-azuredevops/version"
 )
 
@@ -65,12 +75,22 @@ type AggregatedClient struct {
 	ReleaseClient                 release.Client
 	ServiceEndpointClient         serviceendpoint.Client
 	TaskAgentClient               taskagent.Client
+	TaskAgentClientExtras         taskagentextras.Client
+	TokensClient                  tokens.Client
 	MemberEntitleManagementClient memberentitlementmanagement.Client
 	FeatureManagementClient       featuremanagement.Client
 	FeedClient                    feed.Client
+	FeedClientExtras              feedextras.Client
+	NuGetClient                   nuget.Client
+	NpmClient                     npm.Client
+	MavenClient                   maven.Client
+	PyPiClient                    pypiapi.Client
+	UPackClient                   universal.Client
 	SecurityClient                security.Client
 	IdentityClient                identity.Client
 	WikiClient                    wiki.Client
+	WorkClient                    work.Client
+	WorkClientExtras              workextras.Client
 	WorkItemTrackingClient        workitemtracking.Client
 	ServiceHooksClient            servicehooks.Client
 	Ctx                           context.Context
@@ -141,6 +161,12 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		return nil, err
 	}
 
+	taskagentClientExtras, err := taskagentextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): taskagentextras.NewClient failed.")
+		return nil, err
+	}
+
 	gitReposClient, err := git.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): git.NewClient failed.")
@@ -192,6 +218,54 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		return nil, err
 	}
 
+	feedClientExtras, err := feedextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): feedextras.NewClient failed.")
+		return nil, err
+	}
+
+	nugetClient, err := nuget.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): nuget.NewClient failed.")
+		return nil, err
+	}
+
+	npmClient, err := npm.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): npm.NewClient failed.")
+		return nil, err
+	}
+
+	mavenClient, err := maven.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): maven.NewClient failed.")
+		return nil, err
+	}
+
+	pypiClient, err := pypiapi.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): pypiapi.NewClient failed.")
+		return nil, err
+	}
+
+	upackClient, err := universal.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): universal.NewClient failed.")
+		return nil, err
+	}
+
+	workClient, err := work.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): work.NewClient failed.")
+		return nil, err
+	}
+
+	workClientExtras, err := workextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): workextras.NewClient failed.")
+		return nil, err
+	}
+
 	workitemtrackingClient, err := workitemtracking.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): workitemtracking.NewClient failed.")
@@ -222,6 +296,8 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 
 	securityRolesClient := securityroles.NewClient(ctx, connection)
 
+	tokensClient := tokens.NewClient(ctx, connection)
+
 	aggregatedClient := &AggregatedClient{
 		OrganizationURL:               organizationURL,
 		CoreClient:                    coreClient,
@@ -242,12 +318,22 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		ReleaseClient:                 releaseClient,
 		ServiceEndpointClient:         serviceEndpointClient,
 		TaskAgentClient:               taskagentClient,
+		TaskAgentClientExtras:         taskagentClientExtras,
+		TokensClient:                  tokensClient,
 		MemberEntitleManagementClient: memberentitlementmanagementClient,
 		FeatureManagementClient:       featuremanagementClient,
 		FeedClient:                    feedClient,
+		FeedClientExtras:              feedClientExtras,
+		NuGetClient:                   nugetClient,
+		NpmClient:                     npmClient,
+		MavenClient:                   mavenClient,
+		PyPiClient:                    pypiClient,
+		UPackClient:                   upackClient,
 		SecurityClient:                securityClient,
 		IdentityClient:                identityClient,
 		WikiClient:                    wikiClient,
+		WorkClient:                    workClient,
+		WorkClientExtras:              workClientExtras,
 		WorkItemTrackingClient:        workitemtrackingClient,
 		ServiceHooksClient:            serviceHooksClient,
 		SecurityRolesClient:           securityRolesClient,
@@ -61,6 +61,24 @@ type JobTarget struct {
 	AllowScriptsAuthAccessOption *bool                `json:"allowScriptsAuthAccessOption,omitempty"`
 }
 
+type JobStepTask struct {
+	Id             *string `json:"id,omitempty"`
+	VersionSpec    *string `json:"versionSpec,omitempty"`
+	DefinitionType *string `json:"definitionType,omitempty"`
+}
+
+type JobStep struct {
+	DisplayName      *string            `json:"displayName,omitempty"`
+	Enabled          *bool              `json:"enabled,omitempty"`
+	AlwaysRun        *bool              `json:"alwaysRun,omitempty"`
+	ContinueOnError  *bool              `json:"continueOnError,omitempty"`
+	Condition        *string            `json:"condition,omitempty"`
+	TimeoutInMinutes *int               `json:"timeoutInMinutes,omitempty"`
+	Task             *JobStepTask       `json:"task,omitempty"`
+	Inputs           *map[string]string `json:"inputs,omitempty"`
+	Environment      *map[string]string `json:"environment,omitempty"`
+}
+
 type PipelineJob struct {
 	Name                      *string          `json:"name,omitempty"`
 	RefName                   *string          `json:"refName,omitempty"`
@@ -70,4 +88,5 @@ type PipelineJob struct {
 	JobTimeoutInMinutes       *int             `json:"jobTimeoutInMinutes,omitempty"`
 	JobCancelTimeoutInMinutes *int             `json:"jobCancelTimeoutInMinutes,omitempty"`
 	JobAuthorizationScope     *string          `json:"JobAuthorizationScope,omitempty"`
+	Steps                     *[]JobStep       `json:"steps,omitempty"`
 }
@@ -231,3 +231,27 @@ func doBaseFlattening(d *schema.ResourceData, check *pipelineschecksextras.Check
 
 	return nil
 }
+
+// expandCheckRetryInterval validates and sets the `retry_interval` of checks that invoke a remote endpoint
+func expandCheckRetryInterval(d *schema.ResourceData, settings map[string]interface{}, completionEvent string) error {
+	v, ok := d.GetOk("retry_interval")
+	if !ok {
+		return nil
+	}
+
+	// There is no need to retry a Callback check. https://devblogs.microsoft.com/devops/updates-to-approvals-and-checks/
+	retryInterval := v.(int)
+	if completionEvent == string(CompleteEventValues.Callback) {
+		return fmt.Errorf("Does not need to set `retry_interval` when `completion_event=Callback`.")
+	}
+
+	timeout := d.Get("timeout").(int)
+	minRetryInterval := timeout / 10
+	if minRetryInterval > retryInterval {
+		return fmt.Errorf("We require you enter a value of 0 or at least %d,"+
+			" to keep the number of retries below 10. Starting Autumn 2023, non-compliant "+
+			"checks will fail automatically. Timeout: %d, retryInterval: %d", minRetryInterval, timeout, retryInterval)
+	}
+	settings["retryInterval"] = retryInterval
+	return nil
+}
@@ -0,0 +1,155 @@
+package approvalsandchecks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+)
+
+// DataChecks schema and implementation for the checks configured on a protected resource
+func DataChecks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataChecksRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"target_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"target_resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(targetResourceTypes, false),
+			},
+			"checks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"definition_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"settings": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataChecksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	resource := pipelineschecksextras.Resource{
+		Id:   converter.String(d.Get("target_resource_id").(string)),
+		Type: converter.String(d.Get("target_resource_type").(string)),
+	}
+
+	checks, err := clients.PipelinesChecksClientExtras.QueryCheckConfigurationsOnResources(ctx, pipelineschecksextras.QueryCheckConfigurationsOnResourcesArgs{
+		Project:   converter.String(projectID),
+		Resources: &[]pipelineschecksextras.Resource{resource},
+		Expand:    converter.ToPtr(pipelineschecksextras.CheckConfigurationExpandParameterValues.Settings),
+	})
+	if err != nil {
+		return diag.Errorf(" Querying checks on %s %s. Project ID: %s, Error: %+v", *resource.Type, *resource.Id, projectID, err)
+	}
+
+	flattened, err := flattenChecks(checks)
+	if err != nil {
+		return diag.Errorf(" Flattening checks: %+v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", projectID, *resource.Type, *resource.Id))
+	if err := d.Set("checks", flattened); err != nil {
+		return diag.Errorf(" Setting checks: %+v", err)
+	}
+	return nil
+}
+
+func flattenChecks(checks *[]pipelineschecksextras.CheckConfiguration) ([]interface{}, error) {
+	if checks == nil {
+		return []interface{}{}, nil
+	}
+
+	results := make([]interface{}, 0, len(*checks))
+	for _, check := range *checks {
+		output := map[string]interface{}{
+			"timeout": converter.ToInt(check.Timeout, 0),
+			"version": converter.ToInt(check.Version, 0),
+		}
+		if check.Id != nil {
+			output["id"] = *check.Id
+		}
+		if check.Type != nil {
+			if check.Type.Id != nil {
+				output["type_id"] = check.Type.Id.String()
+			}
+			output["type_name"] = converter.ToString(check.Type.Name, "")
+		}
+		if check.Settings != nil {
+			settingsJson, err := json.Marshal(check.Settings)
+			if err != nil {
+				return nil, fmt.Errorf("marshalling settings of check %d: %+v", converter.ToInt(check.Id, 0), err)
+			}
+			output["settings"] = string(settingsJson)
+
+			if settings, ok := check.Settings.(map[string]interface{}); ok {
+				if v, ok := settings["displayName"].(string); ok {
+					output["display_name"] = v
+				}
+				if definitionRef, ok := settings["definitionRef"].(map[string]interface{}); ok {
+					if v, ok := definitionRef["name"].(string); ok {
+						output["definition_name"] = v
+					}
+				}
+			}
+		}
+		results = append(results, output)
+	}
+	return results, nil
+}
@@ -0,0 +1,106 @@
+//go:build (all || data_sources || data_checks) && (!exclude_data_sources || !exclude_data_checks)
+// +build all data_sources data_checks
+// +build !exclude_data_sources !exclude_data_checks
+
+package approvalsandchecks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	dataChecksProjectID   = uuid.New().String()
+	dataChecksEnvironment = pipelineschecksextras.Resource{
+		Id:   converter.String("42"),
+		Type: converter.String("environment"),
+	}
+)
+
+func TestDataChecks_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinesCheckClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	checks := []pipelineschecksextras.CheckConfiguration{
+		{
+			Id:       converter.Int(1),
+			Type:     approvalAndCheckType.Approval,
+			Timeout:  converter.Int(43200),
+			Version:  converter.Int(2),
+			Resource: &dataChecksEnvironment,
+			Settings: map[string]interface{}{
+				"instructions": "Approve production",
+			},
+		},
+		{
+			Id:       converter.Int(2),
+			Type:     approvalAndCheckType.TaskCheck,
+			Timeout:  converter.Int(1440),
+			Resource: &dataChecksEnvironment,
+			Settings: map[string]interface{}{
+				"displayName":   "Business hours",
+				"definitionRef": evaluateBusinessHoursDef,
+			},
+		},
+	}
+
+	pipelinesCheckClient.
+		EXPECT().
+		QueryCheckConfigurationsOnResources(clients.Ctx, pipelineschecksextras.QueryCheckConfigurationsOnResourcesArgs{
+			Project:   &dataChecksProjectID,
+			Resources: &[]pipelineschecksextras.Resource{dataChecksEnvironment},
+			Expand:    converter.ToPtr(pipelineschecksextras.CheckConfigurationExpandParameterValues.Settings),
+		}).
+		Return(&checks, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataChecks().Schema, nil)
+	resourceData.Set("project_id", dataChecksProjectID)
+	resourceData.Set("target_resource_id", "42")
+	resourceData.Set("target_resource_type", "environment")
+
+	diags := dataChecksRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, 2, resourceData.Get("checks.#"))
+	require.Equal(t, "Approval", resourceData.Get("checks.0.type_name"))
+	require.Equal(t, 43200, resourceData.Get("checks.0.timeout"))
+	require.JSONEq(t, `{"instructions":"Approve production"}`, resourceData.Get("checks.0.settings").(string))
+	require.Equal(t, "Business hours", resourceData.Get("checks.1.display_name"))
+	require.Equal(t, "evaluateBusinessHours", resourceData.Get("checks.1.definition_name"))
+}
+
+func TestDataChecks_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinesCheckClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	pipelinesCheckClient.
+		EXPECT().
+		QueryCheckConfigurationsOnResources(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("QueryCheckConfigurationsOnResources() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataChecks().Schema, nil)
+	resourceData.Set("project_id", dataChecksProjectID)
+	resourceData.Set("target_resource_id", "42")
+	resourceData.Set("target_resource_type", "environment")
+
+	diags := dataChecksRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "QueryCheckConfigurationsOnResources() Failed")
+}
@@ -0,0 +1,225 @@
+package approvalsandchecks
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+)
+
+// azureFunctionDef also backs the "Invoke REST API via Azure Function" variant, which
+// Azure DevOps models as this definition with the ApiResponse completion event.
+var azureFunctionDef = map[string]interface{}{
+	"id":      "537fdb7a-a601-4537-aa70-92645a2b5ce4",
+	"name":    "AzureFunction",
+	"version": "1.220.0",
+}
+
+// ResourceCheckAzureFunction schema and implementation for Invoke Azure Function check resources
+func ResourceCheckAzureFunction() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckAzureFunction, expandCheckAzureFunction)
+
+	maps.Copy(r.Schema, map[string]*schema.Schema{
+		"display_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"function_url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"function_key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"method": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "POST",
+			ValidateFunc: validation.StringInSlice([]string{
+				"OPTIONS", "GET", "HEAD", "POST", "PUT", "DELETE", "TRACE", "PATCH",
+			}, false),
+		},
+
+		"headers": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"query_parameters": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"body": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"completion_event": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(CompleteEventValues.Callback), string(CompleteEventValues.ApiResponse),
+			}, false),
+			Default: string(CompleteEventValues.Callback),
+		},
+
+		"success_criteria": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"retry_interval": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"variable_group_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1440,
+			ValidateFunc: validation.IntBetween(1, 43200),
+		},
+	})
+
+	return r
+}
+
+func flattenCheckAzureFunction(d *schema.ResourceData, check *pipelineschecksextras.CheckConfiguration, projectID string) error {
+	err := doBaseFlattening(d, check, projectID)
+	if err != nil {
+		return err
+	}
+
+	if check.Timeout != nil {
+		d.Set("timeout", *check.Timeout)
+	}
+
+	if check.Settings == nil {
+		return fmt.Errorf("settings nil")
+	}
+
+	settings := check.Settings.(map[string]interface{})
+	if v, ok := settings["displayName"]; ok {
+		d.Set("display_name", v.(string))
+	}
+
+	if v, exist := settings["retryInterval"]; exist {
+		d.Set("retry_interval", v.(float64))
+	}
+
+	if v, exist := settings["linkedVariableGroup"]; exist {
+		d.Set("variable_group_name", v.(string))
+	}
+
+	if v, ok := settings["inputs"]; ok {
+		inputs := v.(map[string]interface{})
+		if v, exist := inputs["function"]; exist {
+			d.Set("function_url", v.(string))
+		}
+
+		// the function key is returned masked, keep the configured value
+		if v, exist := inputs["key"]; exist && !strings.HasPrefix(v.(string), "***") {
+			d.Set("function_key", v.(string))
+		}
+
+		if v, exist := inputs["method"]; exist {
+			d.Set("method", v.(string))
+		}
+
+		if v, exist := inputs["headers"]; exist {
+			d.Set("headers", v.(string))
+		}
+
+		if v, exist := inputs["queryParameters"]; exist {
+			d.Set("query_parameters", v.(string))
+		}
+
+		if v, exist := inputs["body"]; exist {
+			d.Set("body", v.(string))
+		}
+
+		if v, exist := inputs["waitForCompletion"]; exist {
+			waitForCompletion, err := strconv.ParseBool(v.(string))
+			if err != nil {
+				return fmt.Errorf("parsing `waitForCompletion`: %v", err)
+			}
+			d.Set("completion_event", string(CompleteEventValues.Callback))
+			if !waitForCompletion {
+				d.Set("completion_event", string(CompleteEventValues.ApiResponse))
+			}
+		}
+
+		if v, exist := inputs["successCriteria"]; exist {
+			d.Set("success_criteria", v.(string))
+		}
+	}
+	return nil
+}
+
+func expandCheckAzureFunction(d *schema.ResourceData) (*pipelineschecksextras.CheckConfiguration, string, error) {
+	settings := map[string]interface{}{
+		"definitionRef": azureFunctionDef,
+		"displayName":   d.Get("display_name").(string),
+	}
+
+	input := map[string]interface{}{
+		"function": d.Get("function_url").(string),
+		"key":      d.Get("function_key").(string),
+		"method":   d.Get("method").(string),
+	}
+
+	if v, ok := d.GetOk("headers"); ok {
+		input["headers"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("query_parameters"); ok {
+		input["queryParameters"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("body"); ok {
+		input["body"] = v.(string)
+	}
+
+	completionEvent := d.Get("completion_event").(string)
+	input["waitForCompletion"] = "true"
+	if strings.EqualFold(completionEvent, string(CompleteEventValues.ApiResponse)) {
+		input["waitForCompletion"] = "false"
+		if v, ok := d.GetOk("success_criteria"); ok {
+			input["successCriteria"] = v.(string)
+		}
+	}
+	settings["inputs"] = input
+
+	if err := expandCheckRetryInterval(d, settings, completionEvent); err != nil {
+		return nil, "", err
+	}
+
+	if v, ok := d.GetOk("variable_group_name"); ok {
+		settings["linkedVariableGroup"] = v.(string)
+	}
+	return doBaseExpansion(d, approvalAndCheckType.TaskCheck, settings, converter.ToPtr(d.Get("timeout").(int)))
+}
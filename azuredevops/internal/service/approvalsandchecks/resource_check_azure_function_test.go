@@ -0,0 +1,85 @@
+//go:build (all || resource_check_azure_function) && !exclude_approvalsandchecks
+// +build all resource_check_azure_function
+// +build !exclude_approvalsandchecks
+
+package approvalsandchecks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	CheckAzureFunctionID        = 123456789
+	CheckAzureFunctionProjectID = uuid.New().String()
+)
+
+var CheckAzureFunctionTest = pipelineschecksextras.CheckConfiguration{
+	Id:   &CheckAzureFunctionID,
+	Type: approvalAndCheckType.TaskCheck,
+	Settings: map[string]interface{}{
+		"definitionRef": azureFunctionDef,
+		"displayName":   "Invoke Azure Function",
+		"inputs": map[string]interface{}{
+			"function":          "https://example.azurewebsites.net/api/check",
+			"key":               "secret",
+			"method":            "POST",
+			"body":              "{\"id\":\"$(system.PlanId)\"}",
+			"waitForCompletion": "false",
+			"successCriteria":   "eq(root['status'], 'ok')",
+		},
+	},
+	Timeout: converter.ToPtr(1440),
+	Resource: &pipelineschecksextras.Resource{
+		Id:   converter.String(uuid.New().String()),
+		Type: converter.String("endpoint"),
+	},
+	Version: converter.Int(0),
+}
+
+// verifies that the flatten/expand round trip yields the same check
+func TestCheckAzureFunction_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckAzureFunction().Schema, nil)
+	resourceData.SetId("123456789")
+	require.Nil(t, flattenCheckAzureFunction(resourceData, &CheckAzureFunctionTest, CheckAzureFunctionProjectID))
+
+	check, projectID, err := expandCheckAzureFunction(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, CheckAzureFunctionTest, *check)
+	require.Equal(t, CheckAzureFunctionProjectID, projectID)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestCheckAzureFunction_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceCheckAzureFunction()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId("123456789")
+	require.Nil(t, flattenCheckAzureFunction(resourceData, &CheckAzureFunctionTest, CheckAzureFunctionProjectID))
+
+	pipelinesCheckClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	expectedArgs := pipelineschecksextras.AddCheckConfigurationArgs{Configuration: &CheckAzureFunctionTest, Project: &CheckAzureFunctionProjectID}
+	pipelinesCheckClient.
+		EXPECT().
+		AddCheckConfiguration(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("AddCheckConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "AddCheckConfiguration() Failed")
+}
@@ -0,0 +1,204 @@
+package approvalsandchecks
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+)
+
+var azureMonitorDef = map[string]interface{}{
+	"id":      "b7f7f9e8-07ac-4d7f-a447-9d6d2ca5e2d8",
+	"name":    "AzureMonitor",
+	"version": "1.220.0",
+}
+
+// ResourceCheckAzureMonitorAlerts schema and implementation for Query Azure Monitor Alerts check resources
+func ResourceCheckAzureMonitorAlerts() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckAzureMonitorAlerts, expandCheckAzureMonitorAlerts)
+
+	maps.Copy(r.Schema, map[string]*schema.Schema{
+		"display_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"azurerm_service_connection_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"resource_group_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"alert_rule": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"severities": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Sev0", "Sev1", "Sev2", "Sev3", "Sev4"}, false),
+			},
+		},
+
+		"time_range": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1h",
+			ValidateFunc: validation.StringInSlice([]string{"1h", "1d", "7d", "30d"}, false),
+		},
+
+		"alert_states": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"New", "Acknowledged", "Closed"}, false),
+			},
+		},
+
+		"monitor_condition": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "Fired",
+			ValidateFunc: validation.StringInSlice([]string{"Fired", "Resolved"}, false),
+		},
+
+		"retry_interval": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1440,
+			ValidateFunc: validation.IntBetween(1, 43200),
+		},
+	})
+
+	return r
+}
+
+func flattenCheckAzureMonitorAlerts(d *schema.ResourceData, check *pipelineschecksextras.CheckConfiguration, projectID string) error {
+	err := doBaseFlattening(d, check, projectID)
+	if err != nil {
+		return err
+	}
+
+	if check.Timeout != nil {
+		d.Set("timeout", *check.Timeout)
+	}
+
+	if check.Settings == nil {
+		return fmt.Errorf("settings nil")
+	}
+
+	settings := check.Settings.(map[string]interface{})
+	if v, ok := settings["displayName"]; ok {
+		d.Set("display_name", v.(string))
+	}
+
+	if v, exist := settings["retryInterval"]; exist {
+		d.Set("retry_interval", v.(float64))
+	}
+
+	if v, ok := settings["inputs"]; ok {
+		inputs := v.(map[string]interface{})
+		if v, exist := inputs["connectedServiceNameARM"]; exist {
+			d.Set("azurerm_service_connection_id", v.(string))
+		}
+
+		if v, exist := inputs["ResourceGroupName"]; exist {
+			d.Set("resource_group_name", v.(string))
+		}
+
+		d.Set("alert_rule", "")
+		if v, exist := inputs["alertRule"]; exist {
+			d.Set("alert_rule", v.(string))
+		}
+
+		if v, exist := inputs["severity"]; exist {
+			d.Set("severities", splitCheckInputList(v.(string)))
+		}
+
+		if v, exist := inputs["timeRange"]; exist {
+			d.Set("time_range", v.(string))
+		}
+
+		if v, exist := inputs["alertState"]; exist {
+			d.Set("alert_states", splitCheckInputList(v.(string)))
+		}
+
+		if v, exist := inputs["monitorCondition"]; exist {
+			d.Set("monitor_condition", v.(string))
+		}
+	}
+	return nil
+}
+
+func expandCheckAzureMonitorAlerts(d *schema.ResourceData) (*pipelineschecksextras.CheckConfiguration, string, error) {
+	settings := map[string]interface{}{
+		"definitionRef": azureMonitorDef,
+		"displayName":   d.Get("display_name").(string),
+	}
+
+	severities := []string{"Sev0", "Sev1", "Sev2", "Sev3", "Sev4"}
+	if v, ok := d.GetOk("severities"); ok {
+		severities = tfhelper.ExpandStringSet(v.(*schema.Set))
+	}
+
+	alertStates := []string{"New", "Acknowledged"}
+	if v, ok := d.GetOk("alert_states"); ok {
+		alertStates = tfhelper.ExpandStringSet(v.(*schema.Set))
+	}
+
+	input := map[string]interface{}{
+		"connectedServiceNameARM": d.Get("azurerm_service_connection_id").(string),
+		"ResourceGroupName":       d.Get("resource_group_name").(string),
+		"filterType":              "none",
+		"severity":                strings.Join(severities, ","),
+		"timeRange":               d.Get("time_range").(string),
+		"alertState":              strings.Join(alertStates, ","),
+		"monitorCondition":        d.Get("monitor_condition").(string),
+	}
+	if v, ok := d.GetOk("alert_rule"); ok {
+		input["filterType"] = "alertrule"
+		input["alertRule"] = v.(string)
+	}
+	settings["inputs"] = input
+
+	if v, ok := d.GetOk("retry_interval"); ok {
+		settings["retryInterval"] = v.(int)
+	}
+	return doBaseExpansion(d, approvalAndCheckType.TaskCheck, settings, converter.ToPtr(d.Get("timeout").(int)))
+}
+
+// splitCheckInputList splits a comma separated check input into its values
+func splitCheckInputList(input string) []string {
+	values := []string{}
+	for _, value := range strings.Split(input, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,87 @@
+//go:build (all || resource_check_azure_monitor_alerts) && !exclude_approvalsandchecks
+// +build all resource_check_azure_monitor_alerts
+// +build !exclude_approvalsandchecks
+
+package approvalsandchecks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	CheckAzureMonitorAlertsID        = 123456789
+	CheckAzureMonitorAlertsProjectID = uuid.New().String()
+)
+
+var CheckAzureMonitorAlertsTest = pipelineschecksextras.CheckConfiguration{
+	Id:   &CheckAzureMonitorAlertsID,
+	Type: approvalAndCheckType.TaskCheck,
+	Settings: map[string]interface{}{
+		"definitionRef": azureMonitorDef,
+		"displayName":   "Query Azure Monitor Alerts",
+		"inputs": map[string]interface{}{
+			"connectedServiceNameARM": "00000000-0000-0000-0000-000000000001",
+			"ResourceGroupName":       "example-rg",
+			"filterType":              "alertrule",
+			"alertRule":               "cpu-high",
+			"severity":                "Sev0",
+			"timeRange":               "1d",
+			"alertState":              "New",
+			"monitorCondition":        "Fired",
+		},
+	},
+	Timeout: converter.ToPtr(1440),
+	Resource: &pipelineschecksextras.Resource{
+		Id:   converter.String(uuid.New().String()),
+		Type: converter.String("endpoint"),
+	},
+	Version: converter.Int(0),
+}
+
+// verifies that the flatten/expand round trip yields the same check
+func TestCheckAzureMonitorAlerts_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckAzureMonitorAlerts().Schema, nil)
+	resourceData.SetId("123456789")
+	require.Nil(t, flattenCheckAzureMonitorAlerts(resourceData, &CheckAzureMonitorAlertsTest, CheckAzureMonitorAlertsProjectID))
+
+	check, projectID, err := expandCheckAzureMonitorAlerts(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, CheckAzureMonitorAlertsTest, *check)
+	require.Equal(t, CheckAzureMonitorAlertsProjectID, projectID)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestCheckAzureMonitorAlerts_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceCheckAzureMonitorAlerts()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId("123456789")
+	require.Nil(t, flattenCheckAzureMonitorAlerts(resourceData, &CheckAzureMonitorAlertsTest, CheckAzureMonitorAlertsProjectID))
+
+	pipelinesCheckClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	expectedArgs := pipelineschecksextras.AddCheckConfigurationArgs{Configuration: &CheckAzureMonitorAlertsTest, Project: &CheckAzureMonitorAlertsProjectID}
+	pipelinesCheckClient.
+		EXPECT().
+		AddCheckConfiguration(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("AddCheckConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "AddCheckConfiguration() Failed")
+}
@@ -0,0 +1,110 @@
+package approvalsandchecks
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+)
+
+var evaluateArtifactDef = map[string]interface{}{
+	"id":      "2e9fb4b8-d4a4-43b5-a6a4-c3d64ee8ad4c",
+	"name":    "evaluateArtifact",
+	"version": "0.0.1",
+}
+
+// ResourceCheckEvaluateArtifact schema and implementation for Evaluate Artifact check resources
+func ResourceCheckEvaluateArtifact() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckEvaluateArtifact, expandCheckEvaluateArtifact)
+
+	maps.Copy(r.Schema, map[string]*schema.Schema{
+		"display_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"artifact_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "container",
+			ValidateFunc: validation.StringInSlice([]string{"container"}, false),
+		},
+
+		"policy": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"retry_interval": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1440,
+			ValidateFunc: validation.IntBetween(1, 43200),
+		},
+	})
+
+	return r
+}
+
+func flattenCheckEvaluateArtifact(d *schema.ResourceData, check *pipelineschecksextras.CheckConfiguration, projectID string) error {
+	err := doBaseFlattening(d, check, projectID)
+	if err != nil {
+		return err
+	}
+
+	if check.Timeout != nil {
+		d.Set("timeout", *check.Timeout)
+	}
+
+	if check.Settings == nil {
+		return fmt.Errorf("settings nil")
+	}
+
+	settings := check.Settings.(map[string]interface{})
+	if v, ok := settings["displayName"]; ok {
+		d.Set("display_name", v.(string))
+	}
+
+	if v, exist := settings["retryInterval"]; exist {
+		d.Set("retry_interval", v.(float64))
+	}
+
+	if v, ok := settings["inputs"]; ok {
+		inputs := v.(map[string]interface{})
+		if v, exist := inputs["artifactType"]; exist {
+			d.Set("artifact_type", v.(string))
+		}
+
+		if v, exist := inputs["policy"]; exist {
+			d.Set("policy", v.(string))
+		}
+	}
+	return nil
+}
+
+func expandCheckEvaluateArtifact(d *schema.ResourceData) (*pipelineschecksextras.CheckConfiguration, string, error) {
+	settings := map[string]interface{}{
+		"definitionRef": evaluateArtifactDef,
+		"displayName":   d.Get("display_name").(string),
+		"inputs": map[string]interface{}{
+			"artifactType": d.Get("artifact_type").(string),
+			"policy":       d.Get("policy").(string),
+		},
+	}
+
+	if v, ok := d.GetOk("retry_interval"); ok {
+		settings["retryInterval"] = v.(int)
+	}
+	return doBaseExpansion(d, approvalAndCheckType.TaskCheck, settings, converter.ToPtr(d.Get("timeout").(int)))
+}
@@ -0,0 +1,81 @@
+//go:build (all || resource_check_evaluate_artifact) && !exclude_approvalsandchecks
+// +build all resource_check_evaluate_artifact
+// +build !exclude_approvalsandchecks
+
+package approvalsandchecks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	CheckEvaluateArtifactID        = 123456789
+	CheckEvaluateArtifactProjectID = uuid.New().String()
+)
+
+var CheckEvaluateArtifactTest = pipelineschecksextras.CheckConfiguration{
+	Id:   &CheckEvaluateArtifactID,
+	Type: approvalAndCheckType.TaskCheck,
+	Settings: map[string]interface{}{
+		"definitionRef": evaluateArtifactDef,
+		"displayName":   "Evaluate Artifact",
+		"inputs": map[string]interface{}{
+			"artifactType": "container",
+			"policy":       "package artifacts\n\ndeny[msg] { false; msg := \"never\" }",
+		},
+	},
+	Timeout: converter.ToPtr(1440),
+	Resource: &pipelineschecksextras.Resource{
+		Id:   converter.String(uuid.New().String()),
+		Type: converter.String("endpoint"),
+	},
+	Version: converter.Int(0),
+}
+
+// verifies that the flatten/expand round trip yields the same check
+func TestCheckEvaluateArtifact_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckEvaluateArtifact().Schema, nil)
+	resourceData.SetId("123456789")
+	require.Nil(t, flattenCheckEvaluateArtifact(resourceData, &CheckEvaluateArtifactTest, CheckEvaluateArtifactProjectID))
+
+	check, projectID, err := expandCheckEvaluateArtifact(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, CheckEvaluateArtifactTest, *check)
+	require.Equal(t, CheckEvaluateArtifactProjectID, projectID)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestCheckEvaluateArtifact_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceCheckEvaluateArtifact()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId("123456789")
+	require.Nil(t, flattenCheckEvaluateArtifact(resourceData, &CheckEvaluateArtifactTest, CheckEvaluateArtifactProjectID))
+
+	pipelinesCheckClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	expectedArgs := pipelineschecksextras.AddCheckConfigurationArgs{Configuration: &CheckEvaluateArtifactTest, Project: &CheckEvaluateArtifactProjectID}
+	pipelinesCheckClient.
+		EXPECT().
+		AddCheckConfiguration(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("AddCheckConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "AddCheckConfiguration() Failed")
+}
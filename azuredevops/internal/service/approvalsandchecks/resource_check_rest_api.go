@@ -228,21 +228,8 @@ func expandRestAPI(d *schema.ResourceData) (*pipelineschecksextras.CheckConfigur
 	settings["inputs"] = input
 	// inputs end
 
-	// There is no need to retry a Callback check. https://devblogs.microsoft.com/devops/updates-to-approvals-and-checks/
-	if v, ok := d.GetOk("retry_interval"); ok {
-		retryInterval := v.(int)
-		if completionEvent == string(CompleteEventValues.Callback) {
-			return nil, "", fmt.Errorf("Does not need to set `retry_interval` when `completion_event=Callback`.")
-		}
-
-		timeout := d.Get("timeout").(int)
-		minRetryInterval := timeout / 10
-		if minRetryInterval > retryInterval {
-			return nil, "", fmt.Errorf("We require you enter a value of 0 or at least %d,"+
-				" to keep the number of retries below 10. Starting Autumn 2023, non-compliant "+
-				"checks will fail automatically. Timeout: %d, retryInterval: %d", minRetryInterval, timeout, retryInterval)
-		}
-		settings["retryInterval"] = retryInterval
+	if err := expandCheckRetryInterval(d, settings, completionEvent); err != nil {
+		return nil, "", err
 	}
 
 	if v, ok := d.GetOk("variable_group_name"); ok {
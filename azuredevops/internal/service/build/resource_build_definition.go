@@ -462,6 +462,74 @@ func ResourceBuildDefinition() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						// Steps added outside of Terraform, e.g. in the designer, are kept unless task blocks are configured
+						"task": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"task_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+									"version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"definition_type": { // `metaTask` references a task group
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "task",
+										ValidateFunc: validation.StringInSlice([]string{"task", "metaTask"}, false),
+									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "",
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"always_run": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"continue_on_error": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"condition": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "succeeded()",
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"timeout_in_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"inputs": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"environment": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -799,6 +867,7 @@ func flattenBuildDefinitionJobs(input interface{}) ([]interface{}, error) {
 					"job_authorization_scope":          job.JobAuthorizationScope,
 					"dependencies":                     dependencyMap,
 					"target":                           []interface{}{targetMap},
+					"task":                             flattenBuildDefinitionJobSteps(job.Steps),
 				}
 
 				result = append(result, jobConfig)
@@ -809,6 +878,39 @@ func flattenBuildDefinitionJobs(input interface{}) ([]interface{}, error) {
 	return result, nil
 }
 
+func flattenBuildDefinitionJobSteps(steps *[]model.JobStep) []interface{} {
+	if steps == nil {
+		return nil
+	}
+
+	result := make([]interface{}, 0, len(*steps))
+	for _, step := range *steps {
+		stepMap := map[string]interface{}{
+			"display_name":      converter.ToString(step.DisplayName, ""),
+			"enabled":           converter.ToBool(step.Enabled, true),
+			"always_run":        converter.ToBool(step.AlwaysRun, false),
+			"continue_on_error": converter.ToBool(step.ContinueOnError, false),
+			"condition":         converter.ToString(step.Condition, ""),
+		}
+		if step.TimeoutInMinutes != nil {
+			stepMap["timeout_in_minutes"] = *step.TimeoutInMinutes
+		}
+		if step.Task != nil {
+			stepMap["task_id"] = converter.ToString(step.Task.Id, "")
+			stepMap["version"] = converter.ToString(step.Task.VersionSpec, "")
+			stepMap["definition_type"] = converter.ToString(step.Task.DefinitionType, "task")
+		}
+		if step.Inputs != nil {
+			stepMap["inputs"] = *step.Inputs
+		}
+		if step.Environment != nil {
+			stepMap["environment"] = *step.Environment
+		}
+		result = append(result, stepMap)
+	}
+	return result
+}
+
 func flattenBuildVariables(d *schema.ResourceData, buildDefinition *build.BuildDefinition) interface{} {
 	if buildDefinition.Variables == nil {
 		return nil
@@ -1305,6 +1407,29 @@ func expandVariables(d *schema.ResourceData) (*map[string]build.BuildDefinitionV
 	return &expandedVars, nil
 }
 
+func expandBuildDefinitionJobSteps(input []interface{}) []model.JobStep {
+	steps := make([]model.JobStep, 0, len(input))
+	for _, stepConfig := range input {
+		stepMap := stepConfig.(map[string]interface{})
+		steps = append(steps, model.JobStep{
+			DisplayName:      converter.String(stepMap["display_name"].(string)),
+			Enabled:          converter.Bool(stepMap["enabled"].(bool)),
+			AlwaysRun:        converter.Bool(stepMap["always_run"].(bool)),
+			ContinueOnError:  converter.Bool(stepMap["continue_on_error"].(bool)),
+			Condition:        converter.String(stepMap["condition"].(string)),
+			TimeoutInMinutes: converter.Int(stepMap["timeout_in_minutes"].(int)),
+			Task: &model.JobStepTask{
+				Id:             converter.String(stepMap["task_id"].(string)),
+				VersionSpec:    converter.String(stepMap["version"].(string)),
+				DefinitionType: converter.String(stepMap["definition_type"].(string)),
+			},
+			Inputs:      converter.ToPtr(tfhelper.ExpandStringMap(stepMap["inputs"])),
+			Environment: converter.ToPtr(tfhelper.ExpandStringMap(stepMap["environment"])),
+		})
+	}
+	return steps
+}
+
 func expandBuildDefinitionJobs(input []interface{}) (*[]model.PipelineJob, error) {
 	if len(input) == 0 {
 		return &[]model.PipelineJob{}, nil
@@ -1419,6 +1544,10 @@ func expandBuildDefinitionJobs(input []interface{}) (*[]model.PipelineJob, error
 		}
 		job.Target = &target
 
+		if steps := expandBuildDefinitionJobSteps(jobMap["task"].([]interface{})); len(steps) > 0 {
+			job.Steps = &steps
+		}
+
 		result = append(result, job)
 	}
 	return &result, nil
@@ -9,13 +9,16 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/model"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 	"github.com/stretchr/testify/require"
@@ -601,3 +604,69 @@ func sortBuildDefinition(b build.BuildDefinition) build.BuildDefinition {
 	}
 	return b
 }
+
+// verifies that the steps of a job without configured task blocks neither cause a diff nor get dropped on apply
+func TestBuildDefinition_Jobs_StepsWithoutConfiguredTasks(t *testing.T) {
+	raw := map[string]interface{}{
+		"project_id": testProjectID,
+		"name":       "definition",
+		"jobs": []interface{}{
+			map[string]interface{}{
+				"name":      "Agent job 1",
+				"ref_name":  "Job_1",
+				"condition": "succeeded()",
+				"target": []interface{}{
+					map[string]interface{}{
+						"type": "AgentJob",
+						"execution_options": []interface{}{
+							map[string]interface{}{"type": "None"},
+						},
+					},
+				},
+			},
+		},
+	}
+	r := ResourceBuildDefinition()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, raw)
+	resourceData.SetId("1")
+
+	jobs, err := flattenBuildDefinitionJobs(map[string]interface{}{
+		"phases": []model.PipelineJob{
+			{
+				Name:      converter.String("Agent job 1"),
+				RefName:   converter.String("Job_1"),
+				Condition: converter.String("succeeded()"),
+				Target: &model.JobTarget{
+					Type:             converter.Int(1),
+					ExecutionOptions: &model.JobExecutionOptions{Type: converter.Int(0)},
+				},
+				Steps: &[]model.JobStep{
+					{
+						DisplayName: converter.String("Run script"),
+						Task: &model.JobStepTask{
+							Id:          converter.String("d9bafed4-0b18-4f58-968d-86655b4d2ce9"),
+							VersionSpec: converter.String("2.*"),
+						},
+						Inputs: &map[string]string{"script": "echo hello"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, resourceData.Set("jobs", jobs))
+
+	diff, err := r.Diff(context.Background(), resourceData.State(), terraform.NewResourceConfigRaw(raw), nil)
+	require.NoError(t, err)
+	if diff != nil {
+		for attribute := range diff.Attributes {
+			require.False(t, strings.HasPrefix(attribute, "jobs.0.task"), "unexpected diff of %s", attribute)
+		}
+	}
+
+	expanded, err := expandBuildDefinitionJobs(resourceData.Get("jobs").([]interface{}))
+	require.NoError(t, err)
+	require.NotNil(t, (*expanded)[0].Steps)
+	require.Len(t, *(*expanded)[0].Steps, 1)
+	require.Equal(t, "echo hello", (*(*(*expanded)[0].Steps)[0].Inputs)["script"])
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+// DataDeletedProjects schema and implementation for the soft-deleted projects data source
+func DataDeletedProjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDeletedProjectsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"visibility": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeletedProjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	name := d.Get("name").(string)
+
+	projects, err := getProjectsForStateAndName(clients, string(core.ProjectStateValues.Deleted), name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("finding deleted projects. Error: %v", err))
+	}
+
+	d.SetId("deleted-projects#" + name)
+	if err := d.Set("projects", flattenDeletedProjects(projects)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting projects: %v", err))
+	}
+	return nil
+}
+
+func flattenDeletedProjects(projects []core.TeamProjectReference) []interface{} {
+	results := make([]interface{}, 0, len(projects))
+	for _, project := range projects {
+		output := map[string]interface{}{
+			"name":        converter.ToString(project.Name, ""),
+			"description": converter.ToString(project.Description, ""),
+			"project_url": converter.ToString(project.Url, ""),
+		}
+		if project.Id != nil {
+			output["project_id"] = project.Id.String()
+		}
+		if project.Visibility != nil {
+			output["visibility"] = string(*project.Visibility)
+		}
+		if project.LastUpdateTime != nil {
+			output["last_update_time"] = project.LastUpdateTime.Time.Format(time.RFC3339)
+		}
+		results = append(results, output)
+	}
+	return results
+}
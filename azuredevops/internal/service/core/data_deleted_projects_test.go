@@ -0,0 +1,117 @@
+//go:build (all || core || data_sources || resource_project || data_deleted_projects) && (!data_sources || !exclude_data_deleted_projects)
+// +build all core data_sources resource_project data_deleted_projects
+// +build !data_sources !exclude_data_deleted_projects
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/testhelper"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var prjListStateDeleted = []core.TeamProjectReference{
+	{
+		Name:        converter.String("vsteam-0377"),
+		Id:          testhelper.CreateUUID(),
+		Description: converter.String("deleted project"),
+		State:       &core.ProjectStateValues.Deleted,
+		Visibility:  &core.ProjectVisibilityValues.Private,
+	},
+	{
+		Name:  converter.String("vsteam-0378"),
+		Id:    testhelper.CreateUUID(),
+		State: &core.ProjectStateValues.Deleted,
+	},
+}
+
+func TestDataSourceDeletedProjects_Read_AllProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		GetProjects(clients.Ctx, core.GetProjectsArgs{StateFilter: &core.ProjectStateValues.Deleted}).
+		Return(&core.GetProjectsResponseValue{
+			Value:             prjListStateDeleted,
+			ContinuationToken: "",
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeletedProjects().Schema, nil)
+	err := dataSourceDeletedProjectsRead(clients.Ctx, resourceData, clients)
+	require.Nil(t, err)
+
+	projects := resourceData.Get("projects").([]interface{})
+	require.Len(t, projects, 2)
+	project := projects[0].(map[string]interface{})
+	require.Equal(t, prjListStateDeleted[0].Id.String(), project["project_id"])
+	require.Equal(t, "deleted project", project["description"])
+	require.Equal(t, "private", project["visibility"])
+}
+
+func TestDataSourceDeletedProjects_Read_ByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		GetProjects(clients.Ctx, core.GetProjectsArgs{StateFilter: &core.ProjectStateValues.Deleted}).
+		Return(&core.GetProjectsResponseValue{
+			Value:             prjListStateDeleted,
+			ContinuationToken: "",
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeletedProjects().Schema, nil)
+	resourceData.Set("name", "VSTEAM-0378")
+	err := dataSourceDeletedProjectsRead(clients.Ctx, resourceData, clients)
+	require.Nil(t, err)
+
+	projects := resourceData.Get("projects").([]interface{})
+	require.Len(t, projects, 1)
+	require.Equal(t, prjListStateDeleted[1].Id.String(), projects[0].(map[string]interface{})["project_id"])
+}
+
+func TestDataSourceDeletedProjects_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		GetProjects(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetProjects() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeletedProjects().Schema, nil)
+	err := dataSourceDeletedProjectsRead(clients.Ctx, resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err[0].Summary, "GetProjects() Failed")
+}
@@ -24,6 +24,12 @@ import (
 // timeout used to wait for operations on projects to finish before executing an update or delete
 var projectBusyTimeoutDuration time.Duration = 6 * time.Minute
 
+// delays before a project and the operations queued for a project are polled for the first time
+var (
+	projectPollDelay          time.Duration = 5 * time.Second
+	projectOperationPollDelay time.Duration = 10 * time.Second
+)
+
 // ResourceProject schema and implementation for project resource
 func ResourceProject() *schema.Resource {
 	return &schema.Resource{
@@ -32,7 +38,7 @@ func ResourceProject() *schema.Resource {
 		UpdateContext: resourceProjectUpdate,
 		DeleteContext: resourceProjectDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importProject,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -92,6 +98,16 @@ func ResourceProject() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"restore_if_soft_deleted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"permanent_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -103,6 +119,16 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(fmt.Errorf("expand project reference: %+v", err))
 	}
 
+	if d.Get("restore_if_soft_deleted").(bool) {
+		deletedProject, err := findDeletedProject(clients, *project.Name)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("looking up deleted project. Name: %s, Error: %v", *project.Name, err))
+		}
+		if deletedProject != nil {
+			return resourceProjectRestore(ctx, d, m, project, deletedProject)
+		}
+	}
+
 	operationRef, err := clients.CoreClient.QueueCreateProject(clients.Ctx, core.QueueCreateProjectArgs{ProjectToCreate: project})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("creating project: %v", err))
@@ -147,6 +173,60 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 	return resourceProjectRead(ctx, d, m)
 }
 
+// resourceProjectRestore restores a soft-deleted project and applies the configured description, visibility and features
+func resourceProjectRestore(ctx context.Context, d *schema.ResourceData, m interface{}, project *core.TeamProject, deletedProject *core.TeamProjectReference) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	// The version control and the process template cannot be changed, adopting a project which differs from the
+	// configuration would have it replaced on every apply.
+	deletedProjectDetails, err := clients.CoreClient.GetProject(clients.Ctx, core.GetProjectArgs{
+		ProjectId:           converter.String(deletedProject.Id.String()),
+		IncludeCapabilities: converter.Bool(true),
+		IncludeHistory:      converter.Bool(false),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("reading deleted project. ID: %s, Error: %v", deletedProject.Id.String(), err))
+	}
+	if err := checkRestorableProject(project, deletedProjectDetails); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Restoring soft-deleted project. ID: %s, Name: %s", deletedProject.Id.String(), *deletedProject.Name)
+	if err := restoreProject(clients, deletedProject.Id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("restoring project. ID: %s, Error: %v", deletedProject.Id.String(), err))
+	}
+
+	restoredProject, err := getProject(clients, deletedProject.Id.String(), "", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("waiting for project ready. %v ", err))
+	}
+
+	projectUpdate := &core.TeamProject{Id: restoredProject.Id}
+	requiresUpdate := false
+	if converter.ToString(restoredProject.Description, "") != *project.Description {
+		projectUpdate.Description = project.Description
+		requiresUpdate = true
+	}
+	if restoredProject.Visibility == nil || !strings.EqualFold(string(*restoredProject.Visibility), string(*project.Visibility)) {
+		projectUpdate.Visibility = project.Visibility
+		requiresUpdate = true
+	}
+	if requiresUpdate {
+		if err := updateProject(clients, projectUpdate, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("updating restored project: %v", err))
+		}
+	}
+
+	d.SetId(restoredProject.Id.String())
+
+	if features, ok := d.GetOk("features"); ok {
+		featureStates := features.(map[string]interface{})
+		if err := updateProjectFeatureStates(clients.Ctx, clients.FeatureManagementClient, restoredProject.Id.String(), &featureStates); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceProjectRead(ctx, d, m)
+}
+
 func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clients := m.(*client.AggregatedClient)
 	projectID := d.Id()
@@ -235,9 +315,22 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(fmt.Errorf("deleting project: %v", err))
 	}
 
+	if d.Get("permanent_delete").(bool) {
+		if err := permanentDeleteProject(clients, id, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(fmt.Errorf("permanently deleting project: %v", err))
+		}
+	}
+
 	return nil
 }
 
+func importProject(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// The delete and restore behavior is not stored in the service, set the defaults to avoid a diff after import
+	d.Set("restore_if_soft_deleted", false)
+	d.Set("permanent_delete", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 // Configure projects features for a project. If projectID is "" then the projectName will be used to locate (read) the project
 func updateProjectFeatures(clients *client.AggregatedClient, project *core.TeamProject, featureStates *interface{}, timeout time.Duration) error {
 	if featureStates == nil {
@@ -281,7 +374,7 @@ func getProject(clients *client.AggregatedClient, projectID string, projectName
 	var project *core.TeamProject
 	stateConf := &retry.StateChangeConf{
 		ContinuousTargetOccurence: 1,
-		Delay:                     5 * time.Second,
+		Delay:                     projectPollDelay,
 		MinTimeout:                20 * time.Second,
 		Timeout:                   timeout,
 		Pending:                   []string{"pending"},
@@ -336,7 +429,7 @@ func updateProject(clients *client.AggregatedClient, project *core.TeamProject,
 
 	stateConf := &retry.StateChangeConf{
 		ContinuousTargetOccurence: 1,
-		Delay:                     10 * time.Second,
+		Delay:                     projectOperationPollDelay,
 		MinTimeout:                10 * time.Second,
 		Timeout:                   timeout,
 		Pending: []string{
@@ -358,6 +451,59 @@ func updateProject(clients *client.AggregatedClient, project *core.TeamProject,
 	return nil
 }
 
+// checkRestorableProject verifies that the version control and the process template of a soft-deleted project match
+// the configured ones
+func checkRestorableProject(project *core.TeamProject, deletedProject *core.TeamProject) error {
+	configured := map[string]map[string]string{}
+	if project.Capabilities != nil {
+		configured = *project.Capabilities
+	}
+	existing := map[string]map[string]string{}
+	if deletedProject.Capabilities != nil {
+		existing = *deletedProject.Capabilities
+	}
+
+	var mismatches []string
+	for _, capability := range []struct {
+		name string
+		key  string
+		desc string
+	}{
+		{"versioncontrol", "sourceControlType", "version control"},
+		{"processTemplate", "templateTypeId", "process template ID"},
+	} {
+		want, got := configured[capability.name][capability.key], existing[capability.name][capability.key]
+		if !strings.EqualFold(want, got) {
+			mismatches = append(mismatches, fmt.Sprintf("%s is %q instead of %q", capability.desc, got, want))
+		}
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("the soft-deleted project %s cannot be restored as its %s. Align `version_control` and `work_item_template` with the deleted project, or permanently delete it",
+			converter.ToString(deletedProject.Name, ""), strings.Join(mismatches, " and "))
+	}
+	return nil
+}
+
+// restoreProject restores a soft-deleted project by setting its state back to well formed
+func restoreProject(clients *client.AggregatedClient, id *uuid.UUID, timeout time.Duration) error {
+	return updateProject(clients, &core.TeamProject{
+		Id:    id,
+		State: &core.ProjectStateValues.WellFormed,
+	}, timeout)
+}
+
+// findDeletedProject looks up a soft-deleted project by name. Returns nil if no deleted project with the name exists.
+func findDeletedProject(clients *client.AggregatedClient, name string) (*core.TeamProjectReference, error) {
+	projects, err := getProjectsForStateAndName(clients, string(core.ProjectStateValues.Deleted), name)
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 {
+		return nil, nil
+	}
+	return &projects[0], nil
+}
+
 func deleteProject(clients *client.AggregatedClient, id string, timeout time.Duration) error {
 	uuid, err := uuid.Parse(id)
 	if err != nil {
@@ -385,7 +531,7 @@ func deleteProject(clients *client.AggregatedClient, id string, timeout time.Dur
 
 	stateConf := &retry.StateChangeConf{
 		ContinuousTargetOccurence: 1,
-		Delay:                     10 * time.Second,
+		Delay:                     projectOperationPollDelay,
 		MinTimeout:                10 * time.Second,
 		Timeout:                   timeout,
 		Pending: []string{
@@ -407,6 +553,57 @@ func deleteProject(clients *client.AggregatedClient, id string, timeout time.Dur
 	return nil
 }
 
+// permanentDeleteProject removes a soft-deleted project from the recycle bin by deleting it again. Unlike
+// deleteProject, errors are not retried and only a succeeded operation is treated as success.
+func permanentDeleteProject(clients *client.AggregatedClient, id string, timeout time.Duration) error {
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("Invalid project UUID: %s", id)
+	}
+
+	operationRef, err := clients.CoreClient.QueueDeleteProject(clients.Ctx, core.QueueDeleteProjectArgs{
+		ProjectId: &uuid,
+	})
+	if err != nil {
+		return err
+	}
+
+	pollOperation := pollOperationResult(clients, operationRef)
+	stateConf := &retry.StateChangeConf{
+		ContinuousTargetOccurence: 1,
+		Delay:                     projectOperationPollDelay,
+		MinTimeout:                10 * time.Second,
+		Timeout:                   timeout,
+		Pending: []string{
+			string(operations.OperationStatusValues.InProgress),
+			string(operations.OperationStatusValues.Queued),
+			string(operations.OperationStatusValues.NotSet),
+		},
+		Target: []string{
+			string(operations.OperationStatusValues.Succeeded),
+		},
+		Refresh: func() (interface{}, string, error) {
+			result, state, err := pollOperation()
+			if err != nil {
+				return nil, string(operations.OperationStatusValues.Failed), err
+			}
+			if state == string(operations.OperationStatusValues.Failed) || state == string(operations.OperationStatusValues.Cancelled) {
+				message := ""
+				if operation, ok := result.(*operations.Operation); ok && operation.DetailedMessage != nil {
+					message = *operation.DetailedMessage
+				}
+				return nil, state, fmt.Errorf("operation %s: %s", state, message)
+			}
+			return result, state, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf("waiting for project to be permanently deleted. %v ", err)
+	}
+	return nil
+}
+
 // Convert internal Terraform data structure to an AzDO data structure
 func expandProject(clients *client.AggregatedClient, d *schema.ResourceData, forCreate bool) (*core.TeamProject, error) {
 	var processTemplateID string
@@ -0,0 +1,298 @@
+//go:build (all || core || resource_project) && !exclude_resource_project
+// +build all core resource_project
+// +build !exclude_resource_project
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/operations"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/testhelper"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testProjectProcessTemplateID = "adcc42ab-9882-485e-a3ed-7678f01f66bc"
+
+func testProjectWithCapabilities(versionControl string, processTemplateID string) *core.TeamProject {
+	return &core.TeamProject{
+		Id:          testhelper.CreateUUID(),
+		Name:        converter.String("vsteam-0377"),
+		Description: converter.String("restored project"),
+		Visibility:  &core.ProjectVisibilityValues.Private,
+		Capabilities: &map[string]map[string]string{
+			"versioncontrol":  {"sourceControlType": versionControl},
+			"processTemplate": {"templateTypeId": processTemplateID},
+		},
+	}
+}
+
+// shortenProjectPollDelays avoids waiting for the first poll of projects and project operations
+func shortenProjectPollDelays(t *testing.T) {
+	pollDelay, operationPollDelay := projectPollDelay, projectOperationPollDelay
+	projectPollDelay, projectOperationPollDelay = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		projectPollDelay, projectOperationPollDelay = pollDelay, operationPollDelay
+	})
+}
+
+func testProjectOperation(status operations.OperationStatus) (*operations.OperationReference, *operations.Operation) {
+	ref := &operations.OperationReference{
+		Id:       testhelper.CreateUUID(),
+		PluginId: testhelper.CreateUUID(),
+	}
+	return ref, &operations.Operation{
+		Id:              ref.Id,
+		PluginId:        ref.PluginId,
+		Status:          &status,
+		DetailedMessage: converter.String("operation " + string(status)),
+	}
+}
+
+func testPermanentDeleteResourceData(t *testing.T, id string) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceProject().Schema, map[string]interface{}{
+		"name":             "vsteam-0377",
+		"permanent_delete": true,
+	})
+	resourceData.SetId(id)
+	return resourceData
+}
+
+// verifies that a permanently deleted project is deleted twice, the second time removing it from the recycle bin
+func TestProject_Delete_PermanentDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	shortenProjectPollDelays(t)
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, OperationsClient: operationsClient, Ctx: context.Background()}
+
+	id := testhelper.CreateUUID()
+	softDeleteRef, softDelete := testProjectOperation(operations.OperationStatusValues.Succeeded)
+	permanentDeleteRef, permanentDelete := testProjectOperation(operations.OperationStatusValues.Succeeded)
+	gomock.InOrder(
+		coreClient.EXPECT().QueueDeleteProject(clients.Ctx, core.QueueDeleteProjectArgs{ProjectId: id}).Return(softDeleteRef, nil).Times(1),
+		coreClient.EXPECT().QueueDeleteProject(clients.Ctx, core.QueueDeleteProjectArgs{ProjectId: id}).Return(permanentDeleteRef, nil).Times(1),
+	)
+	operationsClient.
+		EXPECT().
+		GetOperation(clients.Ctx, operations.GetOperationArgs{OperationId: softDeleteRef.Id, PluginId: softDeleteRef.PluginId}).
+		Return(softDelete, nil).
+		Times(1)
+	operationsClient.
+		EXPECT().
+		GetOperation(clients.Ctx, operations.GetOperationArgs{OperationId: permanentDeleteRef.Id, PluginId: permanentDeleteRef.PluginId}).
+		Return(permanentDelete, nil).
+		Times(1)
+
+	diags := resourceProjectDelete(clients.Ctx, testPermanentDeleteResourceData(t, id.String()), clients)
+	require.False(t, diags.HasError(), "%v", diags)
+}
+
+// verifies that a permanent delete whose operation failed is reported, with the reason given by the service
+func TestProject_Delete_PermanentDeleteRequiresSucceededOperation(t *testing.T) {
+	for _, status := range []operations.OperationStatus{
+		operations.OperationStatusValues.Failed,
+		operations.OperationStatusValues.Cancelled,
+	} {
+		t.Run(string(status), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			shortenProjectPollDelays(t)
+
+			coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+			operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+			clients := &client.AggregatedClient{CoreClient: coreClient, OperationsClient: operationsClient, Ctx: context.Background()}
+
+			id := testhelper.CreateUUID()
+			softDeleteRef, softDelete := testProjectOperation(operations.OperationStatusValues.Succeeded)
+			permanentDeleteRef, permanentDelete := testProjectOperation(status)
+			gomock.InOrder(
+				coreClient.EXPECT().QueueDeleteProject(clients.Ctx, gomock.Any()).Return(softDeleteRef, nil).Times(1),
+				coreClient.EXPECT().QueueDeleteProject(clients.Ctx, gomock.Any()).Return(permanentDeleteRef, nil).Times(1),
+			)
+			operationsClient.
+				EXPECT().
+				GetOperation(clients.Ctx, operations.GetOperationArgs{OperationId: softDeleteRef.Id, PluginId: softDeleteRef.PluginId}).
+				Return(softDelete, nil).
+				Times(1)
+			operationsClient.
+				EXPECT().
+				GetOperation(clients.Ctx, operations.GetOperationArgs{OperationId: permanentDeleteRef.Id, PluginId: permanentDeleteRef.PluginId}).
+				Return(permanentDelete, nil).
+				Times(1)
+
+			diags := resourceProjectDelete(clients.Ctx, testPermanentDeleteResourceData(t, id.String()), clients)
+			require.True(t, diags.HasError())
+			require.Contains(t, diags[0].Summary, "permanently deleting project")
+			require.Contains(t, diags[0].Summary, "operation "+string(status))
+		})
+	}
+}
+
+// verifies that an error queueing the permanent delete is returned instead of being retried
+func TestProject_Delete_PermanentDeleteDoesNotRetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	shortenProjectPollDelays(t)
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, OperationsClient: operationsClient, Ctx: context.Background()}
+
+	softDeleteRef, softDelete := testProjectOperation(operations.OperationStatusValues.Succeeded)
+	gomock.InOrder(
+		coreClient.EXPECT().QueueDeleteProject(clients.Ctx, gomock.Any()).Return(softDeleteRef, nil).Times(1),
+		coreClient.EXPECT().QueueDeleteProject(clients.Ctx, gomock.Any()).Return(nil, errors.New("QueueDeleteProject() Failed")).Times(1),
+	)
+	operationsClient.
+		EXPECT().
+		GetOperation(clients.Ctx, gomock.Any()).
+		Return(softDelete, nil).
+		Times(1)
+
+	diags := resourceProjectDelete(clients.Ctx, testPermanentDeleteResourceData(t, testhelper.CreateUUID().String()), clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "QueueDeleteProject() Failed")
+}
+
+// verifies that a soft-deleted project matching the configuration is restored and read back
+func TestProject_Restore_Succeeds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	shortenProjectPollDelays(t)
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, OperationsClient: operationsClient, Ctx: context.Background()}
+
+	deletedProject := testProjectWithCapabilities("Git", testProjectProcessTemplateID)
+	coreClient.
+		EXPECT().
+		GetProject(clients.Ctx, core.GetProjectArgs{
+			ProjectId:           converter.String(deletedProject.Id.String()),
+			IncludeCapabilities: converter.Bool(true),
+			IncludeHistory:      converter.Bool(false),
+		}).
+		Return(deletedProject, nil).
+		Times(3)
+
+	restoreRef, restore := testProjectOperation(operations.OperationStatusValues.Succeeded)
+	coreClient.
+		EXPECT().
+		UpdateProject(clients.Ctx, core.UpdateProjectArgs{
+			ProjectUpdate: &core.TeamProject{Id: deletedProject.Id, State: &core.ProjectStateValues.WellFormed},
+			ProjectId:     deletedProject.Id,
+		}).
+		Return(restoreRef, nil).
+		Times(1)
+	operationsClient.
+		EXPECT().
+		GetOperation(clients.Ctx, operations.GetOperationArgs{OperationId: restoreRef.Id, PluginId: restoreRef.PluginId}).
+		Return(restore, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetProcessById(clients.Ctx, gomock.Any()).
+		Return(&core.Process{Name: converter.String("Agile")}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProject().Schema, nil)
+	project := testProjectWithCapabilities("Git", testProjectProcessTemplateID)
+	diags := resourceProjectRestore(clients.Ctx, resourceData, clients, project, &core.TeamProjectReference{
+		Id:   deletedProject.Id,
+		Name: deletedProject.Name,
+	})
+
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, deletedProject.Id.String(), resourceData.Id())
+	require.Equal(t, "vsteam-0377", resourceData.Get("name"))
+	require.Equal(t, "Agile", resourceData.Get("work_item_template"))
+}
+
+func TestProject_FindDeletedProject_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		GetProjects(clients.Ctx, core.GetProjectsArgs{StateFilter: &core.ProjectStateValues.Deleted}).
+		Return(&core.GetProjectsResponseValue{
+			Value: []core.TeamProjectReference{
+				{
+					Name:  converter.String("vsteam-0377"),
+					Id:    testhelper.CreateUUID(),
+					State: &core.ProjectStateValues.Deleted,
+				},
+			},
+			ContinuationToken: "",
+		}, nil).
+		Times(1)
+
+	project, err := findDeletedProject(clients, "vsteam-0477")
+	require.NoError(t, err)
+	require.Nil(t, project)
+}
+
+// verifies that a soft-deleted project is not restored when its capabilities differ from the configuration
+func TestProject_Restore_RefusesMismatchingCapabilities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, Ctx: context.Background()}
+
+	deletedProject := testProjectWithCapabilities("Tfvc", testProjectProcessTemplateID)
+	coreClient.
+		EXPECT().
+		GetProject(clients.Ctx, core.GetProjectArgs{
+			ProjectId:           converter.String(deletedProject.Id.String()),
+			IncludeCapabilities: converter.Bool(true),
+			IncludeHistory:      converter.Bool(false),
+		}).
+		Return(deletedProject, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		UpdateProject(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProject().Schema, nil)
+	project := testProjectWithCapabilities("Git", testProjectProcessTemplateID)
+	diags := resourceProjectRestore(clients.Ctx, resourceData, clients, project, &core.TeamProjectReference{
+		Id:   deletedProject.Id,
+		Name: deletedProject.Name,
+	})
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, `version control is "Tfvc" instead of "Git"`)
+	require.Empty(t, resourceData.Id())
+}
+
+func TestProject_CheckRestorableProject(t *testing.T) {
+	configured := testProjectWithCapabilities("Git", testProjectProcessTemplateID)
+
+	require.NoError(t, checkRestorableProject(configured, testProjectWithCapabilities("git", "ADCC42AB-9882-485E-A3ED-7678F01F66BC")))
+
+	err := checkRestorableProject(configured, testProjectWithCapabilities("Git", "6b724908-ef14-45cf-84f8-768b5384da45"))
+	require.ErrorContains(t, err, "process template ID is")
+
+	err = checkRestorableProject(configured, testProjectWithCapabilities("Tfvc", "6b724908-ef14-45cf-84f8-768b5384da45"))
+	require.ErrorContains(t, err, "version control is \"Tfvc\" instead of \"Git\" and process template ID is")
+}
@@ -0,0 +1,103 @@
+package feed
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+// DataFeedPackage schema and implementation for the feed package data source
+func DataFeedPackage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFeedPackageRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"protocol_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringInSlice(packageProtocolTypeNames, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"include_deleted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"normalized_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     packageVersionSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceFeedPackageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	feedId := d.Get("feed_id").(string)
+	projectId := d.Get("project_id").(string)
+	protocolType := d.Get("protocol_type").(string)
+	name := d.Get("name").(string)
+
+	pkg, err := findFeedPackage(clients, projectId, feedId, protocolType, name, d.Get("include_deleted").(bool))
+	if err != nil {
+		return diag.Errorf(" Reading package. Feed ID: %s, Package: %s, Error: %+v", feedId, name, err)
+	}
+	if pkg == nil {
+		return diag.Errorf(" Package %s of type %s does not exist in feed %s", name, protocolType, feedId)
+	}
+
+	d.SetId(pkg.Id.String())
+	d.Set("name", converter.ToString(pkg.Name, name))
+	d.Set("normalized_name", converter.ToString(pkg.NormalizedName, ""))
+	d.Set("url", converter.ToString(pkg.Url, ""))
+
+	latestVersion := ""
+	if pkg.Versions != nil {
+		for _, version := range *pkg.Versions {
+			if converter.ToBool(version.IsLatest, false) {
+				latestVersion = converter.ToString(version.Version, "")
+			}
+		}
+	}
+	d.Set("latest_version", latestVersion)
+	if err := d.Set("versions", flattenPackageVersions(pkg.Versions)); err != nil {
+		return diag.Errorf(" Setting versions: %+v", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+//go:build (all || data_sources || data_feed_package) && (!exclude_data_sources || !exclude_data_feed_package)
+// +build all data_sources data_feed_package
+// +build !exclude_data_sources !exclude_data_feed_package
+
+package feed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testDataFeedId        = "some-feed-name"
+	testDataFeedProjectId = uuid.New().String()
+	testDataPackageId     = uuid.New()
+)
+
+func testDataFeedPackages() []feed.Package {
+	return []feed.Package{
+		{
+			Id:             &testDataPackageId,
+			Name:           converter.String("Contoso.Widgets"),
+			NormalizedName: converter.String("contoso.widgets"),
+			ProtocolType:   converter.String("NuGet"),
+			Versions: &[]feed.MinimalPackageVersion{
+				{
+					Id:        converter.UUID(uuid.New().String()),
+					Version:   converter.String("1.0.0"),
+					IsListed:  converter.Bool(false),
+					IsDeleted: converter.Bool(true),
+				},
+				{
+					Id:       converter.UUID(uuid.New().String()),
+					Version:  converter.String("1.1.0"),
+					IsLatest: converter.Bool(true),
+					IsListed: converter.Bool(true),
+					Views: &[]feed.FeedView{
+						{Name: converter.String("Local"), Type: &feed.FeedViewTypeValues.Implicit},
+						{Name: converter.String("Release"), Type: &feed.FeedViewTypeValues.Release},
+					},
+				},
+			},
+		},
+		{
+			Id:             converter.UUID(uuid.New().String()),
+			Name:           converter.String("Contoso.Widgets.Extensions"),
+			NormalizedName: converter.String("contoso.widgets.extensions"),
+			ProtocolType:   converter.String("NuGet"),
+		},
+	}
+}
+
+func TestDataFeedPackage_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := DataFeedPackage()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"feed_id":         testDataFeedId,
+		"project_id":      testDataFeedProjectId,
+		"protocol_type":   "NuGet",
+		"name":            "contoso.widgets",
+		"include_deleted": true,
+	})
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	packages := testDataFeedPackages()
+	feedClient.EXPECT().
+		GetPackages(clients.Ctx, feed.GetPackagesArgs{
+			FeedId:             converter.String(testDataFeedId),
+			Project:            converter.String(testDataFeedProjectId),
+			ProtocolType:       converter.String("NuGet"),
+			PackageNameQuery:   converter.String("contoso.widgets"),
+			IncludeAllVersions: converter.Bool(true),
+			IncludeDeleted:     converter.Bool(true),
+			Top:                converter.Int(feedPackagesPageSize),
+			Skip:               converter.Int(0),
+		}).
+		Return(&packages, nil).
+		Times(1)
+
+	diags := r.ReadContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, testDataPackageId.String(), resourceData.Id())
+	require.Equal(t, "1.1.0", resourceData.Get("latest_version"))
+	require.Equal(t, 2, resourceData.Get("versions.#"))
+	require.True(t, resourceData.Get("versions.0.is_deleted").(bool))
+	require.Equal(t, []interface{}{"Release"}, resourceData.Get("versions.1.views"))
+}
+
+func TestDataFeedPackage_Read_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := DataFeedPackage()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"feed_id":       testDataFeedId,
+		"protocol_type": "nuget",
+		"name":          "Contoso",
+	})
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	packages := testDataFeedPackages()
+	feedClient.EXPECT().GetPackages(clients.Ctx, gomock.Any()).Return(&packages, nil).Times(1)
+
+	diags := r.ReadContext(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "does not exist")
+}
+
+func TestDataFeedPackages_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := DataFeedPackages()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"feed_id":            testDataFeedId,
+		"protocol_type":      "nuget",
+		"package_name_query": "Contoso",
+	})
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	packages := testDataFeedPackages()
+	feedClient.EXPECT().
+		GetPackages(clients.Ctx, feed.GetPackagesArgs{
+			FeedId:             converter.String(testDataFeedId),
+			Project:            converter.String(""),
+			ProtocolType:       converter.String("NuGet"),
+			PackageNameQuery:   converter.String("Contoso"),
+			IncludeAllVersions: converter.Bool(false),
+			IncludeDeleted:     converter.Bool(false),
+			Top:                converter.Int(feedPackagesPageSize),
+			Skip:               converter.Int(0),
+		}).
+		Return(&packages, nil).
+		Times(1)
+
+	diags := r.ReadContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, 2, resourceData.Get("packages.#"))
+	require.Equal(t, "nuget", resourceData.Get("packages.0.protocol_type"))
+	require.Equal(t, "contoso.widgets.extensions", resourceData.Get("packages.1.normalized_name"))
+	require.Equal(t, 0, resourceData.Get("packages.1.versions.#"))
+}
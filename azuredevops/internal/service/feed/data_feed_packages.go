@@ -0,0 +1,175 @@
+package feed
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+// DataFeedPackages schema and implementation for the feed packages data source
+func DataFeedPackages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFeedPackagesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"protocol_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringInSlice(packageProtocolTypeNames, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"package_name_query": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"include_all_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_deleted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"packages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     feedPackageSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceFeedPackagesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	feedId := d.Get("feed_id").(string)
+	projectId := d.Get("project_id").(string)
+
+	args := feed.GetPackagesArgs{
+		FeedId:             converter.String(feedId),
+		Project:            converter.String(projectId),
+		IncludeAllVersions: converter.Bool(d.Get("include_all_versions").(bool)),
+		IncludeDeleted:     converter.Bool(d.Get("include_deleted").(bool)),
+	}
+	if v, ok := d.GetOk("protocol_type"); ok {
+		args.ProtocolType = converter.String(packageProtocolTypes[strings.ToLower(v.(string))])
+	}
+	if v, ok := d.GetOk("package_name_query"); ok {
+		args.PackageNameQuery = converter.String(v.(string))
+	}
+
+	packages, err := listFeedPackages(clients, args)
+	if err != nil {
+		return diag.Errorf(" Reading packages. Project ID: %s, Feed ID: %s, Error: %+v", projectId, feedId, err)
+	}
+
+	results := make([]interface{}, 0, len(packages))
+	for _, pkg := range packages {
+		results = append(results, flattenFeedPackage(pkg))
+	}
+
+	d.SetId("packages-" + uuid.New().String())
+	if err := d.Set("packages", results); err != nil {
+		return diag.Errorf(" Setting packages: %+v", err)
+	}
+	return nil
+}
+
+func feedPackageSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"normalized_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     packageVersionSchema(),
+			},
+		},
+	}
+}
+
+func packageVersionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"normalized_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_latest": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_listed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_deleted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_cached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"publish_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"views": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
@@ -0,0 +1,349 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/maven"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pypiapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/universal"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// feedPackagesPageSize the number of packages requested per page
+const feedPackagesPageSize = 500
+
+// packageProtocolTypes maps the configured protocol types to the protocol types of the service
+var packageProtocolTypes = map[string]string{
+	"nuget": "NuGet",
+	"npm":   "Npm",
+	"maven": "Maven",
+	"pypi":  "PyPi",
+	"upack": "UPack",
+}
+
+var packageProtocolTypeNames = []string{"nuget", "npm", "maven", "pypi", "upack"}
+
+// listFeedPackages returns all packages matching the arguments, the pagination arguments are overwritten
+func listFeedPackages(clients *client.AggregatedClient, args feed.GetPackagesArgs) ([]feed.Package, error) {
+	var packages []feed.Package
+	args.Top = converter.Int(feedPackagesPageSize)
+	for skip := 0; ; skip += feedPackagesPageSize {
+		args.Skip = converter.Int(skip)
+		page, err := clients.FeedClient.GetPackages(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			break
+		}
+		packages = append(packages, *page...)
+		if len(*page) < feedPackagesPageSize {
+			break
+		}
+	}
+	return packages, nil
+}
+
+// findFeedPackage looks up a package with all of its versions by name. Returns nil if the package does not exist.
+func findFeedPackage(clients *client.AggregatedClient, projectId string, feedId string, protocolType string, name string, includeDeleted bool) (*feed.Package, error) {
+	packages, err := listFeedPackages(clients, feed.GetPackagesArgs{
+		FeedId:             converter.String(feedId),
+		Project:            converter.String(projectId),
+		ProtocolType:       converter.String(packageProtocolTypes[strings.ToLower(protocolType)]),
+		PackageNameQuery:   converter.String(name),
+		IncludeAllVersions: converter.Bool(true),
+		IncludeDeleted:     converter.Bool(includeDeleted),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range packages {
+		if strings.EqualFold(converter.ToString(pkg.Name, ""), name) || strings.EqualFold(converter.ToString(pkg.NormalizedName, ""), name) {
+			return &pkg, nil
+		}
+	}
+	return nil, nil
+}
+
+// findPackageVersion returns the version of a package, matched by version or normalized version
+func findPackageVersion(pkg *feed.Package, version string) *feed.MinimalPackageVersion {
+	if pkg == nil || pkg.Versions == nil {
+		return nil
+	}
+	for _, packageVersion := range *pkg.Versions {
+		if strings.EqualFold(converter.ToString(packageVersion.Version, ""), version) || strings.EqualFold(converter.ToString(packageVersion.NormalizedVersion, ""), version) {
+			return &packageVersion
+		}
+	}
+	return nil
+}
+
+func flattenFeedPackage(pkg feed.Package) map[string]interface{} {
+	result := map[string]interface{}{
+		"name":            converter.ToString(pkg.Name, ""),
+		"normalized_name": converter.ToString(pkg.NormalizedName, ""),
+		"protocol_type":   strings.ToLower(converter.ToString(pkg.ProtocolType, "")),
+		"url":             converter.ToString(pkg.Url, ""),
+		"versions":        flattenPackageVersions(pkg.Versions),
+	}
+	if pkg.Id != nil {
+		result["id"] = pkg.Id.String()
+	}
+	return result
+}
+
+func flattenPackageVersions(versions *[]feed.MinimalPackageVersion) []interface{} {
+	results := []interface{}{}
+	if versions == nil {
+		return results
+	}
+	for _, version := range *versions {
+		result := map[string]interface{}{
+			"version":            converter.ToString(version.Version, ""),
+			"normalized_version": converter.ToString(version.NormalizedVersion, ""),
+			"is_latest":          converter.ToBool(version.IsLatest, false),
+			"is_listed":          converter.ToBool(version.IsListed, false),
+			"is_deleted":         converter.ToBool(version.IsDeleted, false),
+			"is_cached":          converter.ToBool(version.IsCachedVersion, false),
+			"views":              packageVersionViewNames(version.Views),
+		}
+		if version.Id != nil {
+			result["id"] = version.Id.String()
+		}
+		if version.PublishDate != nil {
+			result["publish_date"] = version.PublishDate.Time.Format(time.RFC3339)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// packageVersionViewNames returns the names of the release views a package version was promoted to
+func packageVersionViewNames(views *[]feed.FeedView) []string {
+	names := []string{}
+	if views == nil {
+		return names
+	}
+	for _, view := range *views {
+		if view.Type != nil && *view.Type == feed.FeedViewTypeValues.Implicit {
+			continue
+		}
+		names = append(names, converter.ToString(view.Name, ""))
+	}
+	return names
+}
+
+// packageVersion identifies a version of a package in a feed
+type packageVersion struct {
+	projectId    string
+	feedId       string
+	protocolType string
+	name         string
+	version      string
+}
+
+func (p packageVersion) String() string {
+	return fmt.Sprintf("%s %s %s", p.protocolType, p.name, p.version)
+}
+
+// promotePackageVersion adds a package version to a release view of the feed
+func promotePackageVersion(clients *client.AggregatedClient, p packageVersion, view string) error {
+	return updatePackageVersion(clients, p, &webapi.JsonPatchOperation{
+		Op:    &webapi.OperationValues.Add,
+		Path:  converter.String("/views/-"),
+		Value: view,
+	}, nil, nil)
+}
+
+// listPackageVersion lists or unlists a package version, only supported for NuGet packages
+func listPackageVersion(clients *client.AggregatedClient, p packageVersion, listed bool) error {
+	return updatePackageVersion(clients, p, nil, &listed, nil)
+}
+
+// deprecatePackageVersion deprecates a package version, an empty message removes the deprecation. Only supported for
+// npm packages.
+func deprecatePackageVersion(clients *client.AggregatedClient, p packageVersion, message string) error {
+	return updatePackageVersion(clients, p, nil, nil, &message)
+}
+
+func updatePackageVersion(clients *client.AggregatedClient, p packageVersion, views *webapi.JsonPatchOperation, listed *bool, deprecateMessage *string) error {
+	if listed != nil && p.protocolType != "nuget" {
+		return fmt.Errorf(" Listing package versions is only supported for NuGet packages, package type: %s", p.protocolType)
+	}
+	if deprecateMessage != nil && p.protocolType != "npm" {
+		return fmt.Errorf(" Deprecating package versions is only supported for npm packages, package type: %s", p.protocolType)
+	}
+
+	switch p.protocolType {
+	case "nuget":
+		return clients.NuGetClient.UpdatePackageVersion(clients.Ctx, nuget.UpdatePackageVersionArgs{
+			PackageVersionDetails: &nuget.PackageVersionDetails{Views: views, Listed: listed},
+			FeedId:                converter.String(p.feedId),
+			PackageName:           converter.String(p.name),
+			PackageVersion:        converter.String(p.version),
+			Project:               converter.String(p.projectId),
+		})
+	case "npm":
+		details := &npm.PackageVersionDetails{Views: views, DeprecateMessage: deprecateMessage}
+		if scope, name, ok := npmPackageScope(p.name); ok {
+			_, err := clients.NpmClient.UpdateScopedPackage(clients.Ctx, npm.UpdateScopedPackageArgs{
+				PackageVersionDetails: details,
+				FeedId:                converter.String(p.feedId),
+				PackageScope:          converter.String(scope),
+				UnscopedPackageName:   converter.String(name),
+				PackageVersion:        converter.String(p.version),
+				Project:               converter.String(p.projectId),
+			})
+			return err
+		}
+		_, err := clients.NpmClient.UpdatePackage(clients.Ctx, npm.UpdatePackageArgs{
+			PackageVersionDetails: details,
+			FeedId:                converter.String(p.feedId),
+			PackageName:           converter.String(p.name),
+			PackageVersion:        converter.String(p.version),
+			Project:               converter.String(p.projectId),
+		})
+		return err
+	case "maven":
+		groupId, artifactId, err := mavenPackageCoordinates(p.name)
+		if err != nil {
+			return err
+		}
+		return clients.MavenClient.UpdatePackageVersion(clients.Ctx, maven.UpdatePackageVersionArgs{
+			PackageVersionDetails: &maven.PackageVersionDetails{Views: views},
+			Feed:                  converter.String(p.feedId),
+			GroupId:               converter.String(groupId),
+			ArtifactId:            converter.String(artifactId),
+			Version:               converter.String(p.version),
+			Project:               converter.String(p.projectId),
+		})
+	case "pypi":
+		return clients.PyPiClient.UpdatePackageVersion(clients.Ctx, pypiapi.UpdatePackageVersionArgs{
+			PackageVersionDetails: &pypiapi.PackageVersionDetails{Views: views},
+			FeedId:                converter.String(p.feedId),
+			PackageName:           converter.String(p.name),
+			PackageVersion:        converter.String(p.version),
+			Project:               converter.String(p.projectId),
+		})
+	case "upack":
+		return clients.UPackClient.UpdatePackageVersion(clients.Ctx, universal.UpdatePackageVersionArgs{
+			PackageVersionDetails: &universal.PackageVersionDetails{Views: views},
+			FeedId:                converter.String(p.feedId),
+			PackageName:           converter.String(p.name),
+			PackageVersion:        converter.String(p.version),
+			Project:               converter.String(p.projectId),
+		})
+	}
+	return fmt.Errorf(" Unsupported package type: %s", p.protocolType)
+}
+
+// deletePackageVersion moves a package version to the recycle bin of the feed
+func deletePackageVersion(clients *client.AggregatedClient, p packageVersion) error {
+	var err error
+	switch p.protocolType {
+	case "nuget":
+		_, err = clients.NuGetClient.DeletePackageVersion(clients.Ctx, nuget.DeletePackageVersionArgs{
+			FeedId:         converter.String(p.feedId),
+			PackageName:    converter.String(p.name),
+			PackageVersion: converter.String(p.version),
+			Project:        converter.String(p.projectId),
+		})
+	case "npm":
+		if scope, name, ok := npmPackageScope(p.name); ok {
+			_, err = clients.NpmClient.UnpublishScopedPackage(clients.Ctx, npm.UnpublishScopedPackageArgs{
+				FeedId:              converter.String(p.feedId),
+				PackageScope:        converter.String(scope),
+				UnscopedPackageName: converter.String(name),
+				PackageVersion:      converter.String(p.version),
+				Project:             converter.String(p.projectId),
+			})
+		} else {
+			_, err = clients.NpmClient.UnpublishPackage(clients.Ctx, npm.UnpublishPackageArgs{
+				FeedId:         converter.String(p.feedId),
+				PackageName:    converter.String(p.name),
+				PackageVersion: converter.String(p.version),
+				Project:        converter.String(p.projectId),
+			})
+		}
+	case "maven":
+		groupId, artifactId, parseErr := mavenPackageCoordinates(p.name)
+		if parseErr != nil {
+			return parseErr
+		}
+		err = clients.MavenClient.PackageDelete(clients.Ctx, maven.PackageDeleteArgs{
+			Feed:       converter.String(p.feedId),
+			GroupId:    converter.String(groupId),
+			ArtifactId: converter.String(artifactId),
+			Version:    converter.String(p.version),
+			Project:    converter.String(p.projectId),
+		})
+	case "pypi":
+		_, err = clients.PyPiClient.DeletePackageVersion(clients.Ctx, pypiapi.DeletePackageVersionArgs{
+			FeedId:         converter.String(p.feedId),
+			PackageName:    converter.String(p.name),
+			PackageVersion: converter.String(p.version),
+			Project:        converter.String(p.projectId),
+		})
+	case "upack":
+		_, err = clients.UPackClient.DeletePackageVersion(clients.Ctx, universal.DeletePackageVersionArgs{
+			FeedId:         converter.String(p.feedId),
+			PackageName:    converter.String(p.name),
+			PackageVersion: converter.String(p.version),
+			Project:        converter.String(p.projectId),
+		})
+	default:
+		return fmt.Errorf(" Unsupported package type: %s", p.protocolType)
+	}
+	return err
+}
+
+// getNpmDeprecateMessage returns the deprecation message of an npm package version
+func getNpmDeprecateMessage(clients *client.AggregatedClient, p packageVersion) (string, error) {
+	var pkg *npm.Package
+	var err error
+	if scope, name, ok := npmPackageScope(p.name); ok {
+		pkg, err = clients.NpmClient.GetScopedPackageInfo(clients.Ctx, npm.GetScopedPackageInfoArgs{
+			FeedId:              converter.String(p.feedId),
+			PackageScope:        converter.String(scope),
+			UnscopedPackageName: converter.String(name),
+			PackageVersion:      converter.String(p.version),
+			Project:             converter.String(p.projectId),
+		})
+	} else {
+		pkg, err = clients.NpmClient.GetPackageInfo(clients.Ctx, npm.GetPackageInfoArgs{
+			FeedId:         converter.String(p.feedId),
+			PackageName:    converter.String(p.name),
+			PackageVersion: converter.String(p.version),
+			Project:        converter.String(p.projectId),
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+	return converter.ToString(pkg.DeprecateMessage, ""), nil
+}
+
+// npmPackageScope splits a scoped npm package name, e.g. `@scope/name`, into the scope without the `@` and the name
+func npmPackageScope(packageName string) (string, string, bool) {
+	if !strings.HasPrefix(packageName, "@") {
+		return "", "", false
+	}
+	scope, name, ok := strings.Cut(strings.TrimPrefix(packageName, "@"), "/")
+	return scope, name, ok && scope != "" && name != ""
+}
+
+// mavenPackageCoordinates splits a Maven package name, `<group ID>:<artifact ID>`, into its coordinates
+func mavenPackageCoordinates(packageName string) (string, string, error) {
+	groupId, artifactId, ok := strings.Cut(packageName, ":")
+	if !ok || groupId == "" || artifactId == "" {
+		return "", "", fmt.Errorf(" Maven package names must be in the format <group ID>:<artifact ID>, got: %s", packageName)
+	}
+	return groupId, artifactId, nil
+}
@@ -3,6 +3,7 @@ package feed
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -13,9 +14,12 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
+var upstreamProtocols = []string{"npm", "nuget", "pypi", "maven", "cargo", "upack"}
+
 func ResourceFeed() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFeedCreate,
@@ -82,6 +86,90 @@ func ResourceFeed() *schema.Resource {
 					},
 				},
 			},
+			"allow_upstream_overrides": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"upstream_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringInSlice(upstreamProtocols, true),
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+						"location": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"feed_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"feed_project_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"view_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"view": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^@\s]`), "view names are configured without the leading `@`"),
+						},
+						"visibility": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(feed.FeedVisibilityValues.Private),
+								string(feed.FeedVisibilityValues.Collection),
+								string(feed.FeedVisibilityValues.Organization),
+								string(feed.FeedVisibilityValues.AadTenant),
+							}, false),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -103,10 +191,19 @@ func resourceFeedCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	newFeed := feed.Feed{
+		Name: &name,
+	}
+	if _, ok := d.GetOk("upstream_source"); ok {
+		upstreamSources, err := expandUpstreamSources(clients, d.Get("upstream_source").([]interface{}))
+		if err != nil {
+			return err
+		}
+		newFeed.UpstreamSources = upstreamSources
+	}
+
 	feedDetail, err := clients.FeedClient.CreateFeed(clients.Ctx, feed.CreateFeedArgs{
-		Feed: &feed.Feed{
-			Name: &name,
-		},
+		Feed:    &newFeed,
 		Project: &projectId,
 	})
 	if err != nil {
@@ -114,6 +211,24 @@ func resourceFeedCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(feedDetail.Id.String())
+
+	// the setting is not part of the feed model used for the creation
+	if d.Get("allow_upstream_overrides").(bool) {
+		_, err = clients.FeedClient.UpdateFeed(clients.Ctx, feed.UpdateFeedArgs{
+			Feed:    &feed.FeedUpdate{AllowUpstreamNameConflict: converter.Bool(true)},
+			FeedId:  converter.String(d.Id()),
+			Project: &projectId,
+		})
+		if err != nil {
+			return fmt.Errorf("updating the upstream settings of feed. Name: %s, Error: %+v", name, err)
+		}
+	}
+
+	if _, ok := d.GetOk("view"); ok {
+		if err := updateFeedViews(clients, d.Id(), projectId, nil, d.Get("view").([]interface{})); err != nil {
+			return err
+		}
+	}
 	return resourceFeedRead(d, m)
 }
 
@@ -123,7 +238,7 @@ func resourceFeedRead(d *schema.ResourceData, m interface{}) error {
 	feedID := d.Id()
 	projectId := d.Get("project_id").(string)
 
-	feedDetail, err := clients.FeedClient.GetFeed(clients.Ctx, feed.GetFeedArgs{
+	feedDetail, err := clients.FeedClientExtras.GetFeed(clients.Ctx, feed.GetFeedArgs{
 		FeedId:  &feedID,
 		Project: &projectId,
 	})
@@ -140,8 +255,27 @@ func resourceFeedRead(d *schema.ResourceData, m interface{}) error {
 		if feedDetail.Project != nil {
 			d.Set("project_id", feedDetail.Project.Id.String())
 		}
+		d.Set("allow_upstream_overrides", converter.ToBool(feedDetail.AllowUpstreamNameConflict, false))
+		// The upstream sources are only tracked once configured, the service may add default ones to a new feed
+		upstreamSources := []interface{}{}
+		if len(d.Get("upstream_source").([]interface{})) > 0 {
+			upstreamSources = flattenUpstreamSources(feedDetail.UpstreamSources)
+		}
+		if err := d.Set("upstream_source", upstreamSources); err != nil {
+			return fmt.Errorf("setting upstream_source: %+v", err)
+		}
 	}
 
+	views, err := clients.FeedClient.GetFeedViews(clients.Ctx, feed.GetFeedViewsArgs{
+		FeedId:  &feedID,
+		Project: &projectId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed get feed views. Project ID: %s, Feed ID: %s. Error: %+v", projectId, feedID, err)
+	}
+	if err := d.Set("view", flattenFeedViews(views, d.Get("view").([]interface{}))); err != nil {
+		return fmt.Errorf("setting view: %+v", err)
+	}
 	return nil
 }
 
@@ -150,8 +284,20 @@ func resourceFeedUpdate(d *schema.ResourceData, m interface{}) error {
 	name := d.Get("name").(string)
 	projectId := d.Get("project_id").(string)
 
+	feedUpdate := feed.FeedUpdate{}
+	if d.HasChange("upstream_source") {
+		upstreamSources, err := expandUpstreamSources(clients, d.Get("upstream_source").([]interface{}))
+		if err != nil {
+			return err
+		}
+		feedUpdate.UpstreamSources = upstreamSources
+	}
+	if d.HasChange("allow_upstream_overrides") {
+		feedUpdate.AllowUpstreamNameConflict = converter.Bool(d.Get("allow_upstream_overrides").(bool))
+	}
+
 	_, err := clients.FeedClient.UpdateFeed(clients.Ctx, feed.UpdateFeedArgs{
-		Feed:    &feed.FeedUpdate{},
+		Feed:    &feedUpdate,
 		FeedId:  &name,
 		Project: &projectId,
 	})
@@ -159,6 +305,12 @@ func resourceFeedUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if d.HasChange("view") {
+		oldViews, newViews := d.GetChange("view")
+		if err := updateFeedViews(clients, d.Id(), projectId, oldViews.([]interface{}), newViews.([]interface{})); err != nil {
+			return err
+		}
+	}
 	return resourceFeedRead(d, m)
 }
 
@@ -221,6 +373,226 @@ func restoreFeed(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// expandUpstreamSources converts the configured upstream sources. Upstream sources referencing an Azure Artifacts feed
+// are located by the names of the feed, its project and the view.
+func expandUpstreamSources(clients *client.AggregatedClient, input []interface{}) (*[]feed.UpstreamSource, error) {
+	upstreamSources := []feed.UpstreamSource{}
+	for _, raw := range input {
+		config := raw.(map[string]interface{})
+		name := config["name"].(string)
+		upstreamSource := feed.UpstreamSource{
+			Name:     converter.String(name),
+			Protocol: converter.String(strings.ToLower(config["protocol"].(string))),
+		}
+
+		feedId := config["feed_id"].(string)
+		location := config["location"].(string)
+		if feedId == "" {
+			if location == "" {
+				return nil, fmt.Errorf(" Upstream source %s requires either a `location` or a `feed_id`", name)
+			}
+			upstreamSource.UpstreamSourceType = &feed.UpstreamSourceTypeValues.Public
+			upstreamSource.Location = converter.String(location)
+			upstreamSources = append(upstreamSources, upstreamSource)
+			continue
+		}
+
+		viewId := config["view_id"].(string)
+		if viewId == "" {
+			return nil, fmt.Errorf(" Upstream source %s references feed %s and requires a `view_id`", name, feedId)
+		}
+		feedProjectId := config["feed_project_id"].(string)
+
+		upstreamLocation, err := internalUpstreamLocation(clients, feedProjectId, feedId, viewId)
+		if err != nil {
+			return nil, fmt.Errorf(" Locating upstream source %s: %+v", name, err)
+		}
+		upstreamSource.UpstreamSourceType = &feed.UpstreamSourceTypeValues.Internal
+		upstreamSource.Location = converter.String(upstreamLocation)
+		upstreamSource.InternalUpstreamFeedId = converter.UUID(feedId)
+		upstreamSource.InternalUpstreamViewId = converter.UUID(viewId)
+		if feedProjectId != "" {
+			upstreamSource.InternalUpstreamProjectId = converter.UUID(feedProjectId)
+		}
+		upstreamSources = append(upstreamSources, upstreamSource)
+	}
+	return &upstreamSources, nil
+}
+
+// internalUpstreamLocation returns the location of a view of an Azure Artifacts feed within the organization, e.g.
+// azure-feed://<organization>/<project>/<feed>@<view>
+func internalUpstreamLocation(clients *client.AggregatedClient, projectId string, feedId string, viewId string) (string, error) {
+	upstreamFeed, err := clients.FeedClient.GetFeed(clients.Ctx, feed.GetFeedArgs{
+		FeedId:  converter.String(feedId),
+		Project: converter.String(projectId),
+	})
+	if err != nil {
+		return "", err
+	}
+	view, err := clients.FeedClient.GetFeedView(clients.Ctx, feed.GetFeedViewArgs{
+		FeedId:  converter.String(feedId),
+		ViewId:  converter.String(viewId),
+		Project: converter.String(projectId),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	path := converter.ToString(upstreamFeed.Name, feedId)
+	if upstreamFeed.Project != nil && upstreamFeed.Project.Name != nil {
+		path = *upstreamFeed.Project.Name + "/" + path
+	}
+	organizationName, err := utils.GetOrganizationName(clients.OrganizationURL)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("azure-feed://%s/%s@%s", organizationName, path, converter.ToString(view.Name, viewId)), nil
+}
+
+func flattenUpstreamSources(upstreamSources *[]feed.UpstreamSource) []interface{} {
+	results := []interface{}{}
+	if upstreamSources == nil {
+		return results
+	}
+	for _, upstreamSource := range *upstreamSources {
+		if upstreamSource.DeletedDate != nil {
+			continue
+		}
+		result := map[string]interface{}{
+			"name":     converter.ToString(upstreamSource.Name, ""),
+			"protocol": converter.ToString(upstreamSource.Protocol, ""),
+			"location": converter.ToString(upstreamSource.Location, ""),
+		}
+		if upstreamSource.Id != nil {
+			result["id"] = upstreamSource.Id.String()
+		}
+		if upstreamSource.UpstreamSourceType != nil {
+			result["type"] = string(*upstreamSource.UpstreamSourceType)
+		}
+		if upstreamSource.InternalUpstreamFeedId != nil {
+			result["feed_id"] = upstreamSource.InternalUpstreamFeedId.String()
+		}
+		if upstreamSource.InternalUpstreamProjectId != nil {
+			result["feed_project_id"] = upstreamSource.InternalUpstreamProjectId.String()
+		}
+		if upstreamSource.InternalUpstreamViewId != nil {
+			result["view_id"] = upstreamSource.InternalUpstreamViewId.String()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// updateFeedViews creates the configured views which do not exist yet, updates the visibility of the existing views
+// and deletes the views which were removed from the configuration. The implicit `Local` view cannot be deleted.
+func updateFeedViews(clients *client.AggregatedClient, feedId string, projectId string, oldViews []interface{}, newViews []interface{}) error {
+	views, err := clients.FeedClient.GetFeedViews(clients.Ctx, feed.GetFeedViewsArgs{
+		FeedId:  converter.String(feedId),
+		Project: converter.String(projectId),
+	})
+	if err != nil {
+		return fmt.Errorf("failed get feed views. Project ID: %s, Feed ID: %s. Error: %+v", projectId, feedId, err)
+	}
+	existing := map[string]feed.FeedView{}
+	if views != nil {
+		for _, view := range *views {
+			existing[strings.ToLower(converter.ToString(view.Name, ""))] = view
+		}
+	}
+
+	configured := map[string]bool{}
+	for _, raw := range newViews {
+		config := raw.(map[string]interface{})
+		name := config["name"].(string)
+		visibility := feed.FeedVisibility(config["visibility"].(string))
+		configured[strings.ToLower(name)] = true
+
+		view, ok := existing[strings.ToLower(name)]
+		if !ok {
+			_, err := clients.FeedClient.CreateFeedView(clients.Ctx, feed.CreateFeedViewArgs{
+				View: &feed.FeedView{
+					Name:       converter.String(name),
+					Type:       &feed.FeedViewTypeValues.Release,
+					Visibility: &visibility,
+				},
+				FeedId:  converter.String(feedId),
+				Project: converter.String(projectId),
+			})
+			if err != nil {
+				return fmt.Errorf("creating feed view. Feed ID: %s, View: %s, Error: %+v", feedId, name, err)
+			}
+			continue
+		}
+		if view.Visibility == nil || *view.Visibility != visibility {
+			_, err := clients.FeedClient.UpdateFeedView(clients.Ctx, feed.UpdateFeedViewArgs{
+				View:    &feed.FeedView{Visibility: &visibility},
+				FeedId:  converter.String(feedId),
+				ViewId:  converter.String(view.Id.String()),
+				Project: converter.String(projectId),
+			})
+			if err != nil {
+				return fmt.Errorf("updating feed view. Feed ID: %s, View: %s, Error: %+v", feedId, name, err)
+			}
+		}
+	}
+
+	for _, raw := range oldViews {
+		name := raw.(map[string]interface{})["name"].(string)
+		view, ok := existing[strings.ToLower(name)]
+		if configured[strings.ToLower(name)] || !ok || (view.Type != nil && *view.Type == feed.FeedViewTypeValues.Implicit) {
+			continue
+		}
+		err := clients.FeedClient.DeleteFeedView(clients.Ctx, feed.DeleteFeedViewArgs{
+			FeedId:  converter.String(feedId),
+			ViewId:  converter.String(view.Id.String()),
+			Project: converter.String(projectId),
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf("deleting feed view. Feed ID: %s, View: %s, Error: %+v", feedId, name, err)
+		}
+	}
+	return nil
+}
+
+// flattenFeedViews returns the configured views in the order of the configuration. The views every feed has, e.g.
+// `Local`, are not tracked unless configured.
+func flattenFeedViews(views *[]feed.FeedView, configured []interface{}) []interface{} {
+	results := []interface{}{}
+	if views == nil {
+		return results
+	}
+	byName := map[string]feed.FeedView{}
+	for _, view := range *views {
+		byName[strings.ToLower(converter.ToString(view.Name, ""))] = view
+	}
+
+	flattenView := func(view feed.FeedView) map[string]interface{} {
+		result := map[string]interface{}{
+			"name": converter.ToString(view.Name, ""),
+		}
+		if view.Id != nil {
+			result["id"] = view.Id.String()
+		}
+		if view.Visibility != nil {
+			result["visibility"] = string(*view.Visibility)
+		}
+		if view.Type != nil {
+			result["type"] = string(*view.Type)
+		}
+		return result
+	}
+
+	for _, raw := range configured {
+		if raw == nil {
+			continue
+		}
+		if view, ok := byName[strings.ToLower(raw.(map[string]interface{})["name"].(string))]; ok {
+			results = append(results, flattenView(view))
+		}
+	}
+	return results
+}
+
 func expandFeedFeatures(input []interface{}) map[string]interface{} {
 	if len(input) == 0 || input[0] == nil {
 		return map[string]interface{}{}
@@ -0,0 +1,302 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceFeedPackageVersion schema and implementation for promoting, listing, deprecating and deleting a package version
+func ResourceFeedPackageVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFeedPackageVersionCreate,
+		ReadContext:   resourceFeedPackageVersionRead,
+		UpdateContext: resourceFeedPackageVersionUpdate,
+		DeleteContext: resourceFeedPackageVersionDelete,
+		CustomizeDiff: resourceFeedPackageVersionCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importFeedPackageVersion,
+		},
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"protocol_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringInSlice(packageProtocolTypeNames, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"views": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"listed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"deprecation_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delete_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_latest": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFeedPackageVersionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	protocolType := strings.ToLower(d.Get("protocol_type").(string))
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if listed := rawConfig.GetAttr("listed"); !listed.IsNull() && protocolType != "nuget" {
+		return fmt.Errorf(" `listed` is only supported for NuGet packages, package type: %s", protocolType)
+	}
+	if message := rawConfig.GetAttr("deprecation_message"); !message.IsNull() && protocolType != "npm" {
+		return fmt.Errorf(" `deprecation_message` is only supported for npm packages, package type: %s", protocolType)
+	}
+	return nil
+}
+
+func resourceFeedPackageVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	p := getPackageVersion(d)
+
+	pkg, err := findFeedPackage(clients, p.projectId, p.feedId, p.protocolType, p.name, false)
+	if err != nil {
+		return diag.Errorf(" Reading package. Feed ID: %s, Package: %s, Error: %+v", p.feedId, p.name, err)
+	}
+	version := findPackageVersion(pkg, p.version)
+	if version == nil || converter.ToBool(version.IsDeleted, false) {
+		return diag.Errorf(" Package version %s does not exist in feed %s", p, p.feedId)
+	}
+
+	currentViews := packageVersionViewNames(version.Views)
+	for _, view := range tfhelper.ExpandStringSet(d.Get("views").(*schema.Set)) {
+		if containsFold(currentViews, view) {
+			continue
+		}
+		if err := promotePackageVersion(clients, p, view); err != nil {
+			return diag.Errorf(" Promoting package version %s to view %s. Error: %+v", p, view, err)
+		}
+	}
+
+	if isListedConfigured(d) {
+		listed := d.Get("listed").(bool)
+		if listed != converter.ToBool(version.IsListed, true) {
+			if err := listPackageVersion(clients, p, listed); err != nil {
+				return diag.Errorf(" Updating listing of package version %s. Error: %+v", p, err)
+			}
+		}
+	}
+
+	if message, ok := d.GetOk("deprecation_message"); ok {
+		if err := deprecatePackageVersion(clients, p, message.(string)); err != nil {
+			return diag.Errorf(" Deprecating package version %s. Error: %+v", p, err)
+		}
+	}
+
+	d.SetId(version.Id.String())
+	return resourceFeedPackageVersionRead(ctx, d, m)
+}
+
+func resourceFeedPackageVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	p := getPackageVersion(d)
+
+	pkg, err := findFeedPackage(clients, p.projectId, p.feedId, p.protocolType, p.name, false)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading package. Feed ID: %s, Package: %s, Error: %+v", p.feedId, p.name, err)
+	}
+	version := findPackageVersion(pkg, p.version)
+	if version == nil || converter.ToBool(version.IsDeleted, false) {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(version.Id.String())
+	d.Set("package_id", pkg.Id.String())
+	d.Set("package_name", converter.ToString(pkg.Name, p.name))
+	d.Set("version", converter.ToString(version.Version, p.version))
+	d.Set("is_latest", converter.ToBool(version.IsLatest, false))
+	d.Set("listed", converter.ToBool(version.IsListed, true))
+	d.Set("views", flattenPackageVersionViews(packageVersionViewNames(version.Views), d.Get("views").(*schema.Set)))
+
+	if p.protocolType == "npm" {
+		message, err := getNpmDeprecateMessage(clients, p)
+		if err != nil {
+			return diag.Errorf(" Reading deprecation of package version %s. Error: %+v", p, err)
+		}
+		d.Set("deprecation_message", message)
+	}
+	return nil
+}
+
+func resourceFeedPackageVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	p := getPackageVersion(d)
+
+	if d.HasChange("views") {
+		oldViews, newViews := d.GetChange("views")
+		// Promotion can not be reverted, views removed from the configuration are left untouched
+		for _, view := range tfhelper.ExpandStringSet(newViews.(*schema.Set).Difference(oldViews.(*schema.Set))) {
+			if err := promotePackageVersion(clients, p, view); err != nil {
+				return diag.Errorf(" Promoting package version %s to view %s. Error: %+v", p, view, err)
+			}
+		}
+	}
+
+	if d.HasChange("listed") && isListedConfigured(d) {
+		if err := listPackageVersion(clients, p, d.Get("listed").(bool)); err != nil {
+			return diag.Errorf(" Updating listing of package version %s. Error: %+v", p, err)
+		}
+	}
+
+	if d.HasChange("deprecation_message") {
+		if err := deprecatePackageVersion(clients, p, d.Get("deprecation_message").(string)); err != nil {
+			return diag.Errorf(" Deprecating package version %s. Error: %+v", p, err)
+		}
+	}
+	return resourceFeedPackageVersionRead(ctx, d, m)
+}
+
+func resourceFeedPackageVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("delete_on_destroy").(bool) {
+		return nil
+	}
+
+	clients := m.(*client.AggregatedClient)
+	p := getPackageVersion(d)
+	if err := deletePackageVersion(clients, p); err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Deleting package version %s. Error: %+v", p, err)
+	}
+	return nil
+}
+
+// importFeedPackageVersion imports a package version by `[<project ID>/]<feed ID>/<protocol type>/<package name>/<version>`.
+// Scoped npm package names contain a `/`, so the position of the protocol type decides whether a project ID is present.
+func importFeedPackageVersion(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) >= 4 {
+		protocolIdx := -1
+		if _, ok := packageProtocolTypes[strings.ToLower(parts[1])]; ok {
+			protocolIdx = 1
+		} else if _, ok := packageProtocolTypes[strings.ToLower(parts[2])]; ok && len(parts) >= 5 {
+			protocolIdx = 2
+			d.Set("project_id", parts[0])
+		}
+		if protocolIdx > 0 && len(parts) > protocolIdx+2 {
+			d.Set("feed_id", parts[protocolIdx-1])
+			d.Set("protocol_type", strings.ToLower(parts[protocolIdx]))
+			d.Set("package_name", strings.Join(parts[protocolIdx+1:len(parts)-1], "/"))
+			d.Set("version", parts[len(parts)-1])
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+	return nil, fmt.Errorf(" Unexpected ID format (%q), Expected: [<project ID>/]<feed ID>/<protocol type>/<package name>/<version>", d.Id())
+}
+
+func getPackageVersion(d *schema.ResourceData) packageVersion {
+	return packageVersion{
+		projectId:    d.Get("project_id").(string),
+		feedId:       d.Get("feed_id").(string),
+		protocolType: strings.ToLower(d.Get("protocol_type").(string)),
+		name:         d.Get("package_name").(string),
+		version:      d.Get("version").(string),
+	}
+}
+
+// isListedConfigured reports whether `listed` is set in the configuration, the computed value must not change the listing
+func isListedConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	return !rawConfig.IsNull() && !rawConfig.GetAttr("listed").IsNull()
+}
+
+// flattenPackageVersionViews returns the configured views the package version was promoted to, or all views if none
+// are configured. Views the version was promoted to outside of Terraform do not cause a diff. View names are case
+// insensitive, the configured spelling is kept.
+func flattenPackageVersionViews(views []string, configured *schema.Set) []interface{} {
+	results := []interface{}{}
+	if configured.Len() == 0 {
+		for _, view := range views {
+			results = append(results, view)
+		}
+		return results
+	}
+	for _, view := range configured.List() {
+		if containsFold(views, view.(string)) {
+			results = append(results, view)
+		}
+	}
+	return results
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,325 @@
+//go:build (all || resource_feed) && !exclude_feed
+// +build all resource_feed
+// +build !exclude_feed
+
+package feed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/maven"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testPackageId        = uuid.New()
+	testPackageVersionId = uuid.New()
+)
+
+func testFeedPackage(name string, protocolType string, views ...feed.FeedView) []feed.Package {
+	return []feed.Package{{
+		Id:             &testPackageId,
+		Name:           converter.String(name),
+		NormalizedName: converter.String(name),
+		ProtocolType:   converter.String(protocolType),
+		Versions: &[]feed.MinimalPackageVersion{
+			{
+				Id:        converter.UUID(uuid.New().String()),
+				Version:   converter.String("0.9.0"),
+				IsListed:  converter.Bool(true),
+				IsDeleted: converter.Bool(true),
+			},
+			{
+				Id:       &testPackageVersionId,
+				Version:  converter.String("1.0.0"),
+				IsLatest: converter.Bool(true),
+				IsListed: converter.Bool(true),
+				Views:    &views,
+			},
+		},
+	}}
+}
+
+func TestFeedPackageVersion_ListFeedPackages_Pages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	firstPage := make([]feed.Package, feedPackagesPageSize)
+	secondPage := make([]feed.Package, 1)
+	feedClient.EXPECT().
+		GetPackages(clients.Ctx, feed.GetPackagesArgs{FeedId: converter.String(FeedName), Top: converter.Int(feedPackagesPageSize), Skip: converter.Int(0)}).
+		Return(&firstPage, nil).
+		Times(1)
+	feedClient.EXPECT().
+		GetPackages(clients.Ctx, feed.GetPackagesArgs{FeedId: converter.String(FeedName), Top: converter.Int(feedPackagesPageSize), Skip: converter.Int(feedPackagesPageSize)}).
+		Return(&secondPage, nil).
+		Times(1)
+
+	packages, err := listFeedPackages(clients, feed.GetPackagesArgs{FeedId: converter.String(FeedName)})
+	require.NoError(t, err)
+	require.Len(t, packages, feedPackagesPageSize+1)
+}
+
+func TestFeedPackageVersion_Create_PromotesScopedNpmPackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceFeedPackageVersion()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"feed_id":       FeedName,
+		"project_id":    FeedProjectId,
+		"protocol_type": "npm",
+		"package_name":  "@contoso/widgets",
+		"version":       "1.0.0",
+		"views":         []interface{}{"Release", "Prerelease"},
+	})
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	npmClient := azdosdkmocks.NewMockNpmClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, NpmClient: npmClient, Ctx: context.Background()}
+
+	prerelease := feed.FeedView{Name: converter.String("Prerelease"), Type: &feed.FeedViewTypeValues.Release}
+	release := feed.FeedView{Name: converter.String("Release"), Type: &feed.FeedViewTypeValues.Release}
+	local := feed.FeedView{Name: converter.String("Local"), Type: &feed.FeedViewTypeValues.Implicit}
+	before := testFeedPackage("@contoso/widgets", "Npm", local, prerelease)
+	after := testFeedPackage("@contoso/widgets", "Npm", local, prerelease, release)
+	gomock.InOrder(
+		feedClient.EXPECT().GetPackages(clients.Ctx, gomock.Any()).Return(&before, nil).Times(1),
+		feedClient.EXPECT().GetPackages(clients.Ctx, gomock.Any()).Return(&after, nil).Times(1),
+	)
+
+	npmClient.EXPECT().
+		UpdateScopedPackage(clients.Ctx, npm.UpdateScopedPackageArgs{
+			PackageVersionDetails: &npm.PackageVersionDetails{Views: &webapi.JsonPatchOperation{
+				Op:    &webapi.OperationValues.Add,
+				Path:  converter.String("/views/-"),
+				Value: "Release",
+			}},
+			FeedId:              converter.String(FeedName),
+			PackageScope:        converter.String("contoso"),
+			UnscopedPackageName: converter.String("widgets"),
+			PackageVersion:      converter.String("1.0.0"),
+			Project:             converter.String(FeedProjectId),
+		}).
+		Return(nil, nil).
+		Times(1)
+	npmClient.EXPECT().
+		GetScopedPackageInfo(clients.Ctx, gomock.Any()).
+		Return(&npm.Package{}, nil).
+		Times(1)
+
+	diags := r.CreateContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, testPackageVersionId.String(), resourceData.Id())
+	require.Equal(t, testPackageId.String(), resourceData.Get("package_id"))
+	require.True(t, resourceData.Get("is_latest").(bool))
+	require.ElementsMatch(t, []interface{}{"Prerelease", "Release"}, resourceData.Get("views").(*schema.Set).List())
+}
+
+func TestFeedPackageVersion_Create_ErrorsOnDeletedVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceFeedPackageVersion()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"feed_id":       FeedName,
+		"protocol_type": "nuget",
+		"package_name":  "Contoso.Widgets",
+		"version":       "0.9.0",
+	})
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	packages := testFeedPackage("Contoso.Widgets", "NuGet")
+	feedClient.EXPECT().GetPackages(clients.Ctx, gomock.Any()).Return(&packages, nil).Times(1)
+
+	diags := r.CreateContext(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "does not exist")
+}
+
+func TestFeedPackageVersion_Read_RemovesDeletedVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceFeedPackageVersion()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"feed_id":       FeedName,
+		"protocol_type": "nuget",
+		"package_name":  "Contoso.Widgets",
+		"version":       "0.9.0",
+	})
+	resourceData.SetId(uuid.New().String())
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	packages := testFeedPackage("Contoso.Widgets", "NuGet")
+	feedClient.EXPECT().GetPackages(clients.Ctx, gomock.Any()).Return(&packages, nil).Times(1)
+
+	diags := r.ReadContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Empty(t, resourceData.Id())
+}
+
+func TestFeedPackageVersion_ListPackageVersion_NuGetOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nugetClient := azdosdkmocks.NewMockNugetClient(ctrl)
+	clients := &client.AggregatedClient{NuGetClient: nugetClient, Ctx: context.Background()}
+
+	nugetClient.EXPECT().
+		UpdatePackageVersion(clients.Ctx, nuget.UpdatePackageVersionArgs{
+			PackageVersionDetails: &nuget.PackageVersionDetails{Listed: converter.Bool(false)},
+			FeedId:                converter.String(FeedName),
+			PackageName:           converter.String("Contoso.Widgets"),
+			PackageVersion:        converter.String("1.0.0"),
+			Project:               converter.String(""),
+		}).
+		Return(nil).
+		Times(1)
+
+	err := listPackageVersion(clients, packageVersion{feedId: FeedName, protocolType: "nuget", name: "Contoso.Widgets", version: "1.0.0"}, false)
+	require.NoError(t, err)
+
+	err = listPackageVersion(clients, packageVersion{feedId: FeedName, protocolType: "npm", name: "widgets", version: "1.0.0"}, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "only supported for NuGet packages")
+}
+
+func TestFeedPackageVersion_Delete_MavenPackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceFeedPackageVersion()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"feed_id":           FeedName,
+		"project_id":        FeedProjectId,
+		"protocol_type":     "maven",
+		"package_name":      "com.contoso:widgets",
+		"version":           "1.0.0",
+		"delete_on_destroy": true,
+	})
+	resourceData.SetId(testPackageVersionId.String())
+
+	mavenClient := azdosdkmocks.NewMockMavenClient(ctrl)
+	clients := &client.AggregatedClient{MavenClient: mavenClient, Ctx: context.Background()}
+
+	mavenClient.EXPECT().
+		PackageDelete(clients.Ctx, maven.PackageDeleteArgs{
+			Feed:       converter.String(FeedName),
+			GroupId:    converter.String("com.contoso"),
+			ArtifactId: converter.String("widgets"),
+			Version:    converter.String("1.0.0"),
+			Project:    converter.String(FeedProjectId),
+		}).
+		Return(errors.New("package is locked")).
+		Times(1)
+
+	diags := r.DeleteContext(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "package is locked")
+}
+
+func TestFeedPackageVersion_Delete_KeepsPackageByDefault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceFeedPackageVersion()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"feed_id":       FeedName,
+		"protocol_type": "maven",
+		"package_name":  "com.contoso:widgets",
+		"version":       "1.0.0",
+	})
+	resourceData.SetId(testPackageVersionId.String())
+
+	clients := &client.AggregatedClient{MavenClient: azdosdkmocks.NewMockMavenClient(ctrl), Ctx: context.Background()}
+
+	diags := r.DeleteContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+}
+
+func TestFeedPackageVersion_Import(t *testing.T) {
+	r := ResourceFeedPackageVersion()
+
+	tests := []struct {
+		id           string
+		projectId    string
+		feedId       string
+		protocolType string
+		packageName  string
+		version      string
+	}{
+		{"feed/nuget/Contoso.Widgets/1.0.0", "", "feed", "nuget", "Contoso.Widgets", "1.0.0"},
+		{FeedProjectId + "/feed/npm/@contoso/widgets/1.0.0-beta.1", FeedProjectId, "feed", "npm", "@contoso/widgets", "1.0.0-beta.1"},
+		{"feed/NPM/@contoso/widgets/2.0.0", "", "feed", "npm", "@contoso/widgets", "2.0.0"},
+	}
+	for _, test := range tests {
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+		resourceData.SetId(test.id)
+
+		result, err := r.Importer.StateContext(context.Background(), resourceData, nil)
+		require.NoError(t, err, test.id)
+		require.Len(t, result, 1)
+		require.Equal(t, test.projectId, resourceData.Get("project_id"), test.id)
+		require.Equal(t, test.feedId, resourceData.Get("feed_id"), test.id)
+		require.Equal(t, test.protocolType, resourceData.Get("protocol_type"), test.id)
+		require.Equal(t, test.packageName, resourceData.Get("package_name"), test.id)
+		require.Equal(t, test.version, resourceData.Get("version"), test.id)
+	}
+
+	for _, id := range []string{"feed/nuget/1.0.0", "feed/Contoso.Widgets/1.0.0", "project/feed/cargo/widgets/1.0.0"} {
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+		resourceData.SetId(id)
+
+		_, err := r.Importer.StateContext(context.Background(), resourceData, nil)
+		require.Error(t, err, id)
+	}
+}
+
+func TestFeedPackageVersion_PackageNames(t *testing.T) {
+	scope, name, ok := npmPackageScope("@contoso/widgets")
+	require.True(t, ok)
+	require.Equal(t, "contoso", scope)
+	require.Equal(t, "widgets", name)
+
+	_, _, ok = npmPackageScope("widgets")
+	require.False(t, ok)
+
+	groupId, artifactId, err := mavenPackageCoordinates("com.contoso:widgets")
+	require.NoError(t, err)
+	require.Equal(t, "com.contoso", groupId)
+	require.Equal(t, "widgets", artifactId)
+
+	_, _, err = mavenPackageCoordinates("widgets")
+	require.Error(t, err)
+}
+
+// verifies that views are matched case insensitively and keep the configured spelling
+func TestFeedPackageVersion_FlattenViews(t *testing.T) {
+	promoted := []string{"Prerelease", "Release"}
+
+	views := flattenPackageVersionViews(promoted, schema.NewSet(schema.HashString, []interface{}{"release", "Local"}))
+	require.ElementsMatch(t, []interface{}{"release"}, views)
+
+	views = flattenPackageVersionViews(promoted, schema.NewSet(schema.HashString, nil))
+	require.ElementsMatch(t, []interface{}{"Prerelease", "Release"}, views)
+}
@@ -13,8 +13,11 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/feedextras"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -117,3 +120,260 @@ func TestFeed_Delete_DoesNotSwallowError(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Feed with given name not found")
 }
+
+// verifies that the upstream behavior is read back, so that changes made outside of Terraform are detected
+func TestFeed_Read_SetsAllowUpstreamOverrides(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedId := uuid.New()
+	r := ResourceFeed()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":       FeedName,
+		"project_id": FeedProjectId,
+	})
+	resourceData.SetId(feedId.String())
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	feedClientExtras := azdosdkmocks.NewMockFeedextrasClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, FeedClientExtras: feedClientExtras, Ctx: context.Background()}
+
+	feedClientExtras.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(&feedextras.Feed{
+			Feed:                      feed.Feed{Id: &feedId, Name: &FeedName},
+			AllowUpstreamNameConflict: converter.Bool(true),
+		}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedViews(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedView{}, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.NoError(t, err)
+	require.True(t, resourceData.Get("allow_upstream_overrides").(bool))
+}
+
+func TestFeed_ExpandUpstreamSources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background(), OrganizationURL: "https://dev.azure.com/example"}
+
+	upstreamFeedId := uuid.New()
+	upstreamViewId := uuid.New()
+	upstreamProjectId := uuid.New()
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.Ctx, feed.GetFeedArgs{FeedId: converter.String(upstreamFeedId.String()), Project: converter.String(upstreamProjectId.String())}).
+		Return(&feed.Feed{Name: converter.String("shared"), Project: &feed.ProjectReference{Name: converter.String("Platform")}}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedView(clients.Ctx, feed.GetFeedViewArgs{FeedId: converter.String(upstreamFeedId.String()), ViewId: converter.String(upstreamViewId.String()), Project: converter.String(upstreamProjectId.String())}).
+		Return(&feed.FeedView{Name: converter.String("Release")}, nil).
+		Times(1)
+
+	upstreamSources, err := expandUpstreamSources(clients, []interface{}{
+		map[string]interface{}{
+			"name":            "nuget.org",
+			"protocol":        "NuGet",
+			"location":        "https://api.nuget.org/v3/index.json",
+			"feed_id":         "",
+			"feed_project_id": "",
+			"view_id":         "",
+		},
+		map[string]interface{}{
+			"name":            "shared@Release",
+			"protocol":        "nuget",
+			"location":        "",
+			"feed_id":         upstreamFeedId.String(),
+			"feed_project_id": upstreamProjectId.String(),
+			"view_id":         upstreamViewId.String(),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, *upstreamSources, 2)
+
+	public := (*upstreamSources)[0]
+	require.Equal(t, "nuget", *public.Protocol)
+	require.Equal(t, feed.UpstreamSourceTypeValues.Public, *public.UpstreamSourceType)
+	require.Equal(t, "https://api.nuget.org/v3/index.json", *public.Location)
+
+	internal := (*upstreamSources)[1]
+	require.Equal(t, feed.UpstreamSourceTypeValues.Internal, *internal.UpstreamSourceType)
+	require.Equal(t, "azure-feed://example/Platform/shared@Release", *internal.Location)
+	require.Equal(t, upstreamFeedId, *internal.InternalUpstreamFeedId)
+	require.Equal(t, upstreamViewId, *internal.InternalUpstreamViewId)
+	require.Equal(t, upstreamProjectId, *internal.InternalUpstreamProjectId)
+}
+
+func TestFeed_ExpandUpstreamSources_RequiresLocationOrFeed(t *testing.T) {
+	_, err := expandUpstreamSources(&client.AggregatedClient{}, []interface{}{
+		map[string]interface{}{
+			"name":            "npmjs",
+			"protocol":        "npm",
+			"location":        "",
+			"feed_id":         "",
+			"feed_project_id": "",
+			"view_id":         "",
+		},
+	})
+	require.ErrorContains(t, err, "Upstream source npmjs requires either a `location` or a `feed_id`")
+}
+
+func TestFeed_UpdateFeedViews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, Ctx: context.Background()}
+
+	feedId := uuid.New().String()
+	localId, prereleaseId, releaseId := uuid.New(), uuid.New(), uuid.New()
+
+	feedClient.
+		EXPECT().
+		GetFeedViews(clients.Ctx, feed.GetFeedViewsArgs{FeedId: &feedId, Project: &FeedProjectId}).
+		Return(&[]feed.FeedView{
+			{Id: &localId, Name: converter.String("Local"), Type: &feed.FeedViewTypeValues.Implicit, Visibility: &feed.FeedVisibilityValues.Collection},
+			{Id: &prereleaseId, Name: converter.String("Prerelease"), Type: &feed.FeedViewTypeValues.Release, Visibility: &feed.FeedVisibilityValues.Collection},
+			{Id: &releaseId, Name: converter.String("Release"), Type: &feed.FeedViewTypeValues.Release, Visibility: &feed.FeedVisibilityValues.Collection},
+		}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		UpdateFeedView(clients.Ctx, feed.UpdateFeedViewArgs{
+			View:    &feed.FeedView{Visibility: &feed.FeedVisibilityValues.Organization},
+			FeedId:  &feedId,
+			ViewId:  converter.String(releaseId.String()),
+			Project: &FeedProjectId,
+		}).
+		Return(&feed.FeedView{}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		CreateFeedView(clients.Ctx, feed.CreateFeedViewArgs{
+			View:    &feed.FeedView{Name: converter.String("Candidate"), Type: &feed.FeedViewTypeValues.Release, Visibility: &feed.FeedVisibilityValues.Private},
+			FeedId:  &feedId,
+			Project: &FeedProjectId,
+		}).
+		Return(&feed.FeedView{}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		DeleteFeedView(clients.Ctx, feed.DeleteFeedViewArgs{FeedId: &feedId, ViewId: converter.String(prereleaseId.String()), Project: &FeedProjectId}).
+		Return(nil).
+		Times(1)
+
+	err := updateFeedViews(clients, feedId, FeedProjectId,
+		[]interface{}{
+			map[string]interface{}{"name": "Local", "visibility": "collection"},
+			map[string]interface{}{"name": "Prerelease", "visibility": "collection"},
+			map[string]interface{}{"name": "Release", "visibility": "collection"},
+		},
+		[]interface{}{
+			map[string]interface{}{"name": "Release", "visibility": "organization"},
+			map[string]interface{}{"name": "Candidate", "visibility": "private"},
+		})
+	require.NoError(t, err)
+}
+
+func TestFeed_FlattenFeedViews_KeepsConfiguredOrder(t *testing.T) {
+	views := &[]feed.FeedView{
+		{Name: converter.String("Local"), Visibility: &feed.FeedVisibilityValues.Collection},
+		{Name: converter.String("Prerelease"), Visibility: &feed.FeedVisibilityValues.Collection},
+		{Name: converter.String("Release"), Visibility: &feed.FeedVisibilityValues.Organization},
+	}
+
+	require.Empty(t, flattenFeedViews(views, nil))
+
+	flattened := flattenFeedViews(views, []interface{}{
+		map[string]interface{}{"name": "release"},
+		map[string]interface{}{"name": "Prerelease"},
+		map[string]interface{}{"name": "Missing"},
+	})
+	require.Len(t, flattened, 2)
+	require.Equal(t, "Release", flattened[0].(map[string]interface{})["name"])
+	require.Equal(t, "organization", flattened[0].(map[string]interface{})["visibility"])
+	require.Equal(t, "Prerelease", flattened[1].(map[string]interface{})["name"])
+}
+
+// verifies that removing all upstream_source and view blocks clears the upstream sources and deletes the views
+func TestFeed_Update_ClearsUpstreamSourcesAndViews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedId := uuid.New()
+	releaseId := uuid.New()
+	r := ResourceFeed()
+	prior := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":       FeedName,
+		"project_id": FeedProjectId,
+		"upstream_source": []interface{}{
+			map[string]interface{}{"name": "npmjs", "protocol": "npm", "location": "https://registry.npmjs.org/"},
+		},
+		"view": []interface{}{
+			map[string]interface{}{"name": "Release", "visibility": "collection"},
+		},
+	})
+	prior.SetId(feedId.String())
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":       FeedName,
+		"project_id": FeedProjectId,
+	})
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	feedClientExtras := azdosdkmocks.NewMockFeedextrasClient(ctrl)
+	clients := &client.AggregatedClient{FeedClient: feedClient, FeedClientExtras: feedClientExtras, Ctx: context.Background()}
+
+	diff, err := r.Diff(clients.Ctx, prior.State(), config, clients)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.Equal(t, "0", diff.Attributes["upstream_source.#"].New)
+	require.Equal(t, "0", diff.Attributes["view.#"].New)
+
+	resourceData, err := schema.InternalMap(r.Schema).Data(prior.State(), diff)
+	require.NoError(t, err)
+
+	releaseView := feed.FeedView{Id: &releaseId, Name: converter.String("Release"), Type: &feed.FeedViewTypeValues.Release, Visibility: &feed.FeedVisibilityValues.Collection}
+	feedClient.
+		EXPECT().
+		UpdateFeed(clients.Ctx, feed.UpdateFeedArgs{
+			Feed:    &feed.FeedUpdate{UpstreamSources: &[]feed.UpstreamSource{}},
+			FeedId:  &FeedName,
+			Project: &FeedProjectId,
+		}).
+		Return(&feed.Feed{}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedViews(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedView{releaseView}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		DeleteFeedView(clients.Ctx, feed.DeleteFeedViewArgs{FeedId: converter.String(feedId.String()), ViewId: converter.String(releaseId.String()), Project: &FeedProjectId}).
+		Return(nil).
+		Times(1)
+	feedClientExtras.
+		EXPECT().
+		GetFeed(clients.Ctx, gomock.Any()).
+		Return(&feedextras.Feed{Feed: feed.Feed{Id: &feedId, Name: &FeedName, UpstreamSources: &[]feed.UpstreamSource{}}}, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeedViews(clients.Ctx, gomock.Any()).
+		Return(&[]feed.FeedView{}, nil).
+		Times(1)
+
+	err = r.Update(resourceData, clients)
+	require.NoError(t, err)
+	require.Empty(t, resourceData.Get("upstream_source"))
+	require.Empty(t, resourceData.Get("view"))
+}
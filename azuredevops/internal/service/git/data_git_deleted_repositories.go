@@ -0,0 +1,121 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+// DataGitDeletedRepositories schema and implementation for the data source of git repositories in the recycle bin
+func DataGitDeletedRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitDeletedRepositoriesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"repositories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deleted_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deleted_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitDeletedRepositoriesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	repos, err := clients.GitReposClient.GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf("Reading deleted repositories. Project ID: %s, Error: %+v", projectID, err)
+	}
+
+	results := []interface{}{}
+	if repos != nil {
+		for _, repo := range *repos {
+			if name != "" && !strings.EqualFold(converter.ToString(repo.Name, ""), name) {
+				continue
+			}
+			results = append(results, flattenGitDeletedRepository(repo))
+		}
+	}
+
+	d.SetId("deleted-repositories#" + projectID + "#" + name)
+	if err := d.Set("repositories", results); err != nil {
+		return fmt.Errorf("Setting deleted repositories: %+v", err)
+	}
+	return nil
+}
+
+func flattenGitDeletedRepository(repo git.GitDeletedRepository) map[string]interface{} {
+	output := map[string]interface{}{
+		"name": converter.ToString(repo.Name, ""),
+	}
+	if repo.Id != nil {
+		output["id"] = repo.Id.String()
+	}
+	if repo.Project != nil && repo.Project.Id != nil {
+		output["project_id"] = repo.Project.Id.String()
+	}
+	if repo.CreatedDate != nil {
+		output["created_date"] = repo.CreatedDate.Time.Format(time.RFC3339)
+	}
+	if repo.DeletedDate != nil {
+		output["deleted_date"] = repo.DeletedDate.Time.Format(time.RFC3339)
+	}
+	if repo.DeletedBy != nil {
+		output["deleted_by"] = converter.ToString(repo.DeletedBy.UniqueName, "")
+	}
+	return output
+}
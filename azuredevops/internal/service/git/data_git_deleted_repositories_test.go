@@ -0,0 +1,123 @@
+//go:build (all || git || data_sources || data_git_deleted_repositories) && (!exclude_data_sources || !exclude_git || !exclude_data_git_deleted_repositories)
+// +build all git data_sources data_git_deleted_repositories
+// +build !exclude_data_sources !exclude_git !exclude_data_git_deleted_repositories
+
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	deletedRepoProjectID = uuid.New()
+	deletedRepoDate      = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	deletedRepoList      = []git.GitDeletedRepository{
+		{
+			Id:          converter.UUID(uuid.New().String()),
+			Name:        converter.String("repo-01"),
+			Project:     &core.TeamProjectReference{Id: &deletedRepoProjectID},
+			DeletedDate: &azuredevops.Time{Time: deletedRepoDate},
+			DeletedBy:   &webapi.IdentityRef{UniqueName: converter.String("user@contoso.com")},
+		},
+		{
+			Id:      converter.UUID(uuid.New().String()),
+			Name:    converter.String("repo-02"),
+			Project: &core.TeamProjectReference{Id: &deletedRepoProjectID},
+		},
+	}
+)
+
+func TestDataSourceGitDeletedRepositories_Read_ByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{Project: converter.String(deletedRepoProjectID.String())}).
+		Return(&deletedRepoList, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitDeletedRepositories().Schema, nil)
+	resourceData.Set("project_id", deletedRepoProjectID.String())
+	resourceData.Set("name", "REPO-01")
+
+	err := dataSourceGitDeletedRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+
+	repos := resourceData.Get("repositories").([]interface{})
+	require.Len(t, repos, 1)
+	repo := repos[0].(map[string]interface{})
+	require.Equal(t, deletedRepoList[0].Id.String(), repo["id"])
+	require.Equal(t, deletedRepoProjectID.String(), repo["project_id"])
+	require.Equal(t, "2024-05-01T10:00:00Z", repo["deleted_date"])
+	require.Equal(t, "user@contoso.com", repo["deleted_by"])
+}
+
+func TestDataSourceGitDeletedRepositories_Read_AllRepositories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(clients.Ctx, gomock.Any()).
+		Return(&deletedRepoList, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitDeletedRepositories().Schema, nil)
+	resourceData.Set("project_id", deletedRepoProjectID.String())
+
+	err := dataSourceGitDeletedRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, resourceData.Get("repositories").([]interface{}), 2)
+}
+
+func TestDataSourceGitDeletedRepositories_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetRecycleBinRepositories() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataGitDeletedRepositories().Schema, nil)
+	resourceData.Set("project_id", deletedRepoProjectID.String())
+
+	err := dataSourceGitDeletedRepositoriesRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetRecycleBinRepositories() Failed")
+}
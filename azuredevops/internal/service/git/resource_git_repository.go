@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
 	"time"
@@ -65,7 +66,7 @@ func ResourceGitRepository() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResource(),
+		Importer: importGitRepository(),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:             schema.TypeString,
@@ -169,6 +170,16 @@ func ResourceGitRepository() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"purge_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"restore_if_soft_deleted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"is_fork": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -246,6 +257,16 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if d.Get("restore_if_soft_deleted").(bool) {
+		deletedRepo, err := findDeletedGitRepository(clients, projectID.String(), *repo.Name)
+		if err != nil {
+			return fmt.Errorf("Looking up deleted repository in Azure DevOps. Name: %s, Error: %+v", *repo.Name, err)
+		}
+		if deletedRepo != nil {
+			return resourceGitRepositoryRestore(d, m, projectID, deletedRepo)
+		}
+	}
+
 	args := git.CreateRepositoryArgs{
 		GitRepositoryToCreate: &git.GitRepositoryCreateOptions{
 			Name: repo.Name,
@@ -310,6 +331,50 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 	return resourceGitRepositoryRead(d, m)
 }
 
+// resourceGitRepositoryRestore restores a soft-deleted repository instead of creating a new one. The repository keeps
+// its content, the initialization is not applied.
+func resourceGitRepositoryRestore(d *schema.ResourceData, m interface{}, projectID *uuid.UUID, deletedRepo *git.GitDeletedRepository) error {
+	clients := m.(*client.AggregatedClient)
+	log.Printf("[INFO] Restoring soft-deleted repository. ID: %s, Name: %s", deletedRepo.Id.String(), converter.ToString(deletedRepo.Name, ""))
+
+	restoredRepo, err := clients.GitReposClient.RestoreRepositoryFromRecycleBin(clients.Ctx, git.RestoreRepositoryFromRecycleBinArgs{
+		RepositoryDetails: &git.GitRecycleBinRepositoryDetails{Deleted: converter.Bool(false)},
+		Project:           converter.String(projectID.String()),
+		RepositoryId:      deletedRepo.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("Restoring repository in Azure DevOps. ID: %s, Error: %+v", deletedRepo.Id.String(), err)
+	}
+
+	d.SetId(deletedRepo.Id.String())
+
+	// The restore does not always return the repository, look it up to compare the default branch
+	if restoredRepo == nil || restoredRepo.Id == nil {
+		restoredRepo, err = gitRepositoryRead(clients, deletedRepo.Id.String(), "", projectID.String())
+		if err != nil {
+			return fmt.Errorf("Looking up restored repository. ID: %s, Error: %+v", deletedRepo.Id.String(), err)
+		}
+		if restoredRepo == nil {
+			return fmt.Errorf("Restored repository not found. ID: %s", deletedRepo.Id.String())
+		}
+	}
+
+	if v := d.Get("default_branch").(string); v != "" && !strings.EqualFold(converter.ToString(restoredRepo.DefaultBranch, ""), v) {
+		restoredRepo.DefaultBranch = converter.String(v)
+		if _, err = updateGitRepository(clients, restoredRepo, projectID); err != nil {
+			return fmt.Errorf("updating restored repository : %+v", err)
+		}
+	}
+
+	if v := d.Get("disabled").(bool); v {
+		if _, err = updateIsDisabledGitRepository(clients, deletedRepo.Id.String(), projectID.String(), true); err != nil {
+			return fmt.Errorf("disabling restored repository in Azure DevOps: %+v", err)
+		}
+	}
+
+	return resourceGitRepositoryRead(d, m)
+}
+
 func resourceGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 	repoID := d.Id()
 	repoName := d.Get("name").(string)
@@ -440,9 +505,62 @@ func resourceGitRepositoryDelete(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// deleted repositories are kept in the recycle bin of the project, purge them to free the name
+	if d.Get("purge_on_destroy").(bool) {
+		err = clients.GitReposClient.DeleteRepositoryFromRecycleBin(clients.Ctx, git.DeleteRepositoryFromRecycleBinArgs{
+			Project:      converter.String(projectID),
+			RepositoryId: &repoUUId,
+		})
+		if err != nil {
+			return fmt.Errorf("Purging repository from the recycle bin. ID: %s, Error: %+v", repoID, err)
+		}
+	}
+
 	return nil
 }
 
+// findDeletedGitRepository looks up a repository in the recycle bin of the project by name. If several deleted
+// repositories share the name, the most recently deleted one is returned. Returns nil if no deleted repository with
+// the name exists.
+func findDeletedGitRepository(clients *client.AggregatedClient, projectID string, name string) (*git.GitDeletedRepository, error) {
+	repos, err := clients.GitReposClient.GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil || repos == nil {
+		return nil, err
+	}
+
+	var latest *git.GitDeletedRepository
+	for _, repo := range *repos {
+		if !strings.EqualFold(converter.ToString(repo.Name, ""), name) {
+			continue
+		}
+		if latest == nil || deletedDate(&repo).After(deletedDate(latest)) {
+			latest = &repo
+		}
+	}
+	return latest, nil
+}
+
+func deletedDate(repo *git.GitDeletedRepository) time.Time {
+	if repo.DeletedDate == nil {
+		return time.Time{}
+	}
+	return repo.DeletedDate.Time
+}
+
+func importGitRepository() *schema.ResourceImporter {
+	importer := tfhelper.ImportProjectQualifiedResource()
+	importState := importer.State
+	importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		// The recycle bin behavior is not stored in the service, set the defaults to avoid a diff after import
+		d.Set("purge_on_destroy", false)
+		d.Set("restore_if_soft_deleted", false)
+		return importState(d, meta)
+	}
+	return importer
+}
+
 func waitForBranch(clients *client.AggregatedClient, repoName *string, projectID fmt.Stringer, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"Waiting"},
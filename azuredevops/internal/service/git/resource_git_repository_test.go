@@ -9,9 +9,11 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
@@ -315,3 +317,189 @@ func TestGitRepo_Read_UsesNameIfIdNotSet(t *testing.T) {
 
 	resourceGitRepositoryRead(resourceData, clients)
 }
+
+// verifies that a soft-deleted repository with the same name is restored instead of created
+func TestGitRepo_Create_RestoresSoftDeletedRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, nil)
+	resourceData.Set("name", "reponame")
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("restore_if_soft_deleted", true)
+	configureCleanInitialization(resourceData)
+
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{Project: converter.String(testRepoProjectID.String())}).
+		Return(&[]git.GitDeletedRepository{
+			{Id: converter.UUID(uuid.New().String()), Name: converter.String("other-repo")},
+			{Id: &testRepoID, Name: converter.String("RepoName")},
+		}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		RestoreRepositoryFromRecycleBin(clients.Ctx, git.RestoreRepositoryFromRecycleBinArgs{
+			RepositoryDetails: &git.GitRecycleBinRepositoryDetails{Deleted: converter.Bool(false)},
+			Project:           converter.String(testRepoProjectID.String()),
+			RepositoryId:      &testRepoID,
+		}).
+		Return(&testGitRepository, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreateRepository(gomock.Any(), gomock.Any()).
+		Times(0)
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, git.GetRepositoryArgs{RepositoryId: converter.String(testRepoID.String()), Project: converter.String(testRepoProjectID.String())}).
+		Return(&testGitRepository, nil).
+		Times(1)
+
+	err := resourceGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+}
+
+// verifies that the restored repository is looked up when the restore does not return it
+func TestGitRepo_Create_RestoreLooksUpRepositoryNotReturned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, nil)
+	resourceData.Set("name", "reponame")
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("restore_if_soft_deleted", true)
+	resourceData.Set("default_branch", "refs/heads/main")
+	configureCleanInitialization(resourceData)
+
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitDeletedRepository{
+			{Id: &testRepoID, Name: converter.String("RepoName")},
+		}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		RestoreRepositoryFromRecycleBin(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	restoredRepo := testGitRepository
+	restoredRepo.DefaultBranch = converter.String("refs/heads/master")
+	updatedRepo := testGitRepository
+	updatedRepo.DefaultBranch = converter.String("refs/heads/main")
+	getRepositoryArgs := git.GetRepositoryArgs{RepositoryId: converter.String(testRepoID.String()), Project: converter.String(testRepoProjectID.String())}
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, getRepositoryArgs).
+			Return(&restoredRepo, nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			UpdateRepository(clients.Ctx, git.UpdateRepositoryArgs{
+				NewRepositoryInfo: &updatedRepo,
+				RepositoryId:      &testRepoID,
+				Project:           converter.String(testRepoProjectID.String()),
+			}).
+			Return(&updatedRepo, nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, getRepositoryArgs).
+			Return(&updatedRepo, nil).
+			Times(1),
+	)
+
+	err := resourceGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+	require.Equal(t, "refs/heads/main", resourceData.Get("default_branch"))
+}
+
+// verifies that the most recently deleted repository is picked when several deleted repositories share the name
+func TestGitRepo_FindDeletedGitRepository_PicksLatestDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	deletedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{Project: converter.String(testRepoProjectID.String())}).
+		Return(&[]git.GitDeletedRepository{
+			{Id: converter.UUID(uuid.New().String()), Name: converter.String("reponame"), DeletedDate: &azuredevops.Time{Time: deletedAt.Add(-48 * time.Hour)}},
+			{Id: &testRepoID, Name: converter.String("RepoName"), DeletedDate: &azuredevops.Time{Time: deletedAt}},
+			{Id: converter.UUID(uuid.New().String()), Name: converter.String("reponame"), DeletedDate: &azuredevops.Time{Time: deletedAt.Add(-time.Hour)}},
+			{Id: converter.UUID(uuid.New().String()), Name: converter.String("other-repo"), DeletedDate: &azuredevops.Time{Time: deletedAt.Add(time.Hour)}},
+		}, nil).
+		Times(1)
+
+	repo, err := findDeletedGitRepository(clients, testRepoProjectID.String(), "reponame")
+	require.NoError(t, err)
+	require.NotNil(t, repo)
+	require.Equal(t, testRepoID, *repo.Id)
+}
+
+// verifies that the repository is purged from the recycle bin after it was deleted
+func TestGitRepo_Delete_PurgesFromRecycleBin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, nil)
+	resourceData.SetId(testRepoID.String())
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("purge_on_destroy", true)
+
+	repo := testGitRepository
+	repo.IsDisabled = converter.Bool(false)
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&repo, nil).
+		Times(1)
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			DeleteRepository(clients.Ctx, git.DeleteRepositoryArgs{RepositoryId: &testRepoID}).
+			Return(nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			DeleteRepositoryFromRecycleBin(clients.Ctx, git.DeleteRepositoryFromRecycleBinArgs{
+				Project:      converter.String(testRepoProjectID.String()),
+				RepositoryId: &testRepoID,
+			}).
+			Return(errors.New("DeleteRepositoryFromRecycleBin() Failed")).
+			Times(1),
+	)
+
+	err := resourceGitRepositoryDelete(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "DeleteRepositoryFromRecycleBin() Failed")
+}
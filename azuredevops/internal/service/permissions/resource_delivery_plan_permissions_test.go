@@ -0,0 +1,53 @@
+//go:build (all || permissions || resource_delivery_plan_permissions) && (!exclude_permissions || !resource_delivery_plan_permissions)
+// +build all permissions resource_delivery_plan_permissions
+// +build !exclude_permissions !resource_delivery_plan_permissions
+
+package permissions
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var (
+	deliveryPlanID    = "3c0d4b7e-9f1a-4d2b-8e6c-5a7f9b1d2e4c"
+	deliveryPlanToken = fmt.Sprintf("Plan/%s/%s", projectID, deliveryPlanID)
+)
+
+func TestDeliveryPlanPermissions_CreateDeliveryPlanToken(t *testing.T) {
+	var d *schema.ResourceData
+	var token string
+	var err error
+
+	d = getDeliveryPlanPermissionsResource(t, projectID, deliveryPlanID)
+	token, err = createDeliveryPlanToken(d, nil)
+	assert.NotEmpty(t, token)
+	assert.Nil(t, err)
+	assert.Equal(t, deliveryPlanToken, token)
+
+	d = getDeliveryPlanPermissionsResource(t, "", "")
+	token, err = createDeliveryPlanToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getDeliveryPlanPermissionsResource(t *testing.T, projectID string, deliveryPlanID string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceDeliveryPlanPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if deliveryPlanID != "" {
+		d.Set("delivery_plan_id", deliveryPlanID)
+	}
+	return d
+}
@@ -1,12 +1,15 @@
 package serviceendpoint
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,6 +17,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 const (
@@ -265,6 +269,133 @@ func getServiceEndpoint(client *client.AggregatedClient, serviceEndpointID *uuid
 	}
 }
 
+// addWriteOnlySecret adds `<key>_wo`, the write-only variant of the secret argument at the given address, and
+// `<key>_wo_version` next to the secret. The address of a secret nested in a block looks like `block.0.key`.
+// Write-only values are not stored in the state, changing the version is how the practitioner triggers the update of
+// the secret.
+func addWriteOnlySecret(s map[string]*schema.Schema, address string) {
+	steps := strings.Split(address, ".")
+	for ; len(steps) > 2; steps = steps[2:] {
+		s = s[steps[0]].Elem.(*schema.Resource).Schema
+	}
+	key := steps[0]
+	secret := s[key]
+
+	writeOnly := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		WriteOnly:    true,
+		Sensitive:    true,
+		ValidateFunc: secret.ValidateFunc,
+		Description:  fmt.Sprintf("The write-only variant of `%s`, which is not stored in the state.", key),
+	}
+
+	// A secret falling back to an environment variable cannot be enforced from the configuration alone
+	if secret.Required && secret.DefaultFunc == nil {
+		secret.ExactlyOneOf = []string{address, address + "_wo"}
+		writeOnly.ExactlyOneOf = secret.ExactlyOneOf
+	} else {
+		secret.ConflictsWith = append(secret.ConflictsWith, address+"_wo")
+		writeOnly.ConflictsWith = []string{address}
+	}
+	secret.Required = false
+	secret.Optional = true
+
+	s[key+"_wo"] = writeOnly
+	s[key+"_wo_version"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{address + "_wo"},
+		Description:  fmt.Sprintf("The version of `%s_wo`. Changing it triggers the update of the write-only value.", key),
+	}
+}
+
+// addWriteOnlyAuthPersonal adds `personal_access_token_wo` and `personal_access_token_wo_version` as the write-only
+// alternative of the `auth_personal` block, set blocks cannot hold write-only arguments.
+func addWriteOnlyAuthPersonal(s map[string]*schema.Schema) {
+	authPersonal := s["auth_personal"]
+
+	writeOnly := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		WriteOnly:    true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The write-only personal access token, which is not stored in the state. An alternative to the `auth_personal` block.",
+	}
+
+	if authPersonal.Required {
+		authPersonal.Required = false
+		authPersonal.Optional = true
+		authPersonal.ExactlyOneOf = []string{"auth_personal", "personal_access_token_wo"}
+		writeOnly.ExactlyOneOf = authPersonal.ExactlyOneOf
+	} else {
+		writeOnly.ConflictsWith = append([]string{"auth_personal"}, authPersonal.ConflictsWith...)
+		for _, key := range authPersonal.ConflictsWith {
+			s[key].ConflictsWith = append(s[key].ConflictsWith, "personal_access_token_wo")
+		}
+		authPersonal.ConflictsWith = append(authPersonal.ConflictsWith, "personal_access_token_wo")
+	}
+
+	s["personal_access_token_wo"] = writeOnly
+	s["personal_access_token_wo_version"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{"personal_access_token_wo"},
+		Description:  "The version of `personal_access_token_wo`. Changing it triggers the update of the write-only value.",
+	}
+}
+
+// requireSecretWith returns a CustomizeDiffFunc requiring `secret` or its write-only variant whenever `attribute` is
+// configured, RequiredWith cannot express this as it does not accept alternatives.
+func requireSecretWith(attribute, secret string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		rawConfig := d.GetRawConfig()
+		if rawConfig.IsNull() || !rawConfig.IsKnown() {
+			return nil
+		}
+
+		raw := rawConfig.AsValueMap()
+		isSet := func(key string) bool {
+			v, ok := raw[key]
+			return ok && !v.IsNull()
+		}
+		if !isSet(attribute) || isSet(secret) || isSet(secret+"_wo") {
+			return nil
+		}
+		return fmt.Errorf("one of `%s` or `%s_wo` must be specified when `%s` is set", secret, secret, attribute)
+	}
+}
+
+// getWriteOnlySecret returns the value of the write-only argument at the given address, if it is set
+func getWriteOnlySecret(d *schema.ResourceData, address string) (string, bool) {
+	return tfhelper.GetWriteOnlyString(d, secretPath(address))
+}
+
+// getSecret returns the value of the secret argument at the given address, preferring its write-only variant
+func getSecret(d *schema.ResourceData, address string) string {
+	if value, ok := getWriteOnlySecret(d, address+"_wo"); ok {
+		return value
+	}
+	if value, ok := d.Get(address).(string); ok {
+		return value
+	}
+	return ""
+}
+
+// secretPath converts the address of an argument, e.g. `authentication_basic.0.password`, to its path in the configuration
+func secretPath(address string) cty.Path {
+	var path cty.Path
+	for _, step := range strings.Split(address, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+	return path
+}
+
 // doBaseExpansion performs the expansion for the 'base' attributes that are defined in the schema, above
 func doBaseExpansion(d *schema.ResourceData) *serviceendpoint.ServiceEndpoint {
 	// an "error" is OK here as it is expected in the case that the ID is not set in the resource data
@@ -0,0 +1,168 @@
+//go:build (all || serviceendpoint_commons) && !exclude_serviceendpoints
+// +build all serviceendpoint_commons
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceEndpoint_AddWriteOnlySecret_RequiredSecret(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"password": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	}
+	addWriteOnlySecret(s, "password")
+
+	require.False(t, s["password"].Required)
+	require.True(t, s["password"].Optional)
+	require.Equal(t, []string{"password", "password_wo"}, s["password"].ExactlyOneOf)
+
+	require.True(t, s["password_wo"].WriteOnly)
+	require.True(t, s["password_wo"].Sensitive)
+	require.Equal(t, []string{"password", "password_wo"}, s["password_wo"].ExactlyOneOf)
+
+	require.Equal(t, schema.TypeInt, s["password_wo_version"].Type)
+	require.Equal(t, []string{"password_wo"}, s["password_wo_version"].RequiredWith)
+}
+
+func TestServiceEndpoint_AddWriteOnlySecret_SecretWithEnvironmentDefault(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"password": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			DefaultFunc: schema.EnvDefaultFunc("AZDO_TEST_PASSWORD", nil),
+		},
+	}
+	addWriteOnlySecret(s, "password")
+
+	require.True(t, s["password"].Optional)
+	require.Empty(t, s["password"].ExactlyOneOf)
+	require.Equal(t, []string{"password_wo"}, s["password"].ConflictsWith)
+	require.Equal(t, []string{"password"}, s["password_wo"].ConflictsWith)
+}
+
+func TestServiceEndpoint_AddWriteOnlySecret_NestedSecret(t *testing.T) {
+	r := ResourceServiceEndpointArgoCD()
+
+	basic := r.Schema["authentication_basic"].Elem.(*schema.Resource).Schema
+	require.Contains(t, basic, "password_wo")
+	require.Contains(t, basic, "password_wo_version")
+	require.Equal(t, []string{"authentication_basic.0.password", "authentication_basic.0.password_wo"}, basic["password"].ExactlyOneOf)
+	require.NotContains(t, r.Schema, "password_wo")
+
+	require.NoError(t, r.InternalValidate(nil, true))
+}
+
+func TestServiceEndpoint_GetSecret_FallsBackToSecret(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointArgoCD().Schema, map[string]interface{}{
+		"authentication_basic": []interface{}{
+			map[string]interface{}{
+				"username": "user",
+				"password": "secret",
+			},
+		},
+	})
+
+	require.Equal(t, "secret", getSecret(resourceData, "authentication_basic.0.password"))
+	require.Equal(t, "", getSecret(resourceData, "authentication_token.0.token"))
+}
+
+func TestServiceEndpoint_SecretPath(t *testing.T) {
+	require.True(t, cty.GetAttrPath("password_wo").Equals(secretPath("password_wo")))
+	require.True(t, cty.GetAttrPath("credentials").IndexInt(0).GetAttr("serviceprincipalkey_wo").Equals(secretPath("credentials.0.serviceprincipalkey_wo")))
+}
+
+func TestServiceEndpoint_AddWriteOnlyAuthPersonal(t *testing.T) {
+	r := ResourceServiceEndpointGitHub()
+
+	require.Equal(t, []string{"auth_personal", "auth_oauth"}, r.Schema["personal_access_token_wo"].ConflictsWith)
+	require.Contains(t, r.Schema["auth_personal"].ConflictsWith, "personal_access_token_wo")
+	require.Contains(t, r.Schema["auth_oauth"].ConflictsWith, "personal_access_token_wo")
+	require.NoError(t, r.InternalValidate(nil, true))
+
+	r = ResourceServiceEndpointRunPipeline()
+	require.False(t, r.Schema["auth_personal"].Required)
+	require.Equal(t, []string{"auth_personal", "personal_access_token_wo"}, r.Schema["auth_personal"].ExactlyOneOf)
+	require.NoError(t, r.InternalValidate(nil, true))
+}
+
+func TestServiceEndpoint_RequireSecretWith(t *testing.T) {
+	tests := []struct {
+		name          string
+		resource      *schema.Resource
+		config        map[string]cty.Value
+		expectedError string
+	}{
+		{
+			name:     "nuget username without password",
+			resource: ResourceServiceEndpointNuGet(),
+			config: map[string]cty.Value{
+				"username": cty.StringVal("user"),
+			},
+			expectedError: "one of `password` or `password_wo` must be specified when `username` is set",
+		},
+		{
+			name:     "nuget username with write-only password",
+			resource: ResourceServiceEndpointNuGet(),
+			config: map[string]cty.Value{
+				"username":    cty.StringVal("user"),
+				"password_wo": cty.StringVal("secret"),
+			},
+		},
+		{
+			name:     "nuget api key",
+			resource: ResourceServiceEndpointNuGet(),
+			config: map[string]cty.Value{
+				"api_key":  cty.StringVal("key"),
+				"username": cty.NullVal(cty.String),
+			},
+		},
+		{
+			name:     "checkmarx one client id without client secret",
+			resource: ResourceServiceEndpointCheckMarxOneService(),
+			config: map[string]cty.Value{
+				"client_id": cty.StringVal("client"),
+			},
+			expectedError: "one of `client_secret` or `client_secret_wo` must be specified when `client_id` is set",
+		},
+		{
+			name:     "checkmarx one client id with client secret",
+			resource: ResourceServiceEndpointCheckMarxOneService(),
+			config: map[string]cty.Value{
+				"client_id":     cty.StringVal("client"),
+				"client_secret": cty.StringVal("secret"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawConfig := map[string]interface{}{}
+			for k, v := range tt.config {
+				if !v.IsNull() {
+					rawConfig[k] = v.AsString()
+				}
+			}
+			state := &terraform.InstanceState{RawConfig: cty.ObjectVal(tt.config)}
+
+			_, err := tt.resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig), nil)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
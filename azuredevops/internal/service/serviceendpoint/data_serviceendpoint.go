@@ -0,0 +1,83 @@
+package serviceendpoint
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServiceEndpoint schema and implementation for a service endpoint of any type
+func DataServiceEndpoint() *schema.Resource {
+	r := &schema.Resource{
+		ReadContext: dataSourceServiceEndpointRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: dataSourceGenBaseSchema(),
+	}
+
+	maps.Copy(r.Schema, map[string]*schema.Schema{
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"owner": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"is_ready": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_shared": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"data": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	})
+	return r
+}
+
+func dataSourceServiceEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serviceEndpoint, err := dataSourceGetBaseServiceEndpoint(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+		return diag.Errorf(" Service endpoint was not found in project %s", d.Get("project_id").(string))
+	}
+	if err = checkServiceConnection(serviceEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+
+	doBaseFlattening(d, serviceEndpoint)
+	d.Set("service_endpoint_id", serviceEndpoint.Id.String())
+	flattenServiceEndpointDetails(d, serviceEndpoint)
+	return nil
+}
+
+func flattenServiceEndpointDetails(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) {
+	d.Set("type", converter.ToString(serviceEndpoint.Type, ""))
+	d.Set("url", converter.ToString(serviceEndpoint.Url, ""))
+	d.Set("owner", converter.ToString(serviceEndpoint.Owner, ""))
+	d.Set("is_ready", converter.ToBool(serviceEndpoint.IsReady, false))
+	d.Set("is_shared", converter.ToBool(serviceEndpoint.IsShared, false))
+	if serviceEndpoint.Data != nil {
+		d.Set("data", *serviceEndpoint.Data)
+	} else {
+		d.Set("data", map[string]string{})
+	}
+}
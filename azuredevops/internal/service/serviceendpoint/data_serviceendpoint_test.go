@@ -0,0 +1,163 @@
+//go:build (all || data_sources || data_serviceendpoint) && (!exclude_data_sources || !exclude_data_serviceendpoint)
+// +build all data_sources data_serviceendpoint
+// +build !exclude_data_sources !exclude_data_serviceendpoint
+
+package serviceendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/testhelper"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var dataServiceEndpointProjectID = uuid.New()
+
+var dataServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Id:            testhelper.CreateUUID(),
+	Name:          converter.String("generic-endpoint"),
+	Description:   converter.String("Managed by Terraform"),
+	Type:          converter.String("generic"),
+	Url:           converter.String("https://example.com"),
+	Owner:         converter.String("library"),
+	IsReady:       converter.Bool(true),
+	IsShared:      converter.Bool(false),
+	Authorization: &serviceendpoint.EndpointAuthorization{Scheme: converter.String("UsernamePassword")},
+	Data:          &map[string]string{"releaseUrl": "https://example.com/releases"},
+}
+
+func TestDataSourceServiceEndpoint_Read_ById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: dataServiceEndpoint.Id,
+			Project:    converter.String(dataServiceEndpointProjectID.String()),
+		}).
+		Return(&dataServiceEndpoint, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpoint().Schema, map[string]interface{}{
+		"project_id":          dataServiceEndpointProjectID.String(),
+		"service_endpoint_id": dataServiceEndpoint.Id.String(),
+	})
+	diags := dataSourceServiceEndpointRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, dataServiceEndpoint.Id.String(), resourceData.Id())
+	require.Equal(t, "generic-endpoint", resourceData.Get("service_endpoint_name"))
+	require.Equal(t, "Managed by Terraform", resourceData.Get("description"))
+	require.Equal(t, "generic", resourceData.Get("type"))
+	require.Equal(t, "https://example.com", resourceData.Get("url"))
+	require.Equal(t, "library", resourceData.Get("owner"))
+	require.Equal(t, true, resourceData.Get("is_ready"))
+	require.Equal(t, false, resourceData.Get("is_shared"))
+	require.Equal(t, "UsernamePassword", resourceData.Get("authorization.scheme"))
+	require.Equal(t, map[string]interface{}{"releaseUrl": "https://example.com/releases"}, resourceData.Get("data"))
+}
+
+func TestDataSourceServiceEndpoint_Read_ByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointsByNames(clients.Ctx, serviceendpoint.GetServiceEndpointsByNamesArgs{
+			Project:       converter.String(dataServiceEndpointProjectID.String()),
+			EndpointNames: &[]string{"generic-endpoint"},
+		}).
+		Return(&[]serviceendpoint.ServiceEndpoint{dataServiceEndpoint}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpoint().Schema, map[string]interface{}{
+		"project_id":            dataServiceEndpointProjectID.String(),
+		"service_endpoint_name": "generic-endpoint",
+	})
+	diags := dataSourceServiceEndpointRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, dataServiceEndpoint.Id.String(), resourceData.Id())
+	require.Equal(t, dataServiceEndpoint.Id.String(), resourceData.Get("service_endpoint_id"))
+	require.Equal(t, "generic", resourceData.Get("type"))
+}
+
+func TestDataSourceServiceEndpoint_Read_ByIdNotFound(t *testing.T) {
+	for name, response := range map[string]error{
+		"NullResponse": nil,
+		"NotFound":     azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+			clients := &client.AggregatedClient{
+				ServiceEndpointClient: serviceEndpointClient,
+				Ctx:                   context.Background(),
+			}
+
+			serviceEndpointClient.
+				EXPECT().
+				GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+				Return(nil, response).
+				Times(1)
+
+			resourceData := schema.TestResourceDataRaw(t, DataServiceEndpoint().Schema, map[string]interface{}{
+				"project_id":          dataServiceEndpointProjectID.String(),
+				"service_endpoint_id": uuid.New().String(),
+			})
+			diags := dataSourceServiceEndpointRead(clients.Ctx, resourceData, clients)
+			require.True(t, diags.HasError())
+			require.Contains(t, diags[0].Summary, "Service endpoint was not found in project "+dataServiceEndpointProjectID.String())
+		})
+	}
+}
+
+func TestDataSourceServiceEndpoint_Read_ByNameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointsByNames(clients.Ctx, gomock.Any()).
+		Return(&[]serviceendpoint.ServiceEndpoint{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpoint().Schema, map[string]interface{}{
+		"project_id":            dataServiceEndpointProjectID.String(),
+		"service_endpoint_name": "missing-endpoint",
+	})
+	diags := dataSourceServiceEndpointRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "missing-endpoint not found")
+}
@@ -0,0 +1,191 @@
+package serviceendpoint
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServiceEndpointUsage schema and implementation for the execution history of a service endpoint
+func DataServiceEndpointUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceEndpointUsageRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"service_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"max_records": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"last_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"plan_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"definition_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"definition_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"owner_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finish_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceEndpointUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectId := d.Get("project_id").(string)
+	endpointId := converter.UUID(d.Get("service_endpoint_id").(string))
+	maxRecords := d.Get("max_records").(int)
+
+	records, err := getServiceEndpointExecutionRecords(ctx, clients, serviceendpoint.GetServiceEndpointExecutionRecordsArgs{
+		Project:    converter.String(projectId),
+		EndpointId: endpointId,
+		Top:        converter.Int(maxRecords),
+	}, maxRecords)
+	if err != nil {
+		return diag.Errorf(" Reading execution records of service endpoint. Project ID: %s, Service Endpoint ID: %s, Error: %+v", projectId, endpointId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectId, endpointId))
+	d.Set("last_used", lastServiceEndpointExecution(records))
+	if err := d.Set("records", flattenServiceEndpointExecutionRecords(records)); err != nil {
+		return diag.Errorf(" Setting records: %+v", err)
+	}
+	return nil
+}
+
+func getServiceEndpointExecutionRecords(ctx context.Context, clients *client.AggregatedClient, args serviceendpoint.GetServiceEndpointExecutionRecordsArgs, maxRecords int) ([]serviceendpoint.ServiceEndpointExecutionRecord, error) {
+	var records []serviceendpoint.ServiceEndpointExecutionRecord
+	for len(records) < maxRecords {
+		resp, err := clients.ServiceEndpointClient.GetServiceEndpointExecutionRecords(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			break
+		}
+		records = append(records, resp.Value...)
+		if resp.ContinuationToken == "" || len(resp.Value) == 0 {
+			break
+		}
+		token, err := strconv.ParseUint(resp.ContinuationToken, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(" parsing continuation token %q: %+v", resp.ContinuationToken, err)
+		}
+		args.ContinuationToken = converter.UInt64(token)
+		args.Top = converter.Int(maxRecords - len(records))
+	}
+	if len(records) > maxRecords {
+		records = records[:maxRecords]
+	}
+	return records, nil
+}
+
+// lastServiceEndpointExecution returns the start time of the most recent execution, the records are not guaranteed to be sorted
+func lastServiceEndpointExecution(records []serviceendpoint.ServiceEndpointExecutionRecord) string {
+	var last time.Time
+	for _, record := range records {
+		if record.Data != nil && record.Data.StartTime != nil && record.Data.StartTime.Time.After(last) {
+			last = record.Data.StartTime.Time
+		}
+	}
+	if last.IsZero() {
+		return ""
+	}
+	return last.Format(time.RFC3339)
+}
+
+func flattenServiceEndpointExecutionRecords(records []serviceendpoint.ServiceEndpointExecutionRecord) []interface{} {
+	results := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		if record.Data == nil {
+			continue
+		}
+		data := record.Data
+		output := map[string]interface{}{
+			"plan_type": converter.ToString(data.PlanType, ""),
+		}
+		if data.Id != nil {
+			output["id"] = int(*data.Id)
+		}
+		if data.Definition != nil {
+			output["definition_id"] = converter.ToInt(data.Definition.Id, 0)
+			output["definition_name"] = converter.ToString(data.Definition.Name, "")
+		}
+		if data.Owner != nil {
+			output["owner_id"] = converter.ToInt(data.Owner.Id, 0)
+			output["owner_name"] = converter.ToString(data.Owner.Name, "")
+		}
+		if data.StartTime != nil {
+			output["start_time"] = data.StartTime.Time.Format(time.RFC3339)
+		}
+		if data.FinishTime != nil {
+			output["finish_time"] = data.FinishTime.Time.Format(time.RFC3339)
+		}
+		if data.Result != nil {
+			output["result"] = string(*data.Result)
+		}
+		results = append(results, output)
+	}
+	return results
+}
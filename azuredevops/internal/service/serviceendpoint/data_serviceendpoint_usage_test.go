@@ -0,0 +1,154 @@
+//go:build (all || data_sources || data_serviceendpoint_usage) && (!exclude_data_sources || !exclude_data_serviceendpoint_usage)
+// +build all data_sources data_serviceendpoint_usage
+// +build !exclude_data_sources !exclude_data_serviceendpoint_usage
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newServiceEndpointExecutionRecord(id uint64, startTime time.Time, result serviceendpoint.ServiceEndpointExecutionResult) serviceendpoint.ServiceEndpointExecutionRecord {
+	return serviceendpoint.ServiceEndpointExecutionRecord{
+		Data: &serviceendpoint.ServiceEndpointExecutionData{
+			Id:         converter.UInt64(id),
+			PlanType:   converter.String("Build"),
+			Definition: &serviceendpoint.ServiceEndpointExecutionOwner{Id: converter.Int(7), Name: converter.String("CI")},
+			Owner:      &serviceendpoint.ServiceEndpointExecutionOwner{Id: converter.Int(int(id)), Name: converter.String("20261019.1")},
+			StartTime:  &azuredevops.Time{Time: startTime},
+			FinishTime: &azuredevops.Time{Time: startTime.Add(time.Minute)},
+			Result:     &result,
+		},
+	}
+}
+
+func TestDataSourceServiceEndpointUsage_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	projectId := uuid.New()
+	endpointId := uuid.New()
+	latest := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+
+	firstPage := &serviceendpoint.GetServiceEndpointExecutionRecordsResponseValue{
+		Value: []serviceendpoint.ServiceEndpointExecutionRecord{
+			newServiceEndpointExecutionRecord(2, latest, serviceendpoint.ServiceEndpointExecutionResultValues.Succeeded),
+		},
+		ContinuationToken: "1",
+	}
+	secondPage := &serviceendpoint.GetServiceEndpointExecutionRecordsResponseValue{
+		Value: []serviceendpoint.ServiceEndpointExecutionRecord{
+			newServiceEndpointExecutionRecord(1, latest.Add(-24*time.Hour), serviceendpoint.ServiceEndpointExecutionResultValues.Failed),
+			newServiceEndpointExecutionRecord(0, latest.Add(-48*time.Hour), serviceendpoint.ServiceEndpointExecutionResultValues.Failed),
+		},
+	}
+
+	gomock.InOrder(
+		serviceEndpointClient.
+			EXPECT().
+			GetServiceEndpointExecutionRecords(clients.Ctx, serviceendpoint.GetServiceEndpointExecutionRecordsArgs{
+				Project:    converter.String(projectId.String()),
+				EndpointId: &endpointId,
+				Top:        converter.Int(2),
+			}).
+			Return(firstPage, nil).
+			Times(1),
+		serviceEndpointClient.
+			EXPECT().
+			GetServiceEndpointExecutionRecords(clients.Ctx, serviceendpoint.GetServiceEndpointExecutionRecordsArgs{
+				Project:           converter.String(projectId.String()),
+				EndpointId:        &endpointId,
+				Top:               converter.Int(1),
+				ContinuationToken: converter.UInt64(1),
+			}).
+			Return(secondPage, nil).
+			Times(1),
+	)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpointUsage().Schema, map[string]interface{}{
+		"project_id":          projectId.String(),
+		"service_endpoint_id": endpointId.String(),
+		"max_records":         2,
+	})
+	diags := dataSourceServiceEndpointUsageRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, projectId.String()+"/"+endpointId.String(), resourceData.Id())
+	require.Equal(t, "2026-10-01T08:00:00Z", resourceData.Get("last_used"))
+	require.Equal(t, 2, resourceData.Get("records.#"))
+	require.Equal(t, 2, resourceData.Get("records.0.id"))
+	require.Equal(t, "CI", resourceData.Get("records.0.definition_name"))
+	require.Equal(t, "succeeded", resourceData.Get("records.0.result"))
+	require.Equal(t, "failed", resourceData.Get("records.1.result"))
+}
+
+func TestDataSourceServiceEndpointUsage_Read_NeverUsed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointExecutionRecords(clients.Ctx, gomock.Any()).
+		Return(&serviceendpoint.GetServiceEndpointExecutionRecordsResponseValue{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpointUsage().Schema, map[string]interface{}{
+		"project_id":          uuid.New().String(),
+		"service_endpoint_id": uuid.New().String(),
+	})
+	diags := dataSourceServiceEndpointUsageRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "", resourceData.Get("last_used"))
+	require.Equal(t, 0, resourceData.Get("records.#"))
+}
+
+func TestDataSourceServiceEndpointUsage_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointExecutionRecords(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetServiceEndpointExecutionRecords() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpointUsage().Schema, map[string]interface{}{
+		"project_id":          uuid.New().String(),
+		"service_endpoint_id": uuid.New().String(),
+	})
+	diags := dataSourceServiceEndpointUsageRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetServiceEndpointExecutionRecords() Failed")
+}
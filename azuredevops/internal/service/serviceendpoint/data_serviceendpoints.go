@@ -0,0 +1,173 @@
+package serviceendpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServiceEndpoints schema and implementation for listing the service endpoints of a project
+func DataServiceEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceEndpointsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"library", "agentcloud"}, false),
+			},
+			"authorization_scheme": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"is_ready": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"service_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorization_scheme": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_ready": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_shared": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceEndpointsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectId := d.Get("project_id").(string)
+	args := serviceendpoint.GetServiceEndpointsArgs{
+		Project: converter.String(projectId),
+		// endpoints which failed to be created or deleted are not ready, include them to be able to audit them
+		IncludeFailed: converter.Bool(true),
+	}
+	if v, ok := d.GetOk("type"); ok {
+		args.Type = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("owner"); ok {
+		args.Owner = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("authorization_scheme"); ok {
+		args.AuthSchemes = &[]string{v.(string)}
+	}
+
+	serviceEndpoints, err := clients.ServiceEndpointClient.GetServiceEndpoints(ctx, args)
+	if err != nil {
+		return diag.Errorf(" Listing service endpoints of project %s: %+v", projectId, err)
+	}
+
+	// GetOk does not distinguish an unset is_ready from is_ready = false
+	var isReady *bool
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		if v := rawConfig.GetAttr("is_ready"); v.IsKnown() && !v.IsNull() {
+			isReady = converter.Bool(v.True())
+		}
+	} else if v, ok := d.GetOk("is_ready"); ok {
+		isReady = converter.Bool(v.(bool))
+	}
+
+	d.SetId(projectId)
+	if err := d.Set("service_endpoints", flattenServiceEndpoints(serviceEndpoints, isReady)); err != nil {
+		return diag.Errorf(" Setting service_endpoints: %+v", err)
+	}
+	return nil
+}
+
+func flattenServiceEndpoints(serviceEndpoints *[]serviceendpoint.ServiceEndpoint, isReady *bool) []interface{} {
+	if serviceEndpoints == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*serviceEndpoints))
+	for _, serviceEndpoint := range *serviceEndpoints {
+		ready := converter.ToBool(serviceEndpoint.IsReady, false)
+		if isReady != nil && *isReady != ready {
+			continue
+		}
+
+		output := map[string]interface{}{
+			"name":        converter.ToString(serviceEndpoint.Name, ""),
+			"description": converter.ToString(serviceEndpoint.Description, ""),
+			"type":        converter.ToString(serviceEndpoint.Type, ""),
+			"url":         converter.ToString(serviceEndpoint.Url, ""),
+			"owner":       converter.ToString(serviceEndpoint.Owner, ""),
+			"is_ready":    ready,
+			"is_shared":   converter.ToBool(serviceEndpoint.IsShared, false),
+		}
+		if serviceEndpoint.Id != nil {
+			output["id"] = serviceEndpoint.Id.String()
+		}
+		if serviceEndpoint.Authorization != nil {
+			output["authorization_scheme"] = converter.ToString(serviceEndpoint.Authorization.Scheme, "")
+		}
+		if serviceEndpoint.CreatedBy != nil {
+			output["created_by"] = converter.ToString(serviceEndpoint.CreatedBy.DisplayName, "")
+		}
+		results = append(results, output)
+	}
+	return results
+}
@@ -0,0 +1,137 @@
+//go:build (all || data_sources || data_serviceendpoints) && (!exclude_data_sources || !exclude_data_serviceendpoints)
+// +build all data_sources data_serviceendpoints
+// +build !exclude_data_sources !exclude_data_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var dataServiceEndpointsProjectID = uuid.New()
+
+var dataServiceEndpoints = []serviceendpoint.ServiceEndpoint{
+	{
+		Id:            newDataServiceEndpointId(),
+		Name:          converter.String("ready-endpoint"),
+		Type:          converter.String("generic"),
+		Url:           converter.String("https://example.com"),
+		Owner:         converter.String("library"),
+		IsReady:       converter.Bool(true),
+		IsShared:      converter.Bool(false),
+		Authorization: &serviceendpoint.EndpointAuthorization{Scheme: converter.String("UsernamePassword")},
+		CreatedBy:     &webapi.IdentityRef{DisplayName: converter.String("Jane Doe")},
+	},
+	{
+		Id:      newDataServiceEndpointId(),
+		Name:    converter.String("failed-endpoint"),
+		Type:    converter.String("generic"),
+		IsReady: converter.Bool(false),
+	},
+}
+
+func newDataServiceEndpointId() *uuid.UUID {
+	id := uuid.New()
+	return &id
+}
+
+func TestDataSourceServiceEndpoints_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpoints(clients.Ctx, serviceendpoint.GetServiceEndpointsArgs{
+			Project:       converter.String(dataServiceEndpointsProjectID.String()),
+			Type:          converter.String("generic"),
+			AuthSchemes:   &[]string{"UsernamePassword"},
+			IncludeFailed: converter.Bool(true),
+		}).
+		Return(&dataServiceEndpoints, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpoints().Schema, map[string]interface{}{
+		"project_id":           dataServiceEndpointsProjectID.String(),
+		"type":                 "generic",
+		"authorization_scheme": "UsernamePassword",
+	})
+	diags := dataSourceServiceEndpointsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, dataServiceEndpointsProjectID.String(), resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("service_endpoints.#"))
+	require.Equal(t, "ready-endpoint", resourceData.Get("service_endpoints.0.name"))
+	require.Equal(t, "UsernamePassword", resourceData.Get("service_endpoints.0.authorization_scheme"))
+	require.Equal(t, "Jane Doe", resourceData.Get("service_endpoints.0.created_by"))
+	require.Equal(t, "failed-endpoint", resourceData.Get("service_endpoints.1.name"))
+}
+
+func TestDataSourceServiceEndpoints_Read_FiltersReady(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpoints(clients.Ctx, gomock.Any()).
+		Return(&dataServiceEndpoints, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpoints().Schema, map[string]interface{}{
+		"project_id": dataServiceEndpointsProjectID.String(),
+		"is_ready":   true,
+	})
+	diags := dataSourceServiceEndpointsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, 1, resourceData.Get("service_endpoints.#"))
+	require.Equal(t, "ready-endpoint", resourceData.Get("service_endpoints.0.name"))
+	require.True(t, resourceData.Get("service_endpoints.0.is_ready").(bool))
+}
+
+func TestDataSourceServiceEndpoints_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpoints(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetServiceEndpoints() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServiceEndpoints().Schema, map[string]interface{}{
+		"project_id": dataServiceEndpointsProjectID.String(),
+	})
+	diags := dataSourceServiceEndpointsRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetServiceEndpoints() Failed")
+}
@@ -82,6 +82,8 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "authentication_token.0.token")
+	addWriteOnlySecret(r.Schema, "authentication_basic.0.password")
 	return r
 }
 
@@ -158,13 +160,9 @@ func expandServiceEndpointArtifactory(d *schema.ResourceData) (*serviceendpoint.
 
 	authParams := make(map[string]string)
 
-	if x, ok := d.GetOk("authentication_token"); ok {
+	if _, ok := d.GetOk("authentication_token"); ok {
 		authScheme = "Token"
-		msi := x.([]interface{})[0].(map[string]interface{})
-		authParams["apitoken"], ok = msi["token"].(string)
-		if !ok {
-			return nil, errors.New("Unable to read 'token'")
-		}
+		authParams["apitoken"] = getSecret(d, "authentication_token.0.token")
 	} else if x, ok := d.GetOk("authentication_basic"); ok {
 		authScheme = "UsernamePassword"
 		msi := x.([]interface{})[0].(map[string]interface{})
@@ -172,10 +170,7 @@ func expandServiceEndpointArtifactory(d *schema.ResourceData) (*serviceendpoint.
 		if !ok {
 			return nil, errors.New("Unable to read 'username'")
 		}
-		authParams["password"], ok = msi["password"].(string)
-		if !ok {
-			return nil, errors.New("Unable to read 'password'")
-		}
+		authParams["password"] = getSecret(d, "authentication_basic.0.password")
 	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &authParams,
@@ -32,11 +32,10 @@ func ResourceServiceEndpointAws() *schema.Resource {
 
 	maps.Copy(r.Schema, map[string]*schema.Schema{
 		"access_key_id": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			DefaultFunc:  schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_ACCESS_KEY_ID", nil),
-			Description:  "The AWS access key ID for signing programmatic requests.",
-			RequiredWith: []string{"secret_access_key"},
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_ACCESS_KEY_ID", nil),
+			Description: "The AWS access key ID for signing programmatic requests.",
 		},
 
 		"secret_access_key": {
@@ -84,6 +83,9 @@ func ResourceServiceEndpointAws() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "secret_access_key")
+	addWriteOnlySecret(r.Schema, "session_token")
+	r.Schema["secret_access_key_wo"].RequiredWith = []string{"access_key_id"}
 	return r
 }
 
@@ -144,8 +146,8 @@ func expandServiceEndpointAws(d *schema.ResourceData) *serviceendpoint.ServiceEn
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username":        d.Get("access_key_id").(string),
-			"password":        d.Get("secret_access_key").(string),
-			"sessionToken":    d.Get("session_token").(string),
+			"password":        getSecret(d, "secret_access_key"),
+			"sessionToken":    getSecret(d, "session_token"),
 			"assumeRoleArn":   d.Get("role_to_assume").(string),
 			"roleSessionName": d.Get("role_session_name").(string),
 			"externalId":      d.Get("external_id").(string),
@@ -45,6 +45,7 @@ func ResourceServiceEndpointAzureServiceBus() *schema.Resource {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "connection_string")
 	return r
 }
 
@@ -106,7 +107,7 @@ func expandServiceEndpointAzureServiceBus(d *schema.ResourceData) *serviceendpoi
 	serviceEndpoint := doBaseExpansion(d)
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"serviceBusConnectionString": d.Get("connection_string").(string),
+			"serviceBusConnectionString": getSecret(d, "connection_string"),
 		},
 		Scheme: converter.String("None"),
 	}
@@ -55,6 +55,7 @@ func ResourceServiceEndpointAzureDevOps() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "personal_access_token")
 	return r
 }
 
@@ -112,7 +113,7 @@ func expandServiceEndpointAzureDevOps(d *schema.ResourceData) *serviceendpoint.S
 	serviceEndpoint := doBaseExpansion(d)
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"apitoken": d.Get("personal_access_token").(string),
+			"apitoken": getSecret(d, "personal_access_token"),
 		},
 		Scheme: converter.String("Token"),
 	}
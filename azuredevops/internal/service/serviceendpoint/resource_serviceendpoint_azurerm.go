@@ -1,11 +1,14 @@
 package serviceendpoint
 
 import (
+	"context"
 	"fmt"
 	"maps"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
@@ -15,7 +18,12 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
-const endpointValidationTimeoutSeconds = 60 * time.Second
+const (
+	endpointValidationTimeoutSeconds = 60 * time.Second
+
+	// convertAuthenticationSchemeOperation is the operation of the endpoint update API converting the scheme in place
+	convertAuthenticationSchemeOperation = "ConvertAuthenticationScheme"
+)
 
 // ResourceServiceEndpointAzureRM schema and implementation for AzureRM service endpoint resource
 func ResourceServiceEndpointAzureRM() *schema.Resource {
@@ -30,8 +38,9 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
-		Schema:   baseSchema(),
+		Importer:      tfhelper.ImportProjectQualifiedResourceUUID(),
+		CustomizeDiff: resourceServiceEndpointAzureRMCustomizeDiff,
+		Schema:        baseSchema(),
 	}
 
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -98,14 +107,14 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 					"serviceprincipalkey": {
 						Type:          schema.TypeString,
 						Optional:      true,
-						ConflictsWith: []string{"credentials.0.serviceprincipalcertificate"},
+						ConflictsWith: []string{"credentials.0.serviceprincipalcertificate", "credentials.0.serviceprincipalcertificate_wo"},
 						Sensitive:     true,
 						ValidateFunc:  validation.StringIsNotEmpty,
 					},
 					"serviceprincipalcertificate": {
 						Type:          schema.TypeString,
 						Optional:      true,
-						ConflictsWith: []string{"credentials.0.serviceprincipalkey"},
+						ConflictsWith: []string{"credentials.0.serviceprincipalkey", "credentials.0.serviceprincipalkey_wo"},
 						Sensitive:     true,
 						ValidateFunc:  validation.StringIsNotEmpty,
 					},
@@ -122,10 +131,11 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 			ValidateFunc: validation.StringInSlice([]string{"AzureCloud", "AzureChinaCloud", "AzureUSGovernment", "AzureGermanCloud", "AzureStack"}, false),
 		},
 
+		// Converting from ServicePrincipal to WorkloadIdentityFederation is done in place, any other change forces a
+		// new resource, see resourceServiceEndpointAzureRMCustomizeDiff
 		"service_endpoint_authentication_scheme": {
 			Type:         schema.TypeString,
 			Optional:     true,
-			ForceNew:     true,
 			Description:  "The AzureRM Service Endpoint Authentication Scheme, this can be 'WorkloadIdentityFederation', 'ManagedServiceIdentity' or 'ServicePrincipal'.",
 			Default:      "ServicePrincipal",
 			ValidateFunc: validation.StringInSlice([]string{"WorkloadIdentityFederation", "ManagedServiceIdentity", "ServicePrincipal"}, false),
@@ -173,7 +183,13 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 		},
 	})
 
-	r.SchemaVersion = 2
+	addWriteOnlySecret(r.Schema, "credentials.0.serviceprincipalkey")
+	addWriteOnlySecret(r.Schema, "credentials.0.serviceprincipalcertificate")
+	credentials := r.Schema["credentials"].Elem.(*schema.Resource).Schema
+	credentials["serviceprincipalkey_wo"].ConflictsWith = []string{"credentials.0.serviceprincipalkey", "credentials.0.serviceprincipalcertificate", "credentials.0.serviceprincipalcertificate_wo"}
+	credentials["serviceprincipalcertificate_wo"].ConflictsWith = []string{"credentials.0.serviceprincipalcertificate", "credentials.0.serviceprincipalkey", "credentials.0.serviceprincipalkey_wo"}
+
+	r.SchemaVersion = 3
 	r.StateUpgraders = []schema.StateUpgrader{
 		{
 			Type:    migration.ServiceEndpointAzureRmSchemaV0ToV1().CoreConfigSchema().ImpliedType(),
@@ -185,6 +201,11 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 			Upgrade: migration.ServiceEndpointAzureRmStateUpgradeV1ToV2(),
 			Version: 1,
 		},
+		{
+			Type:    migration.ServiceEndpointAzureRmSchemaV2ToV3().CoreConfigSchema().ImpliedType(),
+			Upgrade: migration.ServiceEndpointAzureRmStateUpgradeV2ToV3(),
+			Version: 2,
+		},
 	}
 
 	return r
@@ -258,6 +279,15 @@ func resourceServiceEndpointAzureRMUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf(errMsgTfConfigRead, err)
 	}
 
+	if oldScheme, newScheme := d.GetChange("service_endpoint_authentication_scheme"); isAzureRMSchemeConversion(oldScheme.(string), newScheme.(string)) {
+		if err := convertServiceEndpointAzureRM(d, clients); err != nil {
+			return err
+		}
+		if !d.HasChangesExcept("service_endpoint_authentication_scheme", "credentials") {
+			return resourceServiceEndpointAzureRMRead(d, m)
+		}
+	}
+
 	if shouldValidate(endpointFeatures(d)) {
 		if err := validateServiceEndpoint(clients, serviceEndpoint, d.Get("project_id").(string), endpointValidationTimeoutSeconds); err != nil {
 			return err
@@ -281,6 +311,80 @@ func resourceServiceEndpointAzureRMDelete(d *schema.ResourceData, m interface{})
 	return deleteServiceEndpoint(clients, serviceEndpoint, d.Timeout(schema.TimeoutDelete))
 }
 
+func resourceServiceEndpointAzureRMCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("service_endpoint_authentication_scheme") {
+		return nil
+	}
+	oldScheme, newScheme := d.GetChange("service_endpoint_authentication_scheme")
+	if isAzureRMSchemeConversion(oldScheme.(string), newScheme.(string)) {
+		return nil
+	}
+	return d.ForceNew("service_endpoint_authentication_scheme")
+}
+
+// isAzureRMSchemeConversion returns true if the scheme change is supported by the endpoint conversion API
+func isAzureRMSchemeConversion(oldScheme string, newScheme string) bool {
+	return EndpointAuthenticationScheme(oldScheme) == ServicePrincipal && EndpointAuthenticationScheme(newScheme) == WorkloadIdentityFederation
+}
+
+// convertServiceEndpointAzureRM converts a ServicePrincipal endpoint to WorkloadIdentityFederation. The endpoint keeps
+// its ID, therefore the pipeline authorizations and checks of the endpoint are preserved.
+func convertServiceEndpointAzureRM(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	getArgs, err := serviceEndpointGetArgs(d)
+	if err != nil {
+		return err
+	}
+	projectID, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing project ID: %+v", err)
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+	if err != nil {
+		return fmt.Errorf(" looking up service endpoint given ID (%s) and project ID (%s): %v", getArgs.EndpointId, *getArgs.Project, err)
+	}
+	if serviceEndpoint == nil || serviceEndpoint.Authorization == nil || serviceEndpoint.Authorization.Parameters == nil {
+		return fmt.Errorf(" service endpoint %s has no authorization to convert", getArgs.EndpointId)
+	}
+
+	// the secret of the service principal is dropped, the federated credential replaces it
+	currentParameters := *serviceEndpoint.Authorization.Parameters
+	parameters := map[string]string{
+		"serviceprincipalid": currentParameters["serviceprincipalid"],
+		"tenantid":           currentParameters["tenantid"],
+	}
+	if scope, ok := currentParameters["scope"]; ok {
+		parameters["scope"] = scope
+	}
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &parameters,
+		Scheme:     converter.String(string(WorkloadIdentityFederation)),
+	}
+
+	_, err = clients.ServiceEndpointClient.UpdateServiceEndpoint(clients.Ctx, serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   serviceEndpoint,
+		EndpointId: serviceEndpoint.Id,
+		Operation:  converter.String(convertAuthenticationSchemeOperation),
+	})
+	if err != nil {
+		return fmt.Errorf(" converting service endpoint %s to %s: %+v", getArgs.EndpointId, WorkloadIdentityFederation, err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		ContinuousTargetOccurence: 1,
+		Delay:                     5 * time.Second,
+		MinTimeout:                5 * time.Second,
+		Pending:                   []string{opState.InProgress},
+		Target:                    []string{opState.Ready},
+		Refresh:                   getServiceEndpoint(clients, serviceEndpoint.Id, &projectID),
+		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+	}
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf(" waiting for the conversion of service endpoint %s: %+v", getArgs.EndpointId, err)
+	}
+	return nil
+}
+
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointAzureRM(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, error) {
 	serviceEndpoint := doBaseExpansion(d)
@@ -360,11 +464,11 @@ func expandServiceEndpointAzureRM(d *schema.ResourceData) (*serviceendpoint.Serv
 				Scheme: converter.String(string(serviceEndPointAuthenticationScheme)),
 			}
 
-			if spnKey := credentials["serviceprincipalkey"].(string); spnKey != "" {
+			if spnKey := getSecret(d, "credentials.0.serviceprincipalkey"); spnKey != "" {
 				(*serviceEndpoint.Authorization.Parameters)["authenticationType"] = "spnKey"
 				(*serviceEndpoint.Authorization.Parameters)["serviceprincipalkey"] = spnKey
 			}
-			if spnCert := credentials["serviceprincipalcertificate"].(string); spnCert != "" {
+			if spnCert := getSecret(d, "credentials.0.serviceprincipalcertificate"); spnCert != "" {
 				(*serviceEndpoint.Authorization.Parameters)["authenticationType"] = "spnCertificate"
 				(*serviceEndpoint.Authorization.Parameters)["servicePrincipalCertificate"] = spnCert
 			}
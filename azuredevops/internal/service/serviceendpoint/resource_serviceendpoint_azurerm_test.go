@@ -11,9 +11,11 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/serviceendpoint/migration"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -564,3 +566,133 @@ func initializeFeaturesWithValidate(validate bool) []map[string]interface{} {
 	features = append(features, feature)
 	return features
 }
+
+// verifies that switching from ServicePrincipal to WorkloadIdentityFederation converts the endpoint in place
+func TestServiceEndpointAzureRM_Update_ConvertsToWorkloadIdentityFederation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointAzureRM()
+	endpoint := getManualAuthServiceEndpoint()
+	resourceData := getResourceData(t, endpoint)
+	resourceData.Set("project_id", azurermTestServiceEndpointAzureRMProjectID.String())
+	flattenServiceEndpointAzureRM(resourceData, &endpoint)
+	resourceData = getConversionResourceData(t, r, resourceData.State())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	convertedEndpoint := getManualAuthServiceEndpoint()
+	convertedEndpoint.IsReady = converter.Bool(true)
+	convertedEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"serviceprincipalid":                (*endpoint.Authorization.Parameters)["serviceprincipalid"],
+			"tenantid":                          (*endpoint.Authorization.Parameters)["tenantid"],
+			"workloadIdentityFederationIssuer":  "https://vstoken.dev.azure.com/00000000-0000-0000-0000-000000000000",
+			"workloadIdentityFederationSubject": "sc://org/project/connection",
+		},
+		Scheme: converter.String(string(WorkloadIdentityFederation)),
+	}
+
+	currentEndpoint := getManualAuthServiceEndpoint()
+	gomock.InOrder(
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(&currentEndpoint, nil).
+			Times(1),
+		buildClient.
+			EXPECT().
+			UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args serviceendpoint.UpdateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+				require.Equal(t, "ConvertAuthenticationScheme", *args.Operation)
+				require.Equal(t, endpoint.Id, args.EndpointId)
+				require.Equal(t, string(WorkloadIdentityFederation), *args.Endpoint.Authorization.Scheme)
+				require.NotContains(t, *args.Endpoint.Authorization.Parameters, "serviceprincipalkey")
+				return &convertedEndpoint, nil
+			}).
+			Times(1),
+		buildClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(&convertedEndpoint, nil).
+			Times(2),
+	)
+
+	err := r.Update(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, string(WorkloadIdentityFederation), resourceData.Get("service_endpoint_authentication_scheme"))
+	require.Equal(t, "sc://org/project/connection", resourceData.Get("workload_identity_federation_subject"))
+}
+
+// verifies that if an error is produced on a conversion, it is not swallowed
+func TestServiceEndpointAzureRM_Update_ConversionDoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointAzureRM()
+	endpoint := getManualAuthServiceEndpoint()
+	resourceData := getResourceData(t, endpoint)
+	resourceData.Set("project_id", azurermTestServiceEndpointAzureRMProjectID.String())
+	flattenServiceEndpointAzureRM(resourceData, &endpoint)
+	resourceData = getConversionResourceData(t, r, resourceData.State())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	currentEndpoint := getManualAuthServiceEndpoint()
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&currentEndpoint, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+func TestServiceEndpointAzureRM_StateUpgradeV2ToV3(t *testing.T) {
+	upgrade := migration.ServiceEndpointAzureRmStateUpgradeV2ToV3()
+
+	servicePrincipalState := map[string]interface{}{
+		"service_endpoint_authentication_scheme": "ServicePrincipal",
+		"credentials": []interface{}{
+			map[string]interface{}{"serviceprincipalid": "id", "serviceprincipalkey": "key"},
+		},
+	}
+	upgraded, err := upgrade(context.Background(), servicePrincipalState, nil)
+	require.Nil(t, err)
+	require.Equal(t, "key", upgraded["credentials"].([]interface{})[0].(map[string]interface{})["serviceprincipalkey"])
+
+	workloadIdentityState := map[string]interface{}{
+		"service_endpoint_authentication_scheme": "WorkloadIdentityFederation",
+		"credentials": []interface{}{
+			map[string]interface{}{"serviceprincipalid": "id", "serviceprincipalkey": "key"},
+		},
+	}
+	upgraded, err = upgrade(context.Background(), workloadIdentityState, nil)
+	require.Nil(t, err)
+	credential := upgraded["credentials"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "id", credential["serviceprincipalid"])
+	require.Equal(t, "", credential["serviceprincipalkey"])
+}
+
+// getConversionResourceData returns the resource data of a planned conversion from ServicePrincipal to WorkloadIdentityFederation
+func getConversionResourceData(t *testing.T, r *schema.Resource, state *terraform.InstanceState) *schema.ResourceData {
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"service_endpoint_authentication_scheme": {
+				Old: string(ServicePrincipal),
+				New: string(WorkloadIdentityFederation),
+			},
+		},
+	}
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+	return resourceData
+}
@@ -46,6 +46,7 @@ func ResourceServiceEndpointBitBucket() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "password")
 	return r
 }
 
@@ -104,7 +105,7 @@ func expandServiceEndpointBitBucket(d *schema.ResourceData) *serviceendpoint.Ser
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": getSecret(d, "password"),
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
@@ -43,6 +43,7 @@ func ResourceServiceEndpointBlackDuck() *schema.Resource {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "api_token")
 	return r
 }
 
@@ -100,7 +101,7 @@ func expandServiceEndpointBlackDuck(d *schema.ResourceData) *serviceendpoint.Ser
 	serviceEndpoint := doBaseExpansion(d)
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"apitoken": d.Get("api_token").(string),
+			"apitoken": getSecret(d, "api_token"),
 		},
 		Scheme: converter.String("Token"),
 	}
@@ -25,8 +25,9 @@ func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
-		Schema:   baseSchema(),
+		Importer:      tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema:        baseSchema(),
+		CustomizeDiff: requireSecretWith("client_id", "client_secret"),
 	}
 
 	maps.Copy(r.Schema, map[string]*schema.Schema{
@@ -41,16 +42,15 @@ func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringIsNotWhiteSpace,
-			ConflictsWith: []string{"client_id", "client_secret", "authorization_url"},
-			AtLeastOneOf:  []string{"client_id", "api_key"},
+			ConflictsWith: []string{"client_id", "client_secret", "client_secret_wo", "authorization_url"},
+			AtLeastOneOf:  []string{"client_id", "api_key", "api_key_wo"},
 		},
 
 		"client_id": {
 			Type:          schema.TypeString,
 			Optional:      true,
 			ValidateFunc:  validation.StringIsNotWhiteSpace,
-			ConflictsWith: []string{"api_key"},
-			RequiredWith:  []string{"client_secret"},
+			ConflictsWith: []string{"api_key", "api_key_wo"},
 		},
 
 		"client_secret": {
@@ -58,7 +58,7 @@ func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringIsNotWhiteSpace,
-			ConflictsWith: []string{"api_key"},
+			ConflictsWith: []string{"api_key", "api_key_wo"},
 			RequiredWith:  []string{"client_id"},
 		},
 
@@ -66,9 +66,14 @@ func ResourceServiceEndpointCheckMarxOneService() *schema.Resource {
 			Type:          schema.TypeString,
 			Optional:      true,
 			ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
-			ConflictsWith: []string{"api_key"},
+			ConflictsWith: []string{"api_key", "api_key_wo"},
 		},
 	})
+	addWriteOnlySecret(r.Schema, "api_key")
+	addWriteOnlySecret(r.Schema, "client_secret")
+	r.Schema["api_key_wo"].ConflictsWith = []string{"api_key", "client_id", "client_secret", "client_secret_wo", "authorization_url"}
+	r.Schema["client_secret_wo"].ConflictsWith = []string{"client_secret", "api_key", "api_key_wo"}
+	r.Schema["client_secret_wo"].RequiredWith = []string{"client_id"}
 	return r
 }
 
@@ -125,10 +130,10 @@ func resourceServiceEndpointCheckMarxOneServiceDelete(d *schema.ResourceData, m
 func expandServiceEndpointCheckMarxOneService(d *schema.ResourceData) *serviceendpoint.ServiceEndpoint {
 	serviceEndpoint := doBaseExpansion(d)
 
-	if v, ok := d.GetOk("api_key"); ok {
+	if apiKey := getSecret(d, "api_key"); apiKey != "" {
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
-				"apitoken": v.(string),
+				"apitoken": apiKey,
 			},
 			Scheme: converter.String("Token"),
 		}
@@ -137,7 +142,7 @@ func expandServiceEndpointCheckMarxOneService(d *schema.ResourceData) *serviceen
 			Parameters: &map[string]string{
 				"authURL":  d.Get("authorization_url").(string),
 				"username": d.Get("client_id").(string),
-				"password": d.Get("client_secret").(string),
+				"password": getSecret(d, "client_secret"),
 			},
 			Scheme: converter.String("UsernamePassword"),
 		}
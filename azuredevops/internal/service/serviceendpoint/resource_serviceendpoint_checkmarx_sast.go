@@ -61,6 +61,7 @@ func ResourceServiceEndpointCheckMarxSAST() *schema.Resource {
 			ValidateFunc: validation.StringIsNotWhiteSpace,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "password")
 	return r
 }
 
@@ -119,7 +120,7 @@ func expandServiceEndpointCheckMarxSAST(d *schema.ResourceData) *serviceendpoint
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": getSecret(d, "password"),
 			"preset":   d.Get("preset").(string),
 			"teams":    d.Get("team").(string),
 		},
@@ -73,6 +73,7 @@ func ResourceServiceEndpointCheckMarxSCA() *schema.Resource {
 			ValidateFunc: validation.StringIsNotWhiteSpace,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "password")
 	return r
 }
 
@@ -131,7 +132,7 @@ func expandServiceEndpointCheckMarxSCA(d *schema.ResourceData) *serviceendpoint.
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": getSecret(d, "password"),
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
@@ -0,0 +1,271 @@
+package serviceendpoint
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointCustom schema and implementation for a service endpoint of an arbitrary type
+func ResourceServiceEndpointCustom() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceServiceEndpointCustomCreate,
+		Read:   resourceServiceEndpointCustomRead,
+		Update: resourceServiceEndpointCustomUpdate,
+		Delete: resourceServiceEndpointCustomDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer:      importServiceEndpointCustom(),
+		CustomizeDiff: resourceServiceEndpointCustomCustomizeDiff,
+		Schema:        baseSchema(),
+	}
+
+	maps.Copy(r.Schema, map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The type of the service connection, as registered by Azure DevOps or an extension.",
+		},
+		"url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The URL of the service connection.",
+		},
+		"authorization_scheme": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The authorization scheme of the service connection.",
+		},
+		"authorization_parameters": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The parameters of the authorization scheme.",
+		},
+		"data": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The type specific data of the service connection.",
+		},
+	})
+	return r
+}
+
+func resourceServiceEndpointCustomCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointCustom(d)
+	serviceEndPoint, err := createServiceEndpoint(d, clients, serviceEndpoint)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceEndPoint.Id.String())
+	return resourceServiceEndpointCustomRead(d, m)
+}
+
+func resourceServiceEndpointCustomRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	getArgs, err := serviceEndpointGetArgs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+	if isServiceEndpointDeleted(d, err, serviceEndpoint, getArgs) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("looking up service endpoint given ID (%s) and project ID (%s): %v", getArgs.EndpointId, *getArgs.Project, err)
+	}
+
+	if err = checkServiceConnection(serviceEndpoint); err != nil {
+		return err
+	}
+	flattenServiceEndpointCustom(d, serviceEndpoint)
+	return nil
+}
+
+func resourceServiceEndpointCustomUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointCustom(d)
+	if _, err := updateServiceEndpoint(clients, serviceEndpoint); err != nil {
+		return fmt.Errorf("Updating service endpoint in Azure DevOps: %+v", err)
+	}
+
+	return resourceServiceEndpointCustomRead(d, m)
+}
+
+func resourceServiceEndpointCustomDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	serviceEndpoint := expandServiceEndpointCustom(d)
+	return deleteServiceEndpoint(clients, serviceEndpoint, d.Timeout(schema.TimeoutDelete))
+}
+
+func resourceServiceEndpointCustomCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChanges("type", "authorization_scheme", "authorization_parameters", "data") {
+		return nil
+	}
+	// values which are only known after apply cannot be validated
+	for _, key := range []string{"type", "authorization_scheme", "authorization_parameters", "data"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	clients := m.(*client.AggregatedClient)
+	return validateServiceEndpointCustom(ctx, clients,
+		d.Get("type").(string),
+		d.Get("authorization_scheme").(string),
+		tfhelper.ExpandStringMap(d.Get("authorization_parameters")),
+		tfhelper.ExpandStringMap(d.Get("data")),
+	)
+}
+
+// validateServiceEndpointCustom checks the configuration against the endpoint type definition, which also covers
+// endpoint types contributed by extensions
+func validateServiceEndpointCustom(ctx context.Context, clients *client.AggregatedClient, endpointType string, scheme string, parameters map[string]string, data map[string]string) error {
+	endpointTypes, err := clients.ServiceEndpointClient.GetServiceEndpointTypes(ctx, serviceendpoint.GetServiceEndpointTypesArgs{
+		Type: converter.String(endpointType),
+	})
+	if err != nil {
+		return fmt.Errorf(" Looking up service endpoint type %s: %+v", endpointType, err)
+	}
+
+	var definition *serviceendpoint.ServiceEndpointType
+	if endpointTypes != nil {
+		for _, t := range *endpointTypes {
+			if strings.EqualFold(converter.ToString(t.Name, ""), endpointType) {
+				definition = &t
+				break
+			}
+		}
+	}
+	if definition == nil {
+		return fmt.Errorf(" Service endpoint type %s is not available in the organization. Make sure the extension providing it is installed", endpointType)
+	}
+
+	var authScheme *serviceendpoint.ServiceEndpointAuthenticationScheme
+	var schemes []string
+	if definition.AuthenticationSchemes != nil {
+		for _, s := range *definition.AuthenticationSchemes {
+			schemes = append(schemes, converter.ToString(s.Scheme, ""))
+			if strings.EqualFold(converter.ToString(s.Scheme, ""), scheme) {
+				authScheme = &s
+			}
+		}
+	}
+	if authScheme == nil {
+		return fmt.Errorf(" Authorization scheme %s is not supported by service endpoint type %s. Supported schemes: %s", scheme, endpointType, strings.Join(schemes, ", "))
+	}
+
+	if missing := missingRequiredInputs(authScheme.InputDescriptors, parameters); len(missing) > 0 {
+		return fmt.Errorf(" `authorization_parameters` is missing the required parameters of scheme %s: %s", scheme, strings.Join(missing, ", "))
+	}
+	if missing := missingRequiredInputs(definition.InputDescriptors, data); len(missing) > 0 {
+		return fmt.Errorf(" `data` is missing the required parameters of service endpoint type %s: %s", endpointType, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func missingRequiredInputs(descriptors *[]forminput.InputDescriptor, values map[string]string) []string {
+	if descriptors == nil {
+		return nil
+	}
+	var missing []string
+	for _, descriptor := range *descriptors {
+		if descriptor.Id == nil || descriptor.Validation == nil || !converter.ToBool(descriptor.Validation.IsRequired, false) {
+			continue
+		}
+		if v, ok := values[*descriptor.Id]; !ok || v == "" {
+			missing = append(missing, *descriptor.Id)
+		}
+	}
+	sort.Strings(missing)
+	return slices.Compact(missing)
+}
+
+func expandServiceEndpointCustom(d *schema.ResourceData) *serviceendpoint.ServiceEndpoint {
+	serviceEndpoint := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String(d.Get("type").(string))
+	serviceEndpoint.Url = converter.String(d.Get("url").(string))
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: converter.ToPtr(tfhelper.ExpandStringMap(d.Get("authorization_parameters"))),
+		Scheme:     converter.String(d.Get("authorization_scheme").(string)),
+	}
+	serviceEndpoint.Data = converter.ToPtr(tfhelper.ExpandStringMap(d.Get("data")))
+	return serviceEndpoint
+}
+
+func flattenServiceEndpointCustom(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint) {
+	doBaseFlattening(d, serviceEndpoint)
+	d.Set("type", converter.ToString(serviceEndpoint.Type, ""))
+	d.Set("url", converter.ToString(serviceEndpoint.Url, ""))
+	if serviceEndpoint.Authorization != nil {
+		d.Set("authorization_scheme", converter.ToString(serviceEndpoint.Authorization.Scheme, ""))
+	}
+	// Confidential authorization parameters are never returned, therefore they are kept as configured.
+
+	// The service may add data of its own, only the tracked keys are kept. All keys are tracked after an import,
+	// see importServiceEndpointCustom.
+	if serviceEndpoint.Data != nil {
+		tracked := d.Get("data").(map[string]interface{})
+		data := map[string]string{}
+		for k, v := range *serviceEndpoint.Data {
+			if _, ok := tracked[k]; ok {
+				data[k] = v
+			}
+		}
+		d.Set("data", data)
+	}
+}
+
+// importServiceEndpointCustom adopts all data of the service endpoint, there is no configuration to filter it by
+func importServiceEndpointCustom() *schema.ResourceImporter {
+	importer := tfhelper.ImportProjectQualifiedResourceUUID()
+	importState := importer.State
+	importer.State = func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if _, err := importState(d, m); err != nil {
+			return nil, err
+		}
+
+		clients := m.(*client.AggregatedClient)
+		getArgs, err := serviceEndpointGetArgs(d)
+		if err != nil {
+			return nil, err
+		}
+		serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, *getArgs)
+		if err != nil {
+			return nil, fmt.Errorf("looking up service endpoint given ID (%s) and project ID (%s): %v", getArgs.EndpointId, *getArgs.Project, err)
+		}
+		if serviceEndpoint == nil || serviceEndpoint.Id == nil {
+			return nil, fmt.Errorf("service endpoint with ID (%s) does not exist in project (%s)", getArgs.EndpointId, *getArgs.Project)
+		}
+		if serviceEndpoint.Data != nil {
+			d.Set("data", *serviceEndpoint.Data)
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+	return importer
+}
@@ -0,0 +1,299 @@
+//go:build (all || resource_serviceendpoint_custom) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_custom
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	customTestServiceEndpointID          = uuid.New()
+	customRandomServiceEndpointProjectID = uuid.New()
+	customTestServiceEndpointProjectID   = &customRandomServiceEndpointProjectID
+)
+
+var customTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": "secret",
+		},
+		Scheme: converter.String("Token"),
+	},
+	Data: &map[string]string{
+		"environment": "production",
+	},
+	Id:          &customTestServiceEndpointID,
+	Name:        converter.String("UNIT_TEST_CONN_NAME"),
+	Owner:       converter.String("library"), // Supported values are "library", "agentcloud"
+	Type:        converter.String("contoso"),
+	Url:         converter.String("https://contoso.com"),
+	Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: customTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+var customTestServiceEndpointType = serviceendpoint.ServiceEndpointType{
+	Name: converter.String("contoso"),
+	AuthenticationSchemes: &[]serviceendpoint.ServiceEndpointAuthenticationScheme{
+		{
+			Scheme: converter.String("Token"),
+			InputDescriptors: &[]forminput.InputDescriptor{
+				{
+					Id:         converter.String("apitoken"),
+					Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+				},
+			},
+		},
+		{
+			Scheme: converter.String("UsernamePassword"),
+		},
+	},
+	InputDescriptors: &[]forminput.InputDescriptor{
+		{
+			Id:         converter.String("environment"),
+			Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+		},
+		{
+			Id:         converter.String("region"),
+			Validation: &forminput.InputValidation{IsRequired: converter.Bool(false)},
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestServiceEndpointCustom_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointCustom().Schema, nil)
+	resourceData.Set("project_id", customTestServiceEndpointProjectID.String())
+	resourceData.Set("authorization_parameters", map[string]interface{}{"apitoken": "secret"})
+	resourceData.Set("data", map[string]interface{}{"environment": ""})
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint)
+
+	serviceEndpointAfterRoundTrip := expandServiceEndpointCustom(resourceData)
+	require.Equal(t, customTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+}
+
+// verifies that data added by the service is not tracked when it was not configured
+func TestServiceEndpointCustom_Flatten_IgnoresUnconfiguredData(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointCustom().Schema, nil)
+	resourceData.Set("project_id", customTestServiceEndpointProjectID.String())
+	resourceData.Set("data", map[string]interface{}{"environment": "staging"})
+
+	serviceEndpoint := customTestServiceEndpoint
+	serviceEndpoint.Data = &map[string]string{
+		"environment":   "production",
+		"acceptedTerms": "true",
+	}
+	flattenServiceEndpointCustom(resourceData, &serviceEndpoint)
+
+	require.Equal(t, map[string]interface{}{"environment": "production"}, resourceData.Get("data"))
+}
+
+// verifies that data added by the service is not tracked when no data is configured
+func TestServiceEndpointCustom_Flatten_IgnoresDataWithoutConfiguration(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointCustom().Schema, nil)
+	resourceData.Set("project_id", customTestServiceEndpointProjectID.String())
+
+	serviceEndpoint := customTestServiceEndpoint
+	serviceEndpoint.Data = &map[string]string{"acceptedTerms": "true"}
+	flattenServiceEndpointCustom(resourceData, &serviceEndpoint)
+
+	require.Empty(t, resourceData.Get("data"))
+}
+
+// verifies that an import adopts all data of the service endpoint
+func TestServiceEndpointCustom_Import_AdoptsAllData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	serviceEndpoint := customTestServiceEndpoint
+	serviceEndpoint.Data = &map[string]string{
+		"environment":   "production",
+		"acceptedTerms": "true",
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: &customTestServiceEndpointID,
+			Project:    converter.String(customTestServiceEndpointProjectID.String()),
+		}).
+		Return(&serviceEndpoint, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointCustom().Schema, nil)
+	resourceData.SetId(customTestServiceEndpointProjectID.String() + "/" + customTestServiceEndpointID.String())
+	imported, err := ResourceServiceEndpointCustom().Importer.State(resourceData, clients)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, map[string]interface{}{"environment": "production", "acceptedTerms": "true"}, imported[0].Get("data"))
+
+	// the following reads keep tracking all adopted keys
+	flattenServiceEndpointCustom(imported[0], &serviceEndpoint)
+	require.Equal(t, map[string]interface{}{"environment": "production", "acceptedTerms": "true"}, imported[0].Get("data"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointCustom_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointCustom()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", customTestServiceEndpointProjectID.String())
+	resourceData.Set("authorization_parameters", map[string]interface{}{"apitoken": "secret"})
+	resourceData.Set("data", map[string]interface{}{"environment": ""})
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &customTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestServiceEndpointCustom_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointCustom()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("project_id", customTestServiceEndpointProjectID.String())
+	flattenServiceEndpointCustom(resourceData, &customTestServiceEndpoint)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: customTestServiceEndpoint.Id,
+		Project:    converter.String(customTestServiceEndpointProjectID.String()),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+func TestServiceEndpointCustom_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		endpointType  string
+		scheme        string
+		parameters    map[string]string
+		data          map[string]string
+		expectedError string
+	}{
+		{
+			name:         "valid",
+			endpointType: "contoso",
+			scheme:       "token",
+			parameters:   map[string]string{"apitoken": "secret"},
+			data:         map[string]string{"environment": "production"},
+		},
+		{
+			name:          "unknown type",
+			endpointType:  "fabrikam",
+			scheme:        "Token",
+			expectedError: "Service endpoint type fabrikam is not available",
+		},
+		{
+			name:          "unsupported scheme",
+			endpointType:  "contoso",
+			scheme:        "OAuth",
+			expectedError: "Supported schemes: Token, UsernamePassword",
+		},
+		{
+			name:          "missing authorization parameter",
+			endpointType:  "contoso",
+			scheme:        "Token",
+			parameters:    map[string]string{"apitoken": ""},
+			data:          map[string]string{"environment": "production"},
+			expectedError: "`authorization_parameters` is missing the required parameters of scheme Token: apitoken",
+		},
+		{
+			name:          "missing data",
+			endpointType:  "contoso",
+			scheme:        "Token",
+			parameters:    map[string]string{"apitoken": "secret"},
+			expectedError: "`data` is missing the required parameters of service endpoint type contoso: environment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+			clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+			endpointTypes := []serviceendpoint.ServiceEndpointType{}
+			if tt.endpointType == "contoso" {
+				endpointTypes = append(endpointTypes, customTestServiceEndpointType)
+			}
+			buildClient.
+				EXPECT().
+				GetServiceEndpointTypes(clients.Ctx, serviceendpoint.GetServiceEndpointTypesArgs{Type: converter.String(tt.endpointType)}).
+				Return(&endpointTypes, nil).
+				Times(1)
+
+			err := validateServiceEndpointCustom(clients.Ctx, clients, tt.endpointType, tt.scheme, tt.parameters, tt.data)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestServiceEndpointCustom_Validate_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetServiceEndpointTypes(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetServiceEndpointTypes() Failed")).
+		Times(1)
+
+	err := validateServiceEndpointCustom(clients.Ctx, clients, "contoso", "Token", nil, nil)
+	require.ErrorContains(t, err, "GetServiceEndpointTypes() Failed")
+}
@@ -64,6 +64,7 @@ func ResourceServiceEndpointDockerRegistry() *schema.Resource {
 			ForceNew:     true,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "docker_password")
 	return r
 }
 
@@ -124,7 +125,7 @@ func expandServiceEndpointDockerRegistry(d *schema.ResourceData) *serviceendpoin
 		Parameters: &map[string]string{
 			"registry": d.Get("docker_registry").(string),
 			"username": d.Get("docker_username").(string),
-			"password": d.Get("docker_password").(string),
+			"password": getSecret(d, "docker_password"),
 			"email":    d.Get("docker_email").(string),
 		},
 		Scheme: converter.String("UsernamePassword"),
@@ -63,6 +63,7 @@ func ResourceServiceEndpointDynamicsLifecycleServices() *schema.Resource {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "password")
 	return r
 }
 
@@ -126,7 +127,7 @@ func expandServiceEndpointDynamicsLifecycleServices(d *schema.ResourceData) *ser
 		Parameters: &map[string]string{
 			"clientid": d.Get("client_id").(string),
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": getSecret(d, "password"),
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
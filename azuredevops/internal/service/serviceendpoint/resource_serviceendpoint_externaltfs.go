@@ -57,6 +57,7 @@ func ResourceServiceEndpointExternalTFS() *schema.Resource {
 		},
 	})
 
+	addWriteOnlyAuthPersonal(r.Schema)
 	return r
 }
 
@@ -122,6 +123,8 @@ func expandServiceEndpointExternalTFS(d *schema.ResourceData) *serviceendpoint.S
 	if config, ok := d.GetOk("auth_personal"); ok {
 		scheme = "Token"
 		parameters = expandAuthPersonalSetExternalTFS(config.(*schema.Set))
+	} else if token, ok := getWriteOnlySecret(d, "personal_access_token_wo"); ok {
+		parameters = map[string]string{"apitoken": token}
 	}
 
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
@@ -63,6 +63,7 @@ func ResourceServiceEndpointGcp() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "private_key")
 	return r
 }
 
@@ -124,7 +125,7 @@ func expandServiceEndpointGcp(d *schema.ResourceData) *serviceendpoint.ServiceEn
 			"Issuer":     d.Get("client_email").(string),
 			"Audience":   d.Get("token_uri").(string),
 			"Scope":      d.Get("scope").(string),
-			"PrivateKey": d.Get("private_key").(string),
+			"PrivateKey": getSecret(d, "private_key"),
 		},
 		Scheme: converter.String("JWT"),
 	}
@@ -53,6 +53,7 @@ func ResourceServiceEndpointGeneric() *schema.Resource {
 			Optional:    true,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "password")
 	return r
 }
 
@@ -113,7 +114,7 @@ func expandServiceEndpointGeneric(d *schema.ResourceData) *serviceendpoint.Servi
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": getSecret(d, "password"),
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
@@ -58,6 +58,7 @@ func ResourceServiceEndpointGenericGit() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "password")
 	return r
 }
 
@@ -121,7 +122,7 @@ func expandServiceEndpointGenericGit(d *schema.ResourceData) *serviceendpoint.Se
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": getSecret(d, "password"),
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
@@ -68,6 +68,7 @@ func ResourceServiceEndpointGitHub() *schema.Resource {
 		},
 	})
 
+	addWriteOnlyAuthPersonal(r.Schema)
 	return r
 }
 
@@ -131,6 +132,9 @@ func expandServiceEndpointGitHub(d *schema.ResourceData) *serviceendpoint.Servic
 	if config, ok := d.GetOk("auth_personal"); ok {
 		scheme = "Token"
 		parameters = expandAuthPersonalSetGithub(config.(*schema.Set))
+	} else if token, ok := getWriteOnlySecret(d, "personal_access_token_wo"); ok {
+		scheme = "Token"
+		parameters = map[string]string{"AccessToken": token}
 	}
 
 	if config, ok := d.GetOk("auth_oauth"); ok {
@@ -73,6 +73,7 @@ func ResourceServiceEndpointGitHubEnterprise() *schema.Resource {
 			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 		},
 	})
+	addWriteOnlyAuthPersonal(r.Schema)
 	return r
 }
 
@@ -177,6 +178,9 @@ func expandServiceEndpointGitHubEnterprise(d *schema.ResourceData) *serviceendpo
 	if config, ok := d.GetOk("auth_personal"); ok {
 		scheme = "Token"
 		parameters = expandAuthPersonalSetGithubEnterprise(config.(*schema.Set))
+	} else if token, ok := getWriteOnlySecret(d, "personal_access_token_wo"); ok {
+		scheme = "Token"
+		parameters = map[string]string{"apitoken": token}
 	}
 
 	if config, ok := d.GetOk("auth_oauth"); ok {
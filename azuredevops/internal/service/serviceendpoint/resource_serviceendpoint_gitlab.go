@@ -49,6 +49,7 @@ func ResourceServiceEndpointGitLab() *schema.Resource {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "api_token")
 	return r
 }
 
@@ -110,7 +111,7 @@ func expandServiceEndpointGitLab(d *schema.ResourceData) *serviceendpoint.Servic
 	serviceEndpoint := doBaseExpansion(d)
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"apitoken": d.Get("api_token").(string),
+			"apitoken": getSecret(d, "api_token"),
 		},
 		Scheme: converter.String("Token"),
 	}
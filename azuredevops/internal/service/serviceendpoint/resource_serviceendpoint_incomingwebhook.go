@@ -51,6 +51,7 @@ func ResourceServiceEndpointIncomingWebhook() *schema.Resource {
 			Description: "Optional http header name on which checksum will be sent.",
 		},
 	})
+	addWriteOnlySecret(r.Schema, "secret")
 	return r
 }
 
@@ -112,7 +113,7 @@ func expandServiceEndpointIncomingWebhook(d *schema.ResourceData) *serviceendpoi
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"webhookname": d.Get("webhook_name").(string),
-			"secret":      d.Get("secret").(string),
+			"secret":      getSecret(d, "secret"),
 			"header":      d.Get("http_header").(string),
 		},
 		Scheme: converter.String("None"),
@@ -60,6 +60,7 @@ func ResourceServiceEndpointJenkins() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "password")
 	return r
 }
 
@@ -122,7 +123,7 @@ func expandServiceEndpointJenkins(d *schema.ResourceData) *serviceendpoint.Servi
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": getSecret(d, "password"),
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
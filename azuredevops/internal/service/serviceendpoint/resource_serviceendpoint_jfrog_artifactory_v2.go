@@ -82,6 +82,8 @@ func ResourceServiceEndpointJFrogArtifactoryV2() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "authentication_token.0.token")
+	addWriteOnlySecret(r.Schema, "authentication_basic.0.password")
 	return r
 }
 
@@ -183,13 +185,9 @@ func expandServiceEndpointJFrogArtifactoryV2(d *schema.ResourceData) (*serviceen
 
 	authParams := make(map[string]string)
 
-	if x, ok := d.GetOk("authentication_token"); ok {
+	if _, ok := d.GetOk("authentication_token"); ok {
 		authScheme = "Token"
-		msi := x.([]interface{})[0].(map[string]interface{})
-		authParams["apitoken"], ok = msi["token"].(string)
-		if !ok {
-			return nil, errors.New("Unable to read 'token'")
-		}
+		authParams["apitoken"] = getSecret(d, "authentication_token.0.token")
 	} else if x, ok := d.GetOk("authentication_basic"); ok {
 		authScheme = "UsernamePassword"
 		msi := x.([]interface{})[0].(map[string]interface{})
@@ -197,10 +195,7 @@ func expandServiceEndpointJFrogArtifactoryV2(d *schema.ResourceData) (*serviceen
 		if !ok {
 			return nil, errors.New("Unable to read 'username'")
 		}
-		authParams["password"], ok = msi["password"].(string)
-		if !ok {
-			return nil, errors.New("Unable to read 'password'")
-		}
+		authParams["password"] = getSecret(d, "authentication_basic.0.password")
 	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &authParams,
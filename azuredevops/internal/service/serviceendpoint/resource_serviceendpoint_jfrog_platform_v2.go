@@ -81,6 +81,8 @@ func ResourceServiceEndpointJFrogPlatformV2() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "authentication_token.0.token")
+	addWriteOnlySecret(r.Schema, "authentication_basic.0.password")
 	return r
 }
 
@@ -155,13 +157,9 @@ func expandServiceEndpointJFrogPlatformV2(d *schema.ResourceData) (*serviceendpo
 
 	authParams := make(map[string]string)
 
-	if x, ok := d.GetOk("authentication_token"); ok {
+	if _, ok := d.GetOk("authentication_token"); ok {
 		authScheme = "Token"
-		msi := x.([]interface{})[0].(map[string]interface{})
-		authParams["apitoken"], ok = msi["token"].(string)
-		if !ok {
-			return nil, errors.New("Unable to read 'token'")
-		}
+		authParams["apitoken"] = getSecret(d, "authentication_token.0.token")
 	} else if x, ok := d.GetOk("authentication_basic"); ok {
 		authScheme = "UsernamePassword"
 		msi := x.([]interface{})[0].(map[string]interface{})
@@ -169,10 +167,7 @@ func expandServiceEndpointJFrogPlatformV2(d *schema.ResourceData) (*serviceendpo
 		if !ok {
 			return nil, errors.New("Unable to read 'username'")
 		}
-		authParams["password"], ok = msi["password"].(string)
-		if !ok {
-			return nil, errors.New("Unable to read 'password'")
-		}
+		authParams["password"] = getSecret(d, "authentication_basic.0.password")
 	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &authParams,
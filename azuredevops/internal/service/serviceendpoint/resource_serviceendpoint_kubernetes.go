@@ -169,6 +169,9 @@ func ResourceServiceEndpointKubernetes() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "kubeconfig.0.kube_config")
+	addWriteOnlySecret(r.Schema, "service_account.0.ca_cert")
+	addWriteOnlySecret(r.Schema, "service_account.0.token")
 	return r
 }
 
@@ -269,10 +272,10 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 	case "Kubeconfig":
 		configurationRaw := d.Get("kubeconfig").([]interface{})
 		configuration := configurationRaw[0].(map[string]interface{})
+		kubeConfigYAML := getSecret(d, "kubeconfig.0.kube_config")
 
 		clusterContextInput := configuration["cluster_context"].(string)
 		if clusterContextInput == "" {
-			kubeConfigYAML := configuration["kube_config"].(string)
 			var kubeConfigYAMLUnmarshalled map[string]interface{}
 			err := yaml.Unmarshal([]byte(kubeConfigYAML), &kubeConfigYAMLUnmarshalled)
 			if err != nil {
@@ -292,7 +295,7 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
 				"clusterContext": clusterContextInput,
-				"kubeconfig":     configuration["kube_config"].(string),
+				"kubeconfig":     kubeConfigYAML,
 			},
 			Scheme: converter.String("Kubernetes"),
 		}
@@ -307,8 +310,8 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
-				"apiToken":                  configuration["token"].(string),
-				"serviceAccountCertificate": configuration["ca_cert"].(string),
+				"apiToken":                  getSecret(d, "service_account.0.token"),
+				"serviceAccountCertificate": getSecret(d, "service_account.0.ca_cert"),
 			},
 			Scheme: converter.String("Token"),
 		}
@@ -376,6 +379,9 @@ func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *s
 			if v, ok := configuration["kube_config"]; ok {
 				kubeconfig["kube_config"] = v.(string)
 			}
+			if v, ok := configuration["kube_config_wo_version"]; ok {
+				kubeconfig["kube_config_wo_version"] = v.(int)
+			}
 
 			if serviceEndpoint.Data != nil {
 				if v, ok := (*serviceEndpoint.Data)["acceptUntrustedCerts"]; ok {
@@ -409,8 +415,10 @@ func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *s
 		} else {
 			configuration := serviceAccountSet[0].(map[string]interface{})
 			serviceAccount = map[string]interface{}{
-				"token":   configuration["token"].(string),
-				"ca_cert": configuration["ca_cert"].(string),
+				"token":              configuration["token"].(string),
+				"token_wo_version":   configuration["token_wo_version"].(int),
+				"ca_cert":            configuration["ca_cert"].(string),
+				"ca_cert_wo_version": configuration["ca_cert_wo_version"].(int),
 			}
 			if v, ok := (*serviceEndpoint.Data)["acceptUntrustedCerts"]; ok {
 				acceptUntrustedCerts, err := strconv.ParseBool(v)
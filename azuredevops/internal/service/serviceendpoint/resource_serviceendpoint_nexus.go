@@ -50,6 +50,7 @@ func ResourceServiceEndpointNexus() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "password")
 	return r
 }
 
@@ -112,7 +113,7 @@ func expandServiceEndpointNexus(d *schema.ResourceData) *serviceendpoint.Service
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"username": d.Get("username").(string),
-			"password": d.Get("password").(string),
+			"password": getSecret(d, "password"),
 		},
 		Scheme: converter.String("UsernamePassword"),
 	}
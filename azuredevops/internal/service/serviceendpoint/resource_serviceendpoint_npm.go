@@ -47,6 +47,7 @@ func ResourceServiceEndpointNpm() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "access_token")
 	return r
 }
 
@@ -105,7 +106,7 @@ func expandServiceEndpointNpm(d *schema.ResourceData) *serviceendpoint.ServiceEn
 	serviceEndpoint := doBaseExpansion(d)
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"apitoken": d.Get("access_token").(string),
+			"apitoken": getSecret(d, "access_token"),
 		},
 		Scheme: converter.String("Token"),
 	}
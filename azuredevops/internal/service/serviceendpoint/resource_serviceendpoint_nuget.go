@@ -25,8 +25,9 @@ func ResourceServiceEndpointNuGet() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
-		Schema:   baseSchema(),
+		Importer:      tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema:        baseSchema(),
+		CustomizeDiff: requireSecretWith("username", "password"),
 	}
 	maps.Copy(r.Schema, map[string]*schema.Schema{
 		"feed_url": {
@@ -40,8 +41,8 @@ func ResourceServiceEndpointNuGet() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringIsNotEmpty,
-			ConflictsWith: []string{"personal_access_token", "username", "password"},
-			AtLeastOneOf:  []string{"api_key", "personal_access_token", "username", "password"},
+			ConflictsWith: []string{"personal_access_token", "personal_access_token_wo", "username", "password", "password_wo"},
+			AtLeastOneOf:  []string{"api_key", "api_key_wo", "personal_access_token", "personal_access_token_wo", "username", "password", "password_wo"},
 		},
 
 		"personal_access_token": {
@@ -49,15 +50,14 @@ func ResourceServiceEndpointNuGet() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringIsNotEmpty,
-			ConflictsWith: []string{"api_key", "username", "password"},
+			ConflictsWith: []string{"api_key", "api_key_wo", "username", "password", "password_wo"},
 		},
 
 		"username": {
 			Type:          schema.TypeString,
 			Optional:      true,
 			ValidateFunc:  validation.StringIsNotEmpty,
-			ConflictsWith: []string{"personal_access_token", "api_key"},
-			RequiredWith:  []string{"password"},
+			ConflictsWith: []string{"personal_access_token", "personal_access_token_wo", "api_key", "api_key_wo"},
 		},
 
 		"password": {
@@ -65,11 +65,18 @@ func ResourceServiceEndpointNuGet() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringIsNotEmpty,
-			ConflictsWith: []string{"personal_access_token", "api_key"},
+			ConflictsWith: []string{"personal_access_token", "personal_access_token_wo", "api_key", "api_key_wo"},
 			RequiredWith:  []string{"username"},
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "api_key")
+	addWriteOnlySecret(r.Schema, "personal_access_token")
+	addWriteOnlySecret(r.Schema, "password")
+	r.Schema["api_key_wo"].ConflictsWith = []string{"api_key", "personal_access_token", "personal_access_token_wo", "username", "password", "password_wo"}
+	r.Schema["personal_access_token_wo"].ConflictsWith = []string{"personal_access_token", "api_key", "api_key_wo", "username", "password", "password_wo"}
+	r.Schema["password_wo"].ConflictsWith = []string{"password", "personal_access_token", "personal_access_token_wo", "api_key", "api_key_wo"}
+	r.Schema["password_wo"].RequiredWith = []string{"username"}
 	return r
 }
 
@@ -127,20 +134,20 @@ func expandServiceEndpointNuGet(d *schema.ResourceData) *serviceendpoint.Service
 	serviceEndpoint := doBaseExpansion(d)
 	serviceEndpoint.Type = converter.String("externalnugetfeed")
 	serviceEndpoint.Url = converter.String(d.Get("feed_url").(string))
-	if apiKey := d.Get("api_key"); apiKey != "" {
+	if apiKey := getSecret(d, "api_key"); apiKey != "" {
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
-				"nugetkey": apiKey.(string),
+				"nugetkey": apiKey,
 			},
 			Scheme: converter.String("None"),
 		}
 	}
 
-	if pat := d.Get("personal_access_token"); pat != "" {
+	if pat := getSecret(d, "personal_access_token"); pat != "" {
 		serviceEndpoint.Type = converter.String("externalnugetfeed")
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
-				"apitoken": pat.(string),
+				"apitoken": pat,
 			},
 			Scheme: converter.String("Token"),
 		}
@@ -151,7 +158,7 @@ func expandServiceEndpointNuGet(d *schema.ResourceData) *serviceendpoint.Service
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
 				"username": uname.(string),
-				"password": d.Get("password").(string),
+				"password": getSecret(d, "password"),
 			},
 			Scheme: converter.String("UsernamePassword"),
 		}
@@ -50,6 +50,7 @@ func ResourceServiceEndpointOctopusDeploy() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "api_key")
 	return r
 }
 
@@ -107,7 +108,7 @@ func expandServiceEndpointOctopusDeploy(d *schema.ResourceData) *serviceendpoint
 	serviceEndpoint := doBaseExpansion(d)
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"apitoken": d.Get("api_key").(string),
+			"apitoken": getSecret(d, "api_key"),
 		},
 		Scheme: converter.String("Token"),
 	}
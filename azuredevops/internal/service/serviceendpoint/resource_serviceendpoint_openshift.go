@@ -108,6 +108,8 @@ func ResourceServiceEndpointOpenshift() *schema.Resource {
 			},
 		},
 	})
+	addWriteOnlySecret(r.Schema, "auth_basic.0.password")
+	addWriteOnlySecret(r.Schema, "auth_token.0.token")
 	return r
 }
 
@@ -177,7 +179,7 @@ func expandServiceEndpointOpenshift(d *schema.ResourceData) *serviceendpoint.Ser
 		val := config.([]interface{})[0].(map[string]interface{})
 		params = map[string]string{
 			"username":             val["username"].(string),
-			"password":             val["password"].(string),
+			"password":             getSecret(d, "auth_basic.0.password"),
 			"acceptUntrustedCerts": "false",
 		}
 
@@ -194,12 +196,10 @@ func expandServiceEndpointOpenshift(d *schema.ResourceData) *serviceendpoint.Ser
 		}
 	}
 
-	if config, ok := d.GetOk("auth_token"); ok {
+	if _, ok := d.GetOk("auth_token"); ok {
 		authType = "Token"
-		val := config.([]interface{})[0].(map[string]interface{})
-
 		params = map[string]string{
-			"apitoken":             val["token"].(string),
+			"apitoken":             getSecret(d, "auth_token.0.token"),
 			"acceptUntrustedCerts": "false",
 		}
 
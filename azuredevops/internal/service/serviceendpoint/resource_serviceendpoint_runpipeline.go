@@ -57,6 +57,7 @@ func ResourceServiceEndpointRunPipeline() *schema.Resource {
 		},
 	})
 
+	addWriteOnlyAuthPersonal(r.Schema)
 	return r
 }
 
@@ -117,6 +118,9 @@ func expandServiceEndpointRunPipeline(d *schema.ResourceData) *serviceendpoint.S
 
 	scheme := "Token"
 	parameters := rpExpandAuthPersonalSet(d.Get("auth_personal").(*schema.Set))
+	if token, ok := getWriteOnlySecret(d, "personal_access_token_wo"); ok {
+		parameters["apitoken"] = token
+	}
 
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &parameters,
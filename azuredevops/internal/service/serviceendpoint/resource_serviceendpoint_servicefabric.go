@@ -155,6 +155,9 @@ func ResourceServiceEndpointServiceFabric() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "certificate.0.client_certificate")
+	addWriteOnlySecret(r.Schema, "certificate.0.client_certificate_password")
+	addWriteOnlySecret(r.Schema, "azure_active_directory.0.password")
 	return r
 }
 
@@ -229,8 +232,8 @@ func expandServiceEndpointServiceFabric(d *schema.ResourceData) (*serviceendpoin
 	if certificateOk {
 		configuration := certificate.([]interface{})[0].(map[string]interface{})
 		parameters := expandServiceEndpointServiceFabricServerCertificateLookup(configuration)
-		parameters["certificate"] = configuration["client_certificate"].(string)
-		parameters["certificatepassword"] = configuration["client_certificate_password"].(string)
+		parameters["certificate"] = getSecret(d, "certificate.0.client_certificate")
+		parameters["certificatepassword"] = getSecret(d, "certificate.0.client_certificate_password")
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &parameters,
 			Scheme:     converter.String("Certificate"),
@@ -243,7 +246,7 @@ func expandServiceEndpointServiceFabric(d *schema.ResourceData) (*serviceendpoin
 		configuration := azureActiveDirectory.([]interface{})[0].(map[string]interface{})
 		parameters := expandServiceEndpointServiceFabricServerCertificateLookup(configuration)
 		parameters["username"] = configuration["username"].(string)
-		parameters["password"] = configuration["password"].(string)
+		parameters["password"] = getSecret(d, "azure_active_directory.0.password")
 		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 			Parameters: &parameters,
 			Scheme:     converter.String("UsernamePassword"),
@@ -286,11 +289,13 @@ func flattenServiceFabricCertificate(d *schema.ResourceData, serviceEndpoint *se
 	result := flattenServiceEndpointServiceFabricServerCertificateLookup(serviceEndpoint)
 	if certificate, ok := d.GetOk("certificate"); ok {
 		configuration := certificate.([]interface{})[0].(map[string]interface{})
-		if v, ok := configuration["client_certificate"]; ok {
-			result[0]["client_certificate"] = v.(string)
-		}
-		if v, ok := configuration["client_certificate_password"]; ok {
-			result[0]["client_certificate_password"] = v.(string)
+		for _, key := range []string{"client_certificate", "client_certificate_password"} {
+			if v, ok := configuration[key]; ok {
+				result[0][key] = v.(string)
+			}
+			if v, ok := configuration[key+"_wo_version"]; ok {
+				result[0][key+"_wo_version"] = v.(int)
+			}
 		}
 	}
 
@@ -305,6 +310,9 @@ func flattenServiceFabricAzureActiveDirectory(d *schema.ResourceData, serviceEnd
 		if v, ok := configuration["password"]; ok {
 			result[0]["password"] = v.(string)
 		}
+		if v, ok := configuration["password_wo_version"]; ok {
+			result[0]["password_wo_version"] = v.(int)
+		}
 	}
 	return result
 }
@@ -0,0 +1,286 @@
+package serviceendpoint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServiceEndpointShare schema and implementation for sharing a service endpoint with another project
+func ResourceServiceEndpointShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceEndpointShareCreate,
+		ReadContext:   resourceServiceEndpointShareRead,
+		UpdateContext: resourceServiceEndpointShareUpdate,
+		DeleteContext: resourceServiceEndpointShareDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importServiceEndpointShare,
+		},
+		Schema: map[string]*schema.Schema{
+			"service_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"owner_project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+		},
+	}
+}
+
+func resourceServiceEndpointShareCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectId, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return diag.Errorf(" Parsing project ID: %+v", err)
+	}
+	ownerProjectId, err := uuid.Parse(d.Get("owner_project_id").(string))
+	if err != nil {
+		return diag.Errorf(" Parsing owner project ID: %+v", err)
+	}
+	serviceEndpointId, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return diag.Errorf(" Parsing service endpoint ID: %+v", err)
+	}
+
+	if err := checkServiceEndpointOwner(ctx, clients, ownerProjectId, projectId, serviceEndpointId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Sharing into a project the endpoint is already available in would hand the existing reference
+	// over to this resource, which removes it on destroy.
+	existing, err := getServiceEndpointProjectReference(ctx, clients, projectId, serviceEndpointId)
+	if err != nil {
+		return diag.Errorf(" Checking whether service endpoint %s is available in project %s: %+v", serviceEndpointId, projectId, err)
+	}
+	if existing != nil {
+		return diag.Errorf(" Service endpoint %s is already available in project %s. Import the existing share with ID %s/%s/%s instead.", serviceEndpointId, projectId, ownerProjectId, projectId, serviceEndpointId)
+	}
+
+	if err := shareServiceEndpoint(ctx, clients, d, projectId, serviceEndpointId); err != nil {
+		return diag.Errorf(" Sharing service endpoint %s with project %s: %+v", serviceEndpointId, projectId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectId, serviceEndpointId))
+	return resourceServiceEndpointShareRead(ctx, d, m)
+}
+
+func resourceServiceEndpointShareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectId, serviceEndpointId, err := parseServiceEndpointShareId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Destroying a share of the owning project would delete the service endpoint itself
+	if strings.EqualFold(d.Get("owner_project_id").(string), projectId.String()) {
+		return diag.Errorf(" Project %s owns service endpoint %s, only shares with other projects can be managed.", projectId, serviceEndpointId)
+	}
+
+	reference, err := getServiceEndpointProjectReference(ctx, clients, projectId, serviceEndpointId)
+	if err != nil {
+		return diag.Errorf(" Reading share of service endpoint %s with project %s: %+v", serviceEndpointId, projectId, err)
+	}
+	if reference == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project_id", projectId.String())
+	d.Set("service_endpoint_id", serviceEndpointId.String())
+	d.Set("name", converter.ToString(reference.Name, ""))
+	d.Set("description", converter.ToString(reference.Description, ""))
+	return nil
+}
+
+func resourceServiceEndpointShareUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectId, serviceEndpointId, err := parseServiceEndpointShareId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := shareServiceEndpoint(ctx, clients, d, projectId, serviceEndpointId); err != nil {
+		return diag.Errorf(" Updating share of service endpoint %s with project %s: %+v", serviceEndpointId, projectId, err)
+	}
+	return resourceServiceEndpointShareRead(ctx, d, m)
+}
+
+func resourceServiceEndpointShareDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectId, serviceEndpointId, err := parseServiceEndpointShareId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting the endpoint from the owning project would delete the endpoint itself
+	ownerProjectId := d.Get("owner_project_id").(string)
+	if ownerProjectId == "" || strings.EqualFold(ownerProjectId, projectId.String()) {
+		return diag.Errorf(" Project %s owns service endpoint %s, refusing to remove the service endpoint from it.", projectId, serviceEndpointId)
+	}
+
+	// Deleting the endpoint from the shared project only removes the project reference, the endpoint
+	// stays available in the owning project.
+	err = clients.ServiceEndpointClient.DeleteServiceEndpoint(ctx, serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: &serviceEndpointId,
+		ProjectIds: &[]string{projectId.String()},
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Removing share of service endpoint %s with project %s: %+v", serviceEndpointId, projectId, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func importServiceEndpointShare(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	// The owning project cannot be derived from the service endpoint, therefore it is part of the import ID
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected ID format %q, expected <ownerProjectID>/<projectID>/<serviceEndpointID>", d.Id())
+	}
+	ownerProjectId, err := uuid.Parse(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("owner project ID was expected to be a UUID, but was not: %+v", err)
+	}
+	projectId, serviceEndpointId, err := parseServiceEndpointShareId(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkServiceEndpointOwner(ctx, clients, ownerProjectId, projectId, serviceEndpointId); err != nil {
+		return nil, err
+	}
+
+	reference, err := getServiceEndpointProjectReference(ctx, clients, projectId, serviceEndpointId)
+	if err != nil {
+		return nil, fmt.Errorf(" Reading share of service endpoint %s with project %s: %+v", serviceEndpointId, projectId, err)
+	}
+	if reference == nil {
+		return nil, fmt.Errorf(" Service endpoint %s is not shared with project %s", serviceEndpointId, projectId)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectId, serviceEndpointId))
+	d.Set("project_id", projectId.String())
+	d.Set("owner_project_id", ownerProjectId.String())
+	d.Set("service_endpoint_id", serviceEndpointId.String())
+	return []*schema.ResourceData{d}, nil
+}
+
+// checkServiceEndpointOwner verifies that the service endpoint is available in the owning project and that the
+// project to share it with is a different one
+func checkServiceEndpointOwner(ctx context.Context, clients *client.AggregatedClient, ownerProjectId uuid.UUID, projectId uuid.UUID, serviceEndpointId uuid.UUID) error {
+	if ownerProjectId == projectId {
+		return fmt.Errorf(" Project %s owns service endpoint %s, only shares with other projects can be managed", projectId, serviceEndpointId)
+	}
+
+	owner, err := getServiceEndpointProjectReference(ctx, clients, ownerProjectId, serviceEndpointId)
+	if err != nil {
+		return fmt.Errorf(" Reading service endpoint %s in owning project %s: %+v", serviceEndpointId, ownerProjectId, err)
+	}
+	if owner == nil {
+		return fmt.Errorf(" Service endpoint %s does not exist in owning project %s", serviceEndpointId, ownerProjectId)
+	}
+	return nil
+}
+
+func shareServiceEndpoint(ctx context.Context, clients *client.AggregatedClient, d *schema.ResourceData, projectId uuid.UUID, serviceEndpointId uuid.UUID) error {
+	return clients.ServiceEndpointClient.ShareServiceEndpoint(ctx, serviceendpoint.ShareServiceEndpointArgs{
+		EndpointId: &serviceEndpointId,
+		EndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: &projectId,
+				},
+				Name:        converter.String(d.Get("name").(string)),
+				Description: converter.String(d.Get("description").(string)),
+			},
+		},
+	})
+}
+
+// getServiceEndpointProjectReference returns the reference of the service endpoint in the project, nil if the
+// endpoint is not available in the project
+func getServiceEndpointProjectReference(ctx context.Context, clients *client.AggregatedClient, projectId uuid.UUID, serviceEndpointId uuid.UUID) (*serviceendpoint.ServiceEndpointProjectReference, error) {
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		Project:    converter.String(projectId.String()),
+		EndpointId: &serviceEndpointId,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil || serviceEndpoint.ServiceEndpointProjectReferences == nil {
+		return nil, nil
+	}
+
+	for _, reference := range *serviceEndpoint.ServiceEndpointProjectReferences {
+		if reference.ProjectReference != nil && reference.ProjectReference.Id != nil &&
+			strings.EqualFold(reference.ProjectReference.Id.String(), projectId.String()) {
+			return &reference, nil
+		}
+	}
+	return nil, nil
+}
+
+func parseServiceEndpointShareId(id string) (uuid.UUID, uuid.UUID, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unexpected ID format %q, expected <projectID>/<serviceEndpointID>", id)
+	}
+	projectId, err := uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("project ID was expected to be a UUID, but was not: %+v", err)
+	}
+	serviceEndpointId, err := uuid.Parse(parts[1])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("service endpoint ID was expected to be a UUID, but was not: %+v", err)
+	}
+	return projectId, serviceEndpointId, nil
+}
@@ -0,0 +1,315 @@
+//go:build (all || resource_serviceendpoint_share) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_share
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	shareTestOwnerProjectId  = uuid.New()
+	shareTestTargetProjectId = uuid.New()
+	shareTestServiceEndpoint = uuid.New()
+)
+
+func shareTestResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, nil)
+	resourceData.Set("project_id", shareTestTargetProjectId.String())
+	resourceData.Set("owner_project_id", shareTestOwnerProjectId.String())
+	resourceData.Set("service_endpoint_id", shareTestServiceEndpoint.String())
+	resourceData.Set("name", "shared-connection")
+	resourceData.Set("description", "Shared from the hub project")
+	return resourceData
+}
+
+func shareTestEndpoint(references ...uuid.UUID) *serviceendpoint.ServiceEndpoint {
+	projectReferences := []serviceendpoint.ServiceEndpointProjectReference{}
+	for _, id := range references {
+		projectReferences = append(projectReferences, serviceendpoint.ServiceEndpointProjectReference{
+			ProjectReference: &serviceendpoint.ProjectReference{Id: converter.UUID(id.String())},
+			Name:             converter.String("shared-connection"),
+			Description:      converter.String("Shared from the hub project"),
+		})
+	}
+	return &serviceendpoint.ServiceEndpoint{
+		Id:                               &shareTestServiceEndpoint,
+		Name:                             converter.String("hub-connection"),
+		ServiceEndpointProjectReferences: &projectReferences,
+	}
+}
+
+func TestServiceEndpointShare_Create_SharesWithProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	getArgs := serviceendpoint.GetServiceEndpointDetailsArgs{
+		Project:    converter.String(shareTestTargetProjectId.String()),
+		EndpointId: &shareTestServiceEndpoint,
+	}
+	gomock.InOrder(
+		serviceEndpointClient.EXPECT().GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			Project:    converter.String(shareTestOwnerProjectId.String()),
+			EndpointId: &shareTestServiceEndpoint,
+		}).Return(shareTestEndpoint(shareTestOwnerProjectId), nil).Times(1),
+		serviceEndpointClient.EXPECT().GetServiceEndpointDetails(clients.Ctx, getArgs).
+			Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).Times(1),
+		serviceEndpointClient.EXPECT().ShareServiceEndpoint(clients.Ctx, serviceendpoint.ShareServiceEndpointArgs{
+			EndpointId: &shareTestServiceEndpoint,
+			EndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+				{
+					ProjectReference: &serviceendpoint.ProjectReference{Id: &shareTestTargetProjectId},
+					Name:             converter.String("shared-connection"),
+					Description:      converter.String("Shared from the hub project"),
+				},
+			},
+		}).Return(nil).Times(1),
+		serviceEndpointClient.EXPECT().GetServiceEndpointDetails(clients.Ctx, getArgs).
+			Return(shareTestEndpoint(shareTestOwnerProjectId, shareTestTargetProjectId), nil).Times(1),
+	)
+
+	resourceData := shareTestResourceData(t)
+	diags := resourceServiceEndpointShareCreate(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, fmt.Sprintf("%s/%s", shareTestTargetProjectId, shareTestServiceEndpoint), resourceData.Id())
+	require.Equal(t, "shared-connection", resourceData.Get("name"))
+}
+
+func TestServiceEndpointShare_Create_RefusesProjectWithExistingReference(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.EXPECT().GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(shareTestEndpoint(shareTestOwnerProjectId, shareTestTargetProjectId), nil).Times(2)
+	serviceEndpointClient.EXPECT().ShareServiceEndpoint(gomock.Any(), gomock.Any()).Times(0)
+
+	diags := resourceServiceEndpointShareCreate(clients.Ctx, shareTestResourceData(t), clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "is already available in project")
+}
+
+func TestServiceEndpointShare_Create_RefusesOwningProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.EXPECT().GetServiceEndpointDetails(gomock.Any(), gomock.Any()).Times(0)
+	serviceEndpointClient.EXPECT().ShareServiceEndpoint(gomock.Any(), gomock.Any()).Times(0)
+
+	resourceData := shareTestResourceData(t)
+	resourceData.Set("project_id", shareTestOwnerProjectId.String())
+	diags := resourceServiceEndpointShareCreate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "owns service endpoint")
+}
+
+func TestServiceEndpointShare_Create_RefusesEndpointMissingInOwningProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.EXPECT().GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		Project:    converter.String(shareTestOwnerProjectId.String()),
+		EndpointId: &shareTestServiceEndpoint,
+	}).Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).Times(1)
+	serviceEndpointClient.EXPECT().ShareServiceEndpoint(gomock.Any(), gomock.Any()).Times(0)
+
+	diags := resourceServiceEndpointShareCreate(clients.Ctx, shareTestResourceData(t), clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "does not exist in owning project")
+}
+
+func TestServiceEndpointShare_Create_InvalidProjectIdReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	resourceData := shareTestResourceData(t)
+	resourceData.Set("project_id", "not-a-uuid")
+	diags := resourceServiceEndpointShareCreate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "Parsing project ID")
+}
+
+func TestServiceEndpointShare_Read_RemovedShareClearsId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.EXPECT().GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&serviceendpoint.ServiceEndpoint{}, nil).Times(1)
+
+	resourceData := shareTestResourceData(t)
+	resourceData.SetId(fmt.Sprintf("%s/%s", shareTestTargetProjectId, shareTestServiceEndpoint))
+	diags := resourceServiceEndpointShareRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Empty(t, resourceData.Id())
+}
+
+func TestServiceEndpointShare_Read_RefusesOwningProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.EXPECT().GetServiceEndpointDetails(gomock.Any(), gomock.Any()).Times(0)
+
+	resourceData := shareTestResourceData(t)
+	resourceData.Set("project_id", shareTestOwnerProjectId.String())
+	resourceData.SetId(fmt.Sprintf("%s/%s", shareTestOwnerProjectId, shareTestServiceEndpoint))
+	diags := resourceServiceEndpointShareRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "owns service endpoint")
+}
+
+func TestServiceEndpointShare_Import(t *testing.T) {
+	tests := []struct {
+		name           string
+		ownerProjectId uuid.UUID
+		projectId      uuid.UUID
+		ownerEndpoint  *serviceendpoint.ServiceEndpoint
+		endpoint       *serviceendpoint.ServiceEndpoint
+		expectedError  string
+	}{
+		{
+			name:           "shared project",
+			ownerProjectId: shareTestOwnerProjectId,
+			projectId:      shareTestTargetProjectId,
+			ownerEndpoint:  shareTestEndpoint(shareTestOwnerProjectId, shareTestTargetProjectId),
+			endpoint:       shareTestEndpoint(shareTestOwnerProjectId, shareTestTargetProjectId),
+		},
+		{
+			name:           "owning project",
+			ownerProjectId: shareTestOwnerProjectId,
+			projectId:      shareTestOwnerProjectId,
+			expectedError:  "owns service endpoint",
+		},
+		{
+			name:           "not in owning project",
+			ownerProjectId: shareTestOwnerProjectId,
+			projectId:      shareTestTargetProjectId,
+			ownerEndpoint:  shareTestEndpoint(shareTestTargetProjectId),
+			expectedError:  "does not exist in owning project",
+		},
+		{
+			name:           "not shared",
+			ownerProjectId: shareTestOwnerProjectId,
+			projectId:      shareTestTargetProjectId,
+			ownerEndpoint:  shareTestEndpoint(shareTestOwnerProjectId),
+			endpoint:       shareTestEndpoint(shareTestOwnerProjectId),
+			expectedError:  "is not shared with project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+			clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+			if tt.ownerEndpoint != nil {
+				serviceEndpointClient.EXPECT().GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+					Project:    converter.String(tt.ownerProjectId.String()),
+					EndpointId: &shareTestServiceEndpoint,
+				}).Return(tt.ownerEndpoint, nil).Times(1)
+			}
+			if tt.endpoint != nil {
+				serviceEndpointClient.EXPECT().GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+					Project:    converter.String(tt.projectId.String()),
+					EndpointId: &shareTestServiceEndpoint,
+				}).Return(tt.endpoint, nil).Times(1)
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, nil)
+			resourceData.SetId(fmt.Sprintf("%s/%s/%s", tt.ownerProjectId, tt.projectId, shareTestServiceEndpoint))
+			imported, err := importServiceEndpointShare(clients.Ctx, resourceData, clients)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, imported, 1)
+			require.Equal(t, fmt.Sprintf("%s/%s", tt.projectId, shareTestServiceEndpoint), imported[0].Id())
+			require.Equal(t, tt.projectId.String(), imported[0].Get("project_id"))
+			require.Equal(t, tt.ownerProjectId.String(), imported[0].Get("owner_project_id"))
+			require.Equal(t, shareTestServiceEndpoint.String(), imported[0].Get("service_endpoint_id"))
+		})
+	}
+}
+
+func TestServiceEndpointShare_Delete_OnlyRemovesTargetProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.EXPECT().DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: &shareTestServiceEndpoint,
+		ProjectIds: &[]string{shareTestTargetProjectId.String()},
+	}).Return(errors.New("DeleteServiceEndpoint() Failed")).Times(1)
+
+	resourceData := shareTestResourceData(t)
+	resourceData.SetId(fmt.Sprintf("%s/%s", shareTestTargetProjectId, shareTestServiceEndpoint))
+	diags := resourceServiceEndpointShareDelete(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "DeleteServiceEndpoint() Failed")
+}
+
+func TestServiceEndpointShare_Delete_RefusesOwningProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.EXPECT().DeleteServiceEndpoint(gomock.Any(), gomock.Any()).Times(0)
+
+	resourceData := shareTestResourceData(t)
+	resourceData.Set("project_id", shareTestOwnerProjectId.String())
+	resourceData.SetId(fmt.Sprintf("%s/%s", shareTestOwnerProjectId, shareTestServiceEndpoint))
+	diags := resourceServiceEndpointShareDelete(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "owns service endpoint")
+}
+
+func TestServiceEndpointShare_ParseId(t *testing.T) {
+	projectId, serviceEndpointId, err := parseServiceEndpointShareId(fmt.Sprintf("%s/%s", shareTestTargetProjectId, shareTestServiceEndpoint))
+	require.NoError(t, err)
+	require.Equal(t, shareTestTargetProjectId, projectId)
+	require.Equal(t, shareTestServiceEndpoint, serviceEndpointId)
+
+	_, _, err = parseServiceEndpointShareId(shareTestServiceEndpoint.String())
+	require.Error(t, err)
+}
@@ -43,6 +43,7 @@ func ResourceServiceEndpointSnyk() *schema.Resource {
 			ValidateFunc: validation.IsUUID,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "api_token")
 	return r
 }
 
@@ -100,7 +101,7 @@ func expandServiceEndpointSnyk(d *schema.ResourceData) *serviceendpoint.ServiceE
 	serviceEndpoint := doBaseExpansion(d)
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
-			"apitoken": d.Get("api_token").(string),
+			"apitoken": getSecret(d, "api_token"),
 		},
 		Scheme: converter.String("Token"),
 	}
@@ -39,6 +39,7 @@ func ResourceServiceEndpointSonarCloud() *schema.Resource {
 			Description:  "Authentication Token generated through SonarCloud (go to My Account > Security > Generate Tokens)",
 		},
 	})
+	addWriteOnlySecret(r.Schema, "token")
 	return r
 }
 
@@ -98,7 +99,7 @@ func expandServiceEndpointSonarCloud(d *schema.ResourceData) *serviceendpoint.Se
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Scheme: converter.String("Token"),
 		Parameters: &map[string]string{
-			"apitoken": d.Get("token").(string),
+			"apitoken": getSecret(d, "token"),
 		},
 	}
 	serviceEndpoint.Type = converter.String("sonarcloud")
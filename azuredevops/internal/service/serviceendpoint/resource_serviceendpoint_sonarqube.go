@@ -47,6 +47,7 @@ func ResourceServiceEndpointSonarQube() *schema.Resource {
 		},
 	})
 
+	addWriteOnlySecret(r.Schema, "token")
 	return r
 }
 
@@ -111,7 +112,7 @@ func expandServiceEndpointSonarQube(d *schema.ResourceData) *serviceendpoint.Ser
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Scheme: converter.String("UsernamePassword"),
 		Parameters: &map[string]string{
-			"username": d.Get("token").(string),
+			"username": getSecret(d, "token"),
 		},
 	}
 	serviceEndpoint.Type = converter.String("sonarqube")
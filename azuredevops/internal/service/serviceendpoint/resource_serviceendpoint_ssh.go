@@ -65,6 +65,8 @@ func ResourceServiceEndpointSSH() *schema.Resource {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 	})
+	addWriteOnlySecret(r.Schema, "password")
+	addWriteOnlySecret(r.Schema, "private_key")
 	return r
 }
 
@@ -125,8 +127,8 @@ func expandServiceEndpointSSH(d *schema.ResourceData) *serviceendpoint.ServiceEn
 	serviceEndpoint.Type = converter.String("ssh")
 	parameters := map[string]string{}
 	parameters["username"] = d.Get("username").(string)
-	if pwd, ok := d.GetOk("password"); ok {
-		parameters["password"] = pwd.(string)
+	if pwd := getSecret(d, "password"); pwd != "" {
+		parameters["password"] = pwd
 	}
 	serviceEndpoint.Authorization.Parameters = &parameters
 
@@ -135,8 +137,8 @@ func expandServiceEndpointSSH(d *schema.ResourceData) *serviceendpoint.ServiceEn
 	if port, ok := d.GetOk("port"); ok {
 		data["Port"] = strconv.Itoa(port.(int))
 	}
-	if privateKey, ok := d.GetOk("private_key"); ok {
-		data["PrivateKey"] = privateKey.(string)
+	if privateKey := getSecret(d, "private_key"); privateKey != "" {
+		data["PrivateKey"] = privateKey
 	}
 	serviceEndpoint.Data = &data
 
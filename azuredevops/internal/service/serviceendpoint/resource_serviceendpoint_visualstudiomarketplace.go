@@ -2,7 +2,6 @@ package serviceendpoint
 
 import (
 	"context"
-	"errors"
 	"maps"
 	"strings"
 	"time"
@@ -77,6 +76,8 @@ func ResourceServiceEndpointMarketplace() *schema.Resource {
 			},
 		},
 	})
+	addWriteOnlySecret(r.Schema, "authentication_token.0.token")
+	addWriteOnlySecret(r.Schema, "authentication_basic.0.password")
 	return r
 }
 
@@ -153,13 +154,9 @@ func expandServiceEndpointMarketplace(d *schema.ResourceData) (*serviceendpoint.
 	authScheme := "Token"
 
 	authParams := make(map[string]string)
-	if tokenAuth, ok := d.GetOk("authentication_token"); ok {
+	if _, ok := d.GetOk("authentication_token"); ok {
 		authScheme = "Token"
-		token := tokenAuth.([]interface{})[0].(map[string]interface{})
-		authParams["apitoken"], ok = token["token"].(string)
-		if !ok {
-			return nil, errors.New(" unable to get `apitoken`.")
-		}
+		authParams["apitoken"] = getSecret(d, "authentication_token.0.token")
 	} else if basicAuth, ok := d.GetOk("authentication_basic"); ok {
 		authScheme = "UsernamePassword"
 		unamePwd := basicAuth.([]interface{})[0].(map[string]interface{})
@@ -167,9 +164,7 @@ func expandServiceEndpointMarketplace(d *schema.ResourceData) (*serviceendpoint.
 			authParams["username"] = v
 		}
 
-		if v, exist := unamePwd["password"].(string); exist {
-			authParams["password"] = v
-		}
+		authParams["password"] = getSecret(d, "authentication_basic.0.password")
 	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &authParams,
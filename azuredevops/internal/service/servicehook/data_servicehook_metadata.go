@@ -0,0 +1,274 @@
+package servicehook
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServicehookMetadata schema and implementation for the service hook publishers, event types and consumers data source
+func DataServicehookMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServicehookMetadataRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"publisher_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"publishers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"inputs": dataSourceInputDescriptorsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inputs": dataSourceInputDescriptorsSchema(),
+						"actions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"supported_event_types": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"inputs": dataSourceInputDescriptorsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInputDescriptorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"required": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"confidential": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"default_value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"allowed_values": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServicehookMetadataRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	publisherId := d.Get("publisher_id").(string)
+	publishers, err := clients.ServiceHooksClient.ListPublishers(ctx, servicehooks.ListPublishersArgs{})
+	if err != nil {
+		return diag.Errorf(" Listing service hook publishers: %+v", err)
+	}
+
+	consumersArgs := servicehooks.ListConsumersArgs{}
+	if publisherId != "" {
+		consumersArgs.PublisherId = converter.String(publisherId)
+	}
+	consumers, err := clients.ServiceHooksClient.ListConsumers(ctx, consumersArgs)
+	if err != nil {
+		return diag.Errorf(" Listing service hook consumers: %+v", err)
+	}
+
+	d.SetId("servicehook-metadata-" + uuid.New().String())
+	if err := d.Set("publishers", flattenServicehookPublishers(publishers, publisherId)); err != nil {
+		return diag.Errorf(" Setting publishers: %+v", err)
+	}
+	if err := d.Set("consumers", flattenServicehookConsumers(consumers)); err != nil {
+		return diag.Errorf(" Setting consumers: %+v", err)
+	}
+	return nil
+}
+
+func flattenServicehookPublishers(publishers *[]servicehooks.Publisher, publisherId string) []interface{} {
+	if publishers == nil {
+		return []interface{}{}
+	}
+	results := make([]interface{}, 0, len(*publishers))
+	for _, publisher := range *publishers {
+		if publisherId != "" && converter.ToString(publisher.Id, "") != publisherId {
+			continue
+		}
+		eventTypes := []interface{}{}
+		if publisher.SupportedEvents != nil {
+			for _, eventType := range *publisher.SupportedEvents {
+				eventTypes = append(eventTypes, map[string]interface{}{
+					"id":          converter.ToString(eventType.Id, ""),
+					"name":        converter.ToString(eventType.Name, ""),
+					"description": converter.ToString(eventType.Description, ""),
+					"inputs":      flattenInputDescriptors(eventType.InputDescriptors),
+				})
+			}
+		}
+		results = append(results, map[string]interface{}{
+			"id":          converter.ToString(publisher.Id, ""),
+			"name":        converter.ToString(publisher.Name, ""),
+			"description": converter.ToString(publisher.Description, ""),
+			"event_types": eventTypes,
+		})
+	}
+	return results
+}
+
+func flattenServicehookConsumers(consumers *[]servicehooks.Consumer) []interface{} {
+	if consumers == nil {
+		return []interface{}{}
+	}
+	results := make([]interface{}, 0, len(*consumers))
+	for _, consumer := range *consumers {
+		actions := []interface{}{}
+		if consumer.Actions != nil {
+			for _, action := range *consumer.Actions {
+				var supportedEventTypes []string
+				if action.SupportedEventTypes != nil {
+					supportedEventTypes = *action.SupportedEventTypes
+				}
+				actions = append(actions, map[string]interface{}{
+					"id":                    converter.ToString(action.Id, ""),
+					"name":                  converter.ToString(action.Name, ""),
+					"description":           converter.ToString(action.Description, ""),
+					"supported_event_types": supportedEventTypes,
+					"inputs":                flattenInputDescriptors(action.InputDescriptors),
+				})
+			}
+		}
+		results = append(results, map[string]interface{}{
+			"id":          converter.ToString(consumer.Id, ""),
+			"name":        converter.ToString(consumer.Name, ""),
+			"description": converter.ToString(consumer.Description, ""),
+			"inputs":      flattenInputDescriptors(consumer.InputDescriptors),
+			"actions":     actions,
+		})
+	}
+	return results
+}
+
+func flattenInputDescriptors(descriptors *[]forminput.InputDescriptor) []interface{} {
+	if descriptors == nil {
+		return []interface{}{}
+	}
+	results := make([]interface{}, 0, len(*descriptors))
+	for _, descriptor := range *descriptors {
+		defaultValue := ""
+		if descriptor.Values != nil {
+			defaultValue = converter.ToString(descriptor.Values.DefaultValue, "")
+		}
+		results = append(results, map[string]interface{}{
+			"id":             converter.ToString(descriptor.Id, ""),
+			"name":           converter.ToString(descriptor.Name, ""),
+			"description":    converter.ToString(descriptor.Description, ""),
+			"required":       descriptor.Validation != nil && converter.ToBool(descriptor.Validation.IsRequired, false),
+			"confidential":   converter.ToBool(descriptor.IsConfidential, false),
+			"default_value":  defaultValue,
+			"allowed_values": allowedInputValues(descriptor),
+		})
+	}
+	return results
+}
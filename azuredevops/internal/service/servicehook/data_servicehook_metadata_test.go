@@ -0,0 +1,132 @@
+//go:build (all || data_sources || data_servicehook_metadata) && (!exclude_data_sources || !exclude_data_servicehook_metadata)
+// +build all data_sources data_servicehook_metadata
+// +build !exclude_data_sources !exclude_data_servicehook_metadata
+
+package servicehook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testServicehookPublishers = []servicehooks.Publisher{
+	{
+		Id:   converter.String("tfs"),
+		Name: converter.String("Azure DevOps Services"),
+		SupportedEvents: &[]servicehooks.EventTypeDescriptor{
+			{
+				Id:   converter.String("git.push"),
+				Name: converter.String("Code pushed"),
+				InputDescriptors: &[]forminput.InputDescriptor{
+					{
+						Id:         converter.String("repository"),
+						Validation: &forminput.InputValidation{IsRequired: converter.Bool(false)},
+					},
+				},
+			},
+		},
+	},
+	{
+		Id:   converter.String("pipelines"),
+		Name: converter.String("Azure Pipelines"),
+	},
+}
+
+var testServicehookConsumers = []servicehooks.Consumer{
+	{
+		Id:   converter.String("webHooks"),
+		Name: converter.String("Web Hooks"),
+		InputDescriptors: &[]forminput.InputDescriptor{
+			{
+				Id:             converter.String("url"),
+				IsConfidential: converter.Bool(false),
+				Validation:     &forminput.InputValidation{IsRequired: converter.Bool(true)},
+			},
+		},
+		Actions: &[]servicehooks.ConsumerAction{
+			{
+				Id:                  converter.String("httpRequest"),
+				SupportedEventTypes: &[]string{"git.push"},
+				InputDescriptors: &[]forminput.InputDescriptor{
+					{
+						Id: converter.String("messagesToSend"),
+						Values: &forminput.InputValues{
+							DefaultValue:              converter.String("all"),
+							IsLimitedToPossibleValues: converter.Bool(true),
+							PossibleValues: &[]forminput.InputValue{
+								{Value: converter.String("all")},
+								{Value: converter.String("none")},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestDataSourceServicehookMetadata_Read_FiltersPublishers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		ListPublishers(clients.Ctx, servicehooks.ListPublishersArgs{}).
+		Return(&testServicehookPublishers, nil).
+		Times(1)
+	mockClient.
+		EXPECT().
+		ListConsumers(clients.Ctx, servicehooks.ListConsumersArgs{PublisherId: converter.String("tfs")}).
+		Return(&testServicehookConsumers, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicehookMetadata().Schema, map[string]interface{}{
+		"publisher_id": "tfs",
+	})
+	diags := dataSourceServicehookMetadataRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, 1, resourceData.Get("publishers.#"))
+	require.Equal(t, "tfs", resourceData.Get("publishers.0.id"))
+	require.Equal(t, "git.push", resourceData.Get("publishers.0.event_types.0.id"))
+	require.Equal(t, "repository", resourceData.Get("publishers.0.event_types.0.inputs.0.id"))
+	require.Equal(t, false, resourceData.Get("publishers.0.event_types.0.inputs.0.required"))
+
+	require.Equal(t, "webHooks", resourceData.Get("consumers.0.id"))
+	require.Equal(t, true, resourceData.Get("consumers.0.inputs.0.required"))
+	require.Equal(t, "git.push", resourceData.Get("consumers.0.actions.0.supported_event_types.0"))
+	require.Equal(t, "all", resourceData.Get("consumers.0.actions.0.inputs.0.default_value"))
+	require.Equal(t, []interface{}{"all", "none"}, resourceData.Get("consumers.0.actions.0.inputs.0.allowed_values"))
+}
+
+func TestDataSourceServicehookMetadata_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	mockClient.
+		EXPECT().
+		ListPublishers(clients.Ctx, servicehooks.ListPublishersArgs{}).
+		Return(nil, errors.New("ListPublishers() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataServicehookMetadata().Schema, nil)
+	diags := dataSourceServicehookMetadataRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "ListPublishers() Failed")
+}
@@ -0,0 +1,215 @@
+package servicehook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+/*
+The input descriptors of publishers and consumers only change when extensions are installed. They are cached for the
+lifetime of the provider so that a plan with many subscriptions only fetches them once per organization. The lock is
+only held while accessing the maps, concurrent lookups of a missing descriptor may both fetch it.
+*/
+var inputDescriptorCache = struct {
+	sync.RWMutex
+	eventTypes map[string]*servicehooks.EventTypeDescriptor
+	consumers  map[string]*servicehooks.Consumer
+}{
+	eventTypes: map[string]*servicehooks.EventTypeDescriptor{},
+	consumers:  map[string]*servicehooks.Consumer{},
+}
+
+func getEventTypeDescriptor(ctx context.Context, clients *client.AggregatedClient, publisherId string, eventType string) (*servicehooks.EventTypeDescriptor, error) {
+	key := strings.ToLower(clients.OrganizationURL + "/" + publisherId + "/" + eventType)
+
+	inputDescriptorCache.RLock()
+	descriptor, ok := inputDescriptorCache.eventTypes[key]
+	inputDescriptorCache.RUnlock()
+	if ok {
+		return descriptor, nil
+	}
+
+	descriptor, err := clients.ServiceHooksClient.GetEventType(ctx, servicehooks.GetEventTypeArgs{
+		PublisherId: converter.String(publisherId),
+		EventTypeId: converter.String(eventType),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, fmt.Errorf(" Event type %s is not available for publisher %s", eventType, publisherId)
+		}
+		return nil, fmt.Errorf(" Looking up event type %s of publisher %s: %+v", eventType, publisherId, err)
+	}
+	inputDescriptorCache.Lock()
+	inputDescriptorCache.eventTypes[key] = descriptor
+	inputDescriptorCache.Unlock()
+	return descriptor, nil
+}
+
+func getConsumerDescriptor(ctx context.Context, clients *client.AggregatedClient, consumerId string) (*servicehooks.Consumer, error) {
+	key := strings.ToLower(clients.OrganizationURL + "/" + consumerId)
+
+	inputDescriptorCache.RLock()
+	consumer, ok := inputDescriptorCache.consumers[key]
+	inputDescriptorCache.RUnlock()
+	if ok {
+		return consumer, nil
+	}
+
+	consumer, err := clients.ServiceHooksClient.GetConsumer(ctx, servicehooks.GetConsumerArgs{
+		ConsumerId: converter.String(consumerId),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, fmt.Errorf(" Consumer %s is not available in the organization", consumerId)
+		}
+		return nil, fmt.Errorf(" Looking up consumer %s: %+v", consumerId, err)
+	}
+	inputDescriptorCache.Lock()
+	inputDescriptorCache.consumers[key] = consumer
+	inputDescriptorCache.Unlock()
+	return consumer, nil
+}
+
+// validateSubscriptionInputs checks the inputs of a subscription against the descriptors of the event type and the
+// consumer action
+func validateSubscriptionInputs(ctx context.Context, clients *client.AggregatedClient, subscription *servicehooks.Subscription) error {
+	publisherId := converter.ToString(subscription.PublisherId, "")
+	eventType := converter.ToString(subscription.EventType, "")
+	consumerId := converter.ToString(subscription.ConsumerId, "")
+	consumerActionId := converter.ToString(subscription.ConsumerActionId, "")
+
+	eventTypeDescriptor, err := getEventTypeDescriptor(ctx, clients, publisherId, eventType)
+	if err != nil {
+		return err
+	}
+	consumer, err := getConsumerDescriptor(ctx, clients, consumerId)
+	if err != nil {
+		return err
+	}
+
+	var action *servicehooks.ConsumerAction
+	var actions []string
+	if consumer.Actions != nil {
+		for _, a := range *consumer.Actions {
+			actions = append(actions, converter.ToString(a.Id, ""))
+			if converter.ToString(a.Id, "") == consumerActionId {
+				action = &a
+			}
+		}
+	}
+	if action == nil {
+		return fmt.Errorf(" Action %s is not supported by consumer %s. Supported actions: %s", consumerActionId, consumerId, strings.Join(actions, ", "))
+	}
+
+	publisherInputs := map[string]string{}
+	if subscription.PublisherInputs != nil {
+		publisherInputs = *subscription.PublisherInputs
+	}
+	consumerInputs := map[string]string{}
+	if subscription.ConsumerInputs != nil {
+		consumerInputs = *subscription.ConsumerInputs
+	}
+
+	var consumerDescriptors []forminput.InputDescriptor
+	if consumer.InputDescriptors != nil {
+		consumerDescriptors = append(consumerDescriptors, *consumer.InputDescriptors...)
+	}
+	if action.InputDescriptors != nil {
+		consumerDescriptors = append(consumerDescriptors, *action.InputDescriptors...)
+	}
+	var publisherDescriptors []forminput.InputDescriptor
+	if eventTypeDescriptor.InputDescriptors != nil {
+		publisherDescriptors = *eventTypeDescriptor.InputDescriptors
+	}
+
+	var problems []string
+	problems = append(problems, checkInputs("publisher_inputs", fmt.Sprintf("event type %s", eventType), publisherDescriptors, publisherInputs)...)
+	problems = append(problems, checkInputs("consumer_inputs", fmt.Sprintf("action %s of consumer %s", consumerActionId, consumerId), consumerDescriptors, consumerInputs)...)
+	if len(problems) > 0 {
+		return fmt.Errorf(" Invalid service hook subscription:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// checkInputs returns a description of each unknown, missing or not allowed input. Values are never part of the
+// description as the consumer inputs can contain secrets.
+func checkInputs(argument string, owner string, descriptors []forminput.InputDescriptor, inputs map[string]string) []string {
+	byId := map[string]forminput.InputDescriptor{}
+	for _, descriptor := range descriptors {
+		if descriptor.Id != nil {
+			byId[*descriptor.Id] = descriptor
+		}
+	}
+	supported := make([]string, 0, len(byId))
+	for id := range byId {
+		supported = append(supported, id)
+	}
+	sort.Strings(supported)
+
+	var problems []string
+	var unknown []string
+	for key, value := range inputs {
+		descriptor, ok := byId[key]
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		if allowed := allowedInputValues(descriptor); len(allowed) > 0 && value != "" && !slices.Contains(allowed, value) {
+			problems = append(problems, fmt.Sprintf("`%s` input %s is not one of the values allowed by %s: %s", argument, key, owner, strings.Join(allowed, ", ")))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		problems = append(problems, fmt.Sprintf("`%s` contains inputs which are not supported by %s: %s. Supported inputs: %s", argument, owner, strings.Join(unknown, ", "), strings.Join(supported, ", ")))
+	}
+
+	var missing []string
+	for _, id := range supported {
+		// the project is managed through `project_id`, subscriptions without a project apply to the whole organization
+		if id == "projectId" || !isRequiredInput(byId[id]) {
+			continue
+		}
+		if v, ok := inputs[id]; !ok || v == "" {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("`%s` is missing inputs required by %s: %s", argument, owner, strings.Join(missing, ", ")))
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// isRequiredInput reports whether an input has to be configured, inputs with a default value are filled in by the service
+func isRequiredInput(descriptor forminput.InputDescriptor) bool {
+	if descriptor.Validation == nil || !converter.ToBool(descriptor.Validation.IsRequired, false) {
+		return false
+	}
+	return descriptor.Values == nil || converter.ToString(descriptor.Values.DefaultValue, "") == ""
+}
+
+// allowedInputValues returns the values an input is limited to. Inputs with dynamic values, e.g. the repositories of
+// a project, are not checked.
+func allowedInputValues(descriptor forminput.InputDescriptor) []string {
+	if converter.ToBool(descriptor.HasDynamicValueInformation, false) || descriptor.Values == nil ||
+		!converter.ToBool(descriptor.Values.IsLimitedToPossibleValues, false) || descriptor.Values.PossibleValues == nil {
+		return nil
+	}
+	var allowed []string
+	for _, v := range *descriptor.Values.PossibleValues {
+		if v.Value != nil {
+			allowed = append(allowed, *v.Value)
+		}
+	}
+	return allowed
+}
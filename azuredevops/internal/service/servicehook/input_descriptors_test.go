@@ -0,0 +1,170 @@
+//go:build (all || resource_servicehook_subscription) && !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/forminput"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testEventTypeDescriptor = servicehooks.EventTypeDescriptor{
+	Id:          converter.String("build.complete"),
+	PublisherId: converter.String("tfs"),
+	InputDescriptors: &[]forminput.InputDescriptor{
+		{
+			Id:         converter.String("projectId"),
+			Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+		},
+		{
+			Id: converter.String("definitionName"),
+		},
+		{
+			Id: converter.String("buildStatus"),
+			Values: &forminput.InputValues{
+				IsLimitedToPossibleValues: converter.Bool(true),
+				PossibleValues: &[]forminput.InputValue{
+					{Value: converter.String("Succeeded")},
+					{Value: converter.String("Failed")},
+				},
+			},
+		},
+	},
+}
+
+var testConsumerDescriptor = servicehooks.Consumer{
+	Id: converter.String("webHooks"),
+	InputDescriptors: &[]forminput.InputDescriptor{
+		{
+			Id:         converter.String("url"),
+			Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+		},
+		{
+			Id:             converter.String("basicAuthPassword"),
+			IsConfidential: converter.Bool(true),
+		},
+	},
+	Actions: &[]servicehooks.ConsumerAction{
+		{
+			Id: converter.String("httpRequest"),
+			InputDescriptors: &[]forminput.InputDescriptor{
+				{
+					Id:         converter.String("resourceDetailsToSend"),
+					Validation: &forminput.InputValidation{IsRequired: converter.Bool(true)},
+					Values:     &forminput.InputValues{DefaultValue: converter.String("all")},
+				},
+			},
+		},
+	},
+}
+
+func getInputDescriptorsTestClients(t *testing.T, ctrl *gomock.Controller) (*client.AggregatedClient, *azdosdkmocks.MockServicehooksClient) {
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{
+		ServiceHooksClient: mockClient,
+		Ctx:                context.Background(),
+		// the descriptors are cached per organization
+		OrganizationURL: "https://dev.azure.com/" + uuid.New().String(),
+	}
+	return clients, mockClient
+}
+
+func getInputDescriptorsTestSubscription(publisherInputs map[string]string, consumerInputs map[string]string) *servicehooks.Subscription {
+	return &servicehooks.Subscription{
+		PublisherId:      converter.String("tfs"),
+		EventType:        converter.String("build.complete"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerActionId: converter.String("httpRequest"),
+		PublisherInputs:  &publisherInputs,
+		ConsumerInputs:   &consumerInputs,
+	}
+}
+
+func TestServicehookInputDescriptors_ValidInputs_AreCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clients, mockClient := getInputDescriptorsTestClients(t, ctrl)
+	mockClient.
+		EXPECT().
+		GetEventType(clients.Ctx, servicehooks.GetEventTypeArgs{
+			PublisherId: converter.String("tfs"),
+			EventTypeId: converter.String("build.complete"),
+		}).
+		Return(&testEventTypeDescriptor, nil).
+		Times(1)
+	mockClient.
+		EXPECT().
+		GetConsumer(clients.Ctx, servicehooks.GetConsumerArgs{ConsumerId: converter.String("webHooks")}).
+		Return(&testConsumerDescriptor, nil).
+		Times(1)
+
+	subscription := getInputDescriptorsTestSubscription(
+		map[string]string{"projectId": "myprojectid", "buildStatus": "Failed"},
+		map[string]string{"url": "https://example.com/webhook", "basicAuthPassword": "secret"},
+	)
+	require.NoError(t, validateSubscriptionInputs(clients.Ctx, clients, subscription))
+	require.NoError(t, validateSubscriptionInputs(clients.Ctx, clients, subscription))
+}
+
+func TestServicehookInputDescriptors_InvalidInputs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clients, mockClient := getInputDescriptorsTestClients(t, ctrl)
+	mockClient.EXPECT().GetEventType(gomock.Any(), gomock.Any()).Return(&testEventTypeDescriptor, nil).Times(1)
+	mockClient.EXPECT().GetConsumer(gomock.Any(), gomock.Any()).Return(&testConsumerDescriptor, nil).Times(1)
+
+	subscription := getInputDescriptorsTestSubscription(
+		map[string]string{"buildStatus": "Canceled", "repository": "myrepo"},
+		map[string]string{"basicAuthPassword": "secret"},
+	)
+	err := validateSubscriptionInputs(clients.Ctx, clients, subscription)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "`publisher_inputs` input buildStatus is not one of the values allowed by event type build.complete: Succeeded, Failed")
+	require.Contains(t, err.Error(), "`publisher_inputs` contains inputs which are not supported by event type build.complete: repository")
+	require.Contains(t, err.Error(), "`consumer_inputs` is missing inputs required by action httpRequest of consumer webHooks: url")
+	require.NotContains(t, err.Error(), "missing inputs required by event type")
+	require.NotContains(t, err.Error(), "resourceDetailsToSend")
+	require.NotContains(t, err.Error(), "secret")
+}
+
+func TestServicehookInputDescriptors_UnknownConsumerAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clients, mockClient := getInputDescriptorsTestClients(t, ctrl)
+	mockClient.EXPECT().GetEventType(gomock.Any(), gomock.Any()).Return(&testEventTypeDescriptor, nil).Times(1)
+	mockClient.EXPECT().GetConsumer(gomock.Any(), gomock.Any()).Return(&testConsumerDescriptor, nil).Times(1)
+
+	subscription := getInputDescriptorsTestSubscription(map[string]string{}, map[string]string{"url": "https://example.com"})
+	subscription.ConsumerActionId = converter.String("enqueue")
+	err := validateSubscriptionInputs(clients.Ctx, clients, subscription)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Action enqueue is not supported by consumer webHooks. Supported actions: httpRequest")
+}
+
+func TestServicehookInputDescriptors_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clients, mockClient := getInputDescriptorsTestClients(t, ctrl)
+	mockClient.
+		EXPECT().
+		GetEventType(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("GetEventType() Failed")).
+		Times(1)
+
+	err := validateSubscriptionInputs(clients.Ctx, clients, getInputDescriptorsTestSubscription(map[string]string{}, map[string]string{}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "GetEventType() Failed")
+}
@@ -0,0 +1,170 @@
+package servicehook
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServicehookAzureServiceBus schema and implementation for an Azure Service Bus service hook subscription
+func ResourceServicehookAzureServiceBus() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "The ID of the project",
+		},
+		"connection_string": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The connection string of the Service Bus namespace",
+		},
+		"queue_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"queue_name", "topic_name"},
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The name of the queue the events are sent to",
+		},
+		"topic_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The name of the topic the events are sent to",
+		},
+		"send_as_non_serialized_string": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the events are sent as a non-serialized string instead of a .NET serialized one",
+		},
+	}
+
+	maps.Copy(resourceSchema, genMessagesSchema())
+	maps.Copy(resourceSchema, genTfsPublisherSchema())
+
+	return &schema.Resource{
+		Create: resourceServicehookAzureServiceBusCreate,
+		Read:   resourceServicehookAzureServiceBusRead,
+		Update: resourceServicehookAzureServiceBusUpdate,
+		Delete: resourceServicehookAzureServiceBusDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func resourceServicehookAzureServiceBusCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookAzureServiceBus(d)
+	createdSubscription, err := createSubscription(clients, subscription)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(createdSubscription.Id.String())
+	return resourceServicehookAzureServiceBusRead(d, m)
+}
+
+func resourceServicehookAzureServiceBusRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription, err := getSubscription(clients, converter.UUID(d.Id()))
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		d.SetId("")
+		return nil
+	}
+	flattenServicehookAzureServiceBus(d, subscription)
+	return nil
+}
+
+func resourceServicehookAzureServiceBusUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookAzureServiceBus(d)
+	parsedID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+	subscription.Id = &parsedID
+
+	if _, err = updateSubscription(clients, subscription); err != nil {
+		return fmt.Errorf("updating subscription in Azure DevOps: %+v", err)
+	}
+	return resourceServicehookAzureServiceBusRead(d, m)
+}
+
+func resourceServicehookAzureServiceBusDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	return clients.ServiceHooksClient.DeleteSubscription(clients.Ctx, servicehooks.DeleteSubscriptionArgs{
+		SubscriptionId: converter.UUID(d.Id()),
+	})
+}
+
+func expandServicehookAzureServiceBus(d *schema.ResourceData) *servicehooks.Subscription {
+	publisherInputs, eventType := expandTfsEventConfig(d)
+	consumerInputs := map[string]string{
+		"connectionString": d.Get("connection_string").(string),
+		"bypassSerializer": fmt.Sprintf("%t", d.Get("send_as_non_serialized_string").(bool)),
+	}
+	consumerActionId := "serviceBusQueueSend"
+	if v, ok := d.GetOk("topic_name"); ok {
+		consumerActionId = "serviceBusTopicSend"
+		consumerInputs["topicName"] = v.(string)
+	} else {
+		consumerInputs["queueName"] = d.Get("queue_name").(string)
+	}
+	expandMessagesConfig(d, consumerInputs)
+
+	return &servicehooks.Subscription{
+		ConsumerActionId: converter.String(consumerActionId),
+		ConsumerId:       converter.String("azureServiceBus"),
+		ConsumerInputs:   &consumerInputs,
+		EventType:        &eventType,
+		PublisherId:      converter.String("tfs"),
+		PublisherInputs:  &publisherInputs,
+		ResourceVersion:  converter.String("1.0"),
+	}
+}
+
+// flattenServicehookAzureServiceBus does not flatten the connection string, it is masked by the service
+func flattenServicehookAzureServiceBus(d *schema.ResourceData, subscription *servicehooks.Subscription) {
+	flattenTfsEventConfig(d, subscription)
+
+	consumerInputs := map[string]string{}
+	if subscription.ConsumerInputs != nil {
+		consumerInputs = *subscription.ConsumerInputs
+	}
+	if converter.ToString(subscription.ConsumerActionId, "") == "serviceBusTopicSend" {
+		d.Set("topic_name", consumerInputs["topicName"])
+		d.Set("queue_name", "")
+	} else {
+		d.Set("queue_name", consumerInputs["queueName"])
+		d.Set("topic_name", "")
+	}
+	d.Set("send_as_non_serialized_string", strings.EqualFold(consumerInputs["bypassSerializer"], "true"))
+	flattenMessagesConfig(d, consumerInputs)
+}
@@ -0,0 +1,184 @@
+//go:build (all || resource_servicehook_azure_service_bus) && !exclude_subscriptions
+// +build all resource_servicehook_azure_service_bus
+// +build !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var subscriptionAzureServiceBusID = uuid.New()
+
+var testResourceSubscriptionAzureServiceBus = []servicehooks.Subscription{
+	{
+		Id:               &subscriptionAzureServiceBusID,
+		ConsumerActionId: converter.String("serviceBusQueueSend"),
+		ConsumerId:       converter.String("azureServiceBus"),
+		ConsumerInputs: &map[string]string{
+			"connectionString":       "myconnectionstring",
+			"queueName":              "myqueue",
+			"bypassSerializer":       "false",
+			"resourceDetailsToSend":  "all",
+			"messagesToSend":         "all",
+			"detailedMessagesToSend": "all",
+		},
+		EventType:   converter.String("git.pullrequest.merged"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":                    "myprojectid",
+			"repository":                   "myrepositoryid",
+			"pullrequestReviewersContains": "mygroupid",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+	{
+		Id:               &subscriptionAzureServiceBusID,
+		ConsumerActionId: converter.String("serviceBusTopicSend"),
+		ConsumerId:       converter.String("azureServiceBus"),
+		ConsumerInputs: &map[string]string{
+			"connectionString":       "myconnectionstring",
+			"topicName":              "mytopic",
+			"bypassSerializer":       "true",
+			"resourceDetailsToSend":  "none",
+			"messagesToSend":         "text",
+			"detailedMessagesToSend": "html",
+		},
+		EventType:   converter.String("workitem.created"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":    "myprojectid",
+			"workItemType": "Task",
+			"areaPath":     "myproject\\myarea",
+			"tag":          "mytag",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+}
+
+// getAzureServiceBusResourceData flattens the subscription; the connection string is not returned by the service and is set from the configuration
+func getAzureServiceBusResourceData(t *testing.T, subscription *servicehooks.Subscription) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServicehookAzureServiceBus().Schema, nil)
+	flattenServicehookAzureServiceBus(resourceData, subscription)
+	resourceData.Set("connection_string", (*subscription.ConsumerInputs)["connectionString"])
+	return resourceData
+}
+
+func TestServicehookAzureServiceBus_FlattenExpandRoundTrip(t *testing.T) {
+	for _, subscription := range testResourceSubscriptionAzureServiceBus {
+		resourceData := getAzureServiceBusResourceData(t, &subscription)
+		subscriptionAfterRoundTrip := expandServicehookAzureServiceBus(resourceData)
+		subscriptionAfterRoundTrip.Id = subscription.Id
+
+		require.Equal(t, subscription, *subscriptionAfterRoundTrip)
+	}
+}
+
+func TestServicehookAzureServiceBus_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookAzureServiceBus()
+	for _, subscription := range testResourceSubscriptionAzureServiceBus {
+		resourceData := getAzureServiceBusResourceData(t, &subscription)
+
+		mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+		clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+		subscription.Id = nil
+		expectedArgs := servicehooks.CreateSubscriptionArgs{Subscription: &subscription}
+
+		mockClient.
+			EXPECT().
+			CreateSubscription(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateSubscription() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateSubscription() Failed")
+	}
+}
+
+func TestServicehookAzureServiceBus_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookAzureServiceBus()
+	for _, subscription := range testResourceSubscriptionAzureServiceBus {
+		resourceData := getAzureServiceBusResourceData(t, &subscription)
+		resourceData.SetId(subscription.Id.String())
+
+		mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+		clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+		expectedArgs := servicehooks.ReplaceSubscriptionArgs{
+			Subscription:   &subscription,
+			SubscriptionId: subscription.Id,
+		}
+
+		mockClient.
+			EXPECT().
+			ReplaceSubscription(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("ReplaceSubscription() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "ReplaceSubscription() Failed")
+	}
+}
+
+func TestServicehookAzureServiceBus_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookAzureServiceBus()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionAzureServiceBusID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	expectedArgs := servicehooks.GetSubscriptionArgs{SubscriptionId: &subscriptionAzureServiceBusID}
+
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetSubscription() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetSubscription() Failed")
+}
+
+func TestServicehookAzureServiceBus_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookAzureServiceBus()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionAzureServiceBusID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	expectedArgs := servicehooks.DeleteSubscriptionArgs{SubscriptionId: &subscriptionAzureServiceBusID}
+
+	mockClient.
+		EXPECT().
+		DeleteSubscription(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteSubscription() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteSubscription() Failed")
+}
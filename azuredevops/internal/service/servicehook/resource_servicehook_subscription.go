@@ -1,6 +1,7 @@
 package servicehook
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,10 +15,11 @@ import (
 // ResourceServicehookSubscription schema and implementation for service hook subscription resource
 func ResourceServicehookSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceServicehookSubscriptionCreate,
-		Read:   resourceServicehookSubscriptionRead,
-		Update: resourceServicehookSubscriptionUpdate,
-		Delete: resourceServicehookSubscriptionDelete,
+		Create:        resourceServicehookSubscriptionCreate,
+		Read:          resourceServicehookSubscriptionRead,
+		Update:        resourceServicehookSubscriptionUpdate,
+		Delete:        resourceServicehookSubscriptionDelete,
+		CustomizeDiff: resourceServicehookSubscriptionCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -146,6 +148,41 @@ func resourceServicehookSubscriptionDelete(d *schema.ResourceData, m interface{}
 	})
 }
 
+func resourceServicehookSubscriptionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	keys := []string{"project_id", "publisher_id", "event_type", "consumer_id", "consumer_action_id", "publisher_inputs", "consumer_inputs"}
+	if !d.HasChanges(keys...) {
+		return nil
+	}
+	// values which are only known after apply cannot be validated, except for the project which is not validated anyway
+	for _, key := range keys[1:] {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	publisherInputs := make(map[string]string)
+	if projectID, ok := d.GetOk("project_id"); ok && d.NewValueKnown("project_id") {
+		publisherInputs["projectId"] = projectID.(string)
+	}
+	for key, value := range d.Get("publisher_inputs").(map[string]interface{}) {
+		publisherInputs[key] = value.(string)
+	}
+	consumerInputs := make(map[string]string)
+	for key, value := range d.Get("consumer_inputs").(map[string]interface{}) {
+		consumerInputs[key] = value.(string)
+	}
+
+	clients := m.(*client.AggregatedClient)
+	return validateSubscriptionInputs(ctx, clients, &servicehooks.Subscription{
+		PublisherId:      converter.String(d.Get("publisher_id").(string)),
+		EventType:        converter.String(d.Get("event_type").(string)),
+		ConsumerId:       converter.String(d.Get("consumer_id").(string)),
+		ConsumerActionId: converter.String(d.Get("consumer_action_id").(string)),
+		PublisherInputs:  &publisherInputs,
+		ConsumerInputs:   &consumerInputs,
+	})
+}
+
 func expandServicehookSubscription(d *schema.ResourceData) *servicehooks.Subscription {
 	publisherInputs := make(map[string]string)
 
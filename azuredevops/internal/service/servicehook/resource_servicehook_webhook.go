@@ -0,0 +1,181 @@
+package servicehook
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceServicehookWebhook schema and implementation for a Web Hooks service hook subscription
+func ResourceServicehookWebhook() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "The ID of the project",
+		},
+		"url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The URL to which the HTTP POST request is sent",
+		},
+		"basic_auth_username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The username for basic authentication",
+		},
+		"basic_auth_password": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"basic_auth_username"},
+			Description:  "The password for basic authentication",
+		},
+		"http_headers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The HTTP headers sent with the request",
+		},
+		"accept_untrusted_certs": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to accept untrusted SSL certificates",
+		},
+	}
+
+	maps.Copy(resourceSchema, genMessagesSchema())
+	maps.Copy(resourceSchema, genTfsPublisherSchema())
+
+	return &schema.Resource{
+		Create: resourceServicehookWebhookCreate,
+		Read:   resourceServicehookWebhookRead,
+		Update: resourceServicehookWebhookUpdate,
+		Delete: resourceServicehookWebhookDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func resourceServicehookWebhookCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookWebhook(d)
+	createdSubscription, err := createSubscription(clients, subscription)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(createdSubscription.Id.String())
+	return resourceServicehookWebhookRead(d, m)
+}
+
+func resourceServicehookWebhookRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription, err := getSubscription(clients, converter.UUID(d.Id()))
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		d.SetId("")
+		return nil
+	}
+	flattenServicehookWebhook(d, subscription)
+	return nil
+}
+
+func resourceServicehookWebhookUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	subscription := expandServicehookWebhook(d)
+	parsedID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+	subscription.Id = &parsedID
+
+	if _, err = updateSubscription(clients, subscription); err != nil {
+		return fmt.Errorf("updating subscription in Azure DevOps: %+v", err)
+	}
+	return resourceServicehookWebhookRead(d, m)
+}
+
+func resourceServicehookWebhookDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	return clients.ServiceHooksClient.DeleteSubscription(clients.Ctx, servicehooks.DeleteSubscriptionArgs{
+		SubscriptionId: converter.UUID(d.Id()),
+	})
+}
+
+func expandServicehookWebhook(d *schema.ResourceData) *servicehooks.Subscription {
+	publisherInputs, eventType := expandTfsEventConfig(d)
+	consumerInputs := map[string]string{
+		"url":                  d.Get("url").(string),
+		"acceptUntrustedCerts": fmt.Sprintf("%t", d.Get("accept_untrusted_certs").(bool)),
+	}
+	if v, ok := d.GetOk("basic_auth_username"); ok {
+		consumerInputs["basicAuthUsername"] = v.(string)
+	}
+	if v, ok := d.GetOk("basic_auth_password"); ok {
+		consumerInputs["basicAuthPassword"] = v.(string)
+	}
+	if v, ok := d.GetOk("http_headers"); ok {
+		consumerInputs["httpHeaders"] = expandHttpHeaders(v.(map[string]interface{}))
+	}
+	expandMessagesConfig(d, consumerInputs)
+
+	return &servicehooks.Subscription{
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs:   &consumerInputs,
+		EventType:        &eventType,
+		PublisherId:      converter.String("tfs"),
+		PublisherInputs:  &publisherInputs,
+		ResourceVersion:  converter.String("1.0"),
+	}
+}
+
+// flattenServicehookWebhook does not flatten the password and the headers, they are masked by the service
+func flattenServicehookWebhook(d *schema.ResourceData, subscription *servicehooks.Subscription) {
+	flattenTfsEventConfig(d, subscription)
+
+	consumerInputs := map[string]string{}
+	if subscription.ConsumerInputs != nil {
+		consumerInputs = *subscription.ConsumerInputs
+	}
+	d.Set("url", consumerInputs["url"])
+	d.Set("basic_auth_username", consumerInputs["basicAuthUsername"])
+	d.Set("accept_untrusted_certs", strings.EqualFold(consumerInputs["acceptUntrustedCerts"], "true"))
+	flattenMessagesConfig(d, consumerInputs)
+}
+
+// expandHttpHeaders formats the headers as expected by the Web Hooks consumer, one `Key:Value` pair per line
+func expandHttpHeaders(headers map[string]interface{}) string {
+	lines := make([]string, 0, len(headers))
+	for key, value := range headers {
+		lines = append(lines, fmt.Sprintf("%s:%s", key, value.(string)))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
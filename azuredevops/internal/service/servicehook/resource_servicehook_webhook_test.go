@@ -0,0 +1,237 @@
+//go:build (all || resource_servicehook_webhook) && !exclude_subscriptions
+// +build all resource_servicehook_webhook
+// +build !exclude_subscriptions
+
+package servicehook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var subscriptionWebhookID = uuid.New()
+
+var testResourceSubscriptionWebhook = []servicehooks.Subscription{
+	{
+		Id:               &subscriptionWebhookID,
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs: &map[string]string{
+			"url":                    "https://example.com/webhook",
+			"acceptUntrustedCerts":   "false",
+			"resourceDetailsToSend":  "all",
+			"messagesToSend":         "all",
+			"detailedMessagesToSend": "all",
+		},
+		EventType:   converter.String("git.push"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":  "myprojectid",
+			"repository": "myrepositoryid",
+			"branch":     "main",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+	{
+		Id:               &subscriptionWebhookID,
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs: &map[string]string{
+			"url":                    "https://example.com/webhook",
+			"acceptUntrustedCerts":   "true",
+			"basicAuthUsername":      "myusername",
+			"resourceDetailsToSend":  "minimal",
+			"messagesToSend":         "none",
+			"detailedMessagesToSend": "markdown",
+		},
+		EventType:   converter.String("git.pullrequest.updated"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":        "myprojectid",
+			"notificationType": "ReviewerVoteNotification",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+	{
+		Id:               &subscriptionWebhookID,
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs: &map[string]string{
+			"url":                    "https://example.com/webhook",
+			"acceptUntrustedCerts":   "false",
+			"resourceDetailsToSend":  "all",
+			"messagesToSend":         "all",
+			"detailedMessagesToSend": "all",
+		},
+		EventType:   converter.String("workitem.updated"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":     "myprojectid",
+			"workItemType":  "Bug",
+			"changedFields": "System.State",
+			"linksChanged":  "true",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+	{
+		Id:               &subscriptionWebhookID,
+		ConsumerActionId: converter.String("httpRequest"),
+		ConsumerId:       converter.String("webHooks"),
+		ConsumerInputs: &map[string]string{
+			"url":                    "https://example.com/webhook",
+			"acceptUntrustedCerts":   "false",
+			"resourceDetailsToSend":  "all",
+			"messagesToSend":         "all",
+			"detailedMessagesToSend": "all",
+		},
+		EventType:   converter.String("build.complete"),
+		PublisherId: converter.String("tfs"),
+		PublisherInputs: &map[string]string{
+			"projectId":      "myprojectid",
+			"definitionName": "mypipeline",
+			"buildStatus":    "Failed",
+		},
+		ResourceVersion: converter.String("1.0"),
+	},
+}
+
+func TestServicehookWebhook_FlattenExpandRoundTrip(t *testing.T) {
+	for _, subscription := range testResourceSubscriptionWebhook {
+		resourceData := schema.TestResourceDataRaw(t, ResourceServicehookWebhook().Schema, nil)
+		flattenServicehookWebhook(resourceData, &subscription)
+		subscriptionAfterRoundTrip := expandServicehookWebhook(resourceData)
+		subscriptionAfterRoundTrip.Id = subscription.Id
+
+		require.Equal(t, subscription, *subscriptionAfterRoundTrip)
+	}
+}
+
+func TestServicehookWebhook_ExpandSecrets(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServicehookWebhook().Schema, map[string]interface{}{
+		"project_id":          "myprojectid",
+		"url":                 "https://example.com/webhook",
+		"basic_auth_username": "myusername",
+		"basic_auth_password": "mypassword",
+		"http_headers": map[string]interface{}{
+			"X-Second": "2",
+			"X-First":  "1",
+		},
+		"git_push_event": []interface{}{map[string]interface{}{}},
+	})
+
+	subscription := expandServicehookWebhook(resourceData)
+	require.Equal(t, "mypassword", (*subscription.ConsumerInputs)["basicAuthPassword"])
+	require.Equal(t, "X-First:1\nX-Second:2", (*subscription.ConsumerInputs)["httpHeaders"])
+	require.Equal(t, "git.push", *subscription.EventType)
+	require.Equal(t, map[string]string{"projectId": "myprojectid"}, *subscription.PublisherInputs)
+}
+
+func TestServicehookWebhook_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookWebhook()
+	for _, subscription := range testResourceSubscriptionWebhook {
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+		flattenServicehookWebhook(resourceData, &subscription)
+
+		mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+		clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+		subscription.Id = nil
+		expectedArgs := servicehooks.CreateSubscriptionArgs{Subscription: &subscription}
+
+		mockClient.
+			EXPECT().
+			CreateSubscription(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("CreateSubscription() Failed")).
+			Times(1)
+
+		err := r.Create(resourceData, clients)
+		require.Contains(t, err.Error(), "CreateSubscription() Failed")
+	}
+}
+
+func TestServicehookWebhook_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookWebhook()
+	for _, subscription := range testResourceSubscriptionWebhook {
+		resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+		resourceData.SetId(subscription.Id.String())
+		flattenServicehookWebhook(resourceData, &subscription)
+
+		mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+		clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+		expectedArgs := servicehooks.ReplaceSubscriptionArgs{
+			Subscription:   &subscription,
+			SubscriptionId: subscription.Id,
+		}
+
+		mockClient.
+			EXPECT().
+			ReplaceSubscription(clients.Ctx, expectedArgs).
+			Return(nil, errors.New("ReplaceSubscription() Failed")).
+			Times(1)
+
+		err := r.Update(resourceData, clients)
+		require.Contains(t, err.Error(), "ReplaceSubscription() Failed")
+	}
+}
+
+func TestServicehookWebhook_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookWebhook()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionWebhookID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	expectedArgs := servicehooks.GetSubscriptionArgs{SubscriptionId: &subscriptionWebhookID}
+
+	mockClient.
+		EXPECT().
+		GetSubscription(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetSubscription() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetSubscription() Failed")
+}
+
+func TestServicehookWebhook_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServicehookWebhook()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(subscriptionWebhookID.String())
+
+	mockClient := azdosdkmocks.NewMockServicehooksClient(ctrl)
+	clients := &client.AggregatedClient{ServiceHooksClient: mockClient, Ctx: context.Background()}
+
+	expectedArgs := servicehooks.DeleteSubscriptionArgs{SubscriptionId: &subscriptionWebhookID}
+
+	mockClient.
+		EXPECT().
+		DeleteSubscription(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteSubscription() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteSubscription() Failed")
+}
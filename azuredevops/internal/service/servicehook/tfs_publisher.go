@@ -0,0 +1,261 @@
+package servicehook
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+)
+
+// tfsEventFilter maps an attribute of an event block to the input of the `tfs` publisher it filters on
+type tfsEventFilter struct {
+	input         string
+	description   string
+	allowedValues []string
+}
+
+type tfsEvent struct {
+	eventType string
+	filters   map[string]tfsEventFilter
+}
+
+var (
+	tfsRepositoryFilter = tfsEventFilter{
+		input:       "repository",
+		description: "The ID of the repository to be monitored. If not specified, all repositories in the project will trigger the event",
+	}
+	tfsBranchFilter = tfsEventFilter{
+		input:       "branch",
+		description: "The branch to be monitored. If not specified, all branches will trigger the event",
+	}
+	tfsPullRequestCreatedByFilter = tfsEventFilter{
+		input:       "pullrequestCreatedBy",
+		description: "Only pull requests created by a member of this group will trigger the event",
+	}
+	tfsPullRequestReviewersContainsFilter = tfsEventFilter{
+		input:       "pullrequestReviewersContains",
+		description: "Only pull requests with a member of this group as reviewer will trigger the event",
+	}
+	tfsWorkItemTypeFilter = tfsEventFilter{
+		input:       "workItemType",
+		description: "The type of work item to be monitored. If not specified, all work item types will trigger the event",
+	}
+	tfsAreaPathFilter = tfsEventFilter{
+		input:       "areaPath",
+		description: "The area path of the work items to be monitored. If not specified, all area paths will trigger the event",
+	}
+	tfsTagFilter = tfsEventFilter{
+		input:       "tag",
+		description: "Only work items with this tag will trigger the event",
+	}
+
+	tfsEvents = map[string]tfsEvent{
+		"git_push_event": {
+			eventType: "git.push",
+			filters: map[string]tfsEventFilter{
+				"repository_id": tfsRepositoryFilter,
+				"branch":        tfsBranchFilter,
+				"pushed_by": {
+					input:       "pushedBy",
+					description: "Only pushes by a member of this group will trigger the event",
+				},
+			},
+		},
+		"git_pull_request_created_event": {
+			eventType: "git.pullrequest.created",
+			filters: map[string]tfsEventFilter{
+				"repository_id":      tfsRepositoryFilter,
+				"branch":             tfsBranchFilter,
+				"created_by":         tfsPullRequestCreatedByFilter,
+				"reviewers_contains": tfsPullRequestReviewersContainsFilter,
+			},
+		},
+		"git_pull_request_updated_event": {
+			eventType: "git.pullrequest.updated",
+			filters: map[string]tfsEventFilter{
+				"repository_id":      tfsRepositoryFilter,
+				"branch":             tfsBranchFilter,
+				"created_by":         tfsPullRequestCreatedByFilter,
+				"reviewers_contains": tfsPullRequestReviewersContainsFilter,
+				"notification_type": {
+					input:         "notificationType",
+					description:   "The type of update which should generate an event. If not specified, all updates will trigger the event",
+					allowedValues: []string{"PushNotification", "ReviewersUpdateNotification", "StatusUpdateNotification", "ReviewerVoteNotification"},
+				},
+			},
+		},
+		"git_pull_request_merged_event": {
+			eventType: "git.pullrequest.merged",
+			filters: map[string]tfsEventFilter{
+				"repository_id":      tfsRepositoryFilter,
+				"branch":             tfsBranchFilter,
+				"created_by":         tfsPullRequestCreatedByFilter,
+				"reviewers_contains": tfsPullRequestReviewersContainsFilter,
+			},
+		},
+		"work_item_created_event": {
+			eventType: "workitem.created",
+			filters: map[string]tfsEventFilter{
+				"work_item_type": tfsWorkItemTypeFilter,
+				"area_path":      tfsAreaPathFilter,
+				"tag":            tfsTagFilter,
+			},
+		},
+		"work_item_updated_event": {
+			eventType: "workitem.updated",
+			filters: map[string]tfsEventFilter{
+				"work_item_type": tfsWorkItemTypeFilter,
+				"area_path":      tfsAreaPathFilter,
+				"tag":            tfsTagFilter,
+				"changed_fields": {
+					input:       "changedFields",
+					description: "Only updates of this field will trigger the event, e.g. `System.State`",
+				},
+				"links_changed": {
+					input:         "linksChanged",
+					description:   "Whether only link changes should trigger the event",
+					allowedValues: []string{"true", "false"},
+				},
+			},
+		},
+		"build_completed_event": {
+			eventType: "build.complete",
+			filters: map[string]tfsEventFilter{
+				"definition_name": {
+					input:       "definitionName",
+					description: "The name of the build pipeline to be monitored. If not specified, all build pipelines will trigger the event",
+				},
+				"build_status": {
+					input:         "buildStatus",
+					description:   "Which build status should generate an event. If not specified, all statuses will trigger the event",
+					allowedValues: []string{"Succeeded", "PartiallySucceeded", "Failed", "Stopped"},
+				},
+			},
+		},
+	}
+)
+
+func tfsEventBlocks() []string {
+	blocks := make([]string, 0, len(tfsEvents))
+	for block := range tfsEvents {
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func genTfsPublisherSchema() map[string]*schema.Schema {
+	blocks := tfsEventBlocks()
+	result := map[string]*schema.Schema{}
+	for block, event := range tfsEvents {
+		filters := map[string]*schema.Schema{}
+		for attribute, filter := range event.filters {
+			filters[attribute] = &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: filter.description,
+			}
+			if len(filter.allowedValues) > 0 {
+				filters[attribute].ValidateFunc = validation.StringInSlice(filter.allowedValues, false)
+			}
+		}
+		result[block] = &schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: blocks,
+			Elem: &schema.Resource{
+				Schema: filters,
+			},
+		}
+	}
+	return result
+}
+
+func expandTfsEventConfig(d *schema.ResourceData) (map[string]string, string) {
+	eventConfig := map[string]string{
+		"projectId": d.Get("project_id").(string),
+	}
+	for block, event := range tfsEvents {
+		inputsList := d.Get(block).([]interface{})
+		if len(inputsList) == 0 {
+			continue
+		}
+		if inputs, ok := inputsList[0].(map[string]interface{}); ok {
+			for attribute, filter := range event.filters {
+				if v := inputs[attribute].(string); v != "" {
+					eventConfig[filter.input] = v
+				}
+			}
+		}
+		return eventConfig, event.eventType
+	}
+	return eventConfig, ""
+}
+
+// flattenTfsEventConfig sets the event block matching the event type of the subscription and clears all others
+func flattenTfsEventConfig(d *schema.ResourceData, subscription *servicehooks.Subscription) {
+	publisherInputs := map[string]string{}
+	if subscription.PublisherInputs != nil {
+		publisherInputs = *subscription.PublisherInputs
+	}
+	for block, event := range tfsEvents {
+		if subscription.EventType == nil || *subscription.EventType != event.eventType {
+			d.Set(block, nil)
+			continue
+		}
+		eventConfig := map[string]interface{}{}
+		for attribute, filter := range event.filters {
+			eventConfig[attribute] = publisherInputs[filter.input]
+		}
+		d.Set(block, []interface{}{eventConfig})
+	}
+	if projectId, ok := publisherInputs["projectId"]; ok {
+		d.Set("project_id", projectId)
+	}
+}
+
+// genMessagesSchema returns the arguments controlling the content of the event sent to a consumer
+func genMessagesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"resource_details_to_send": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"all", "minimal", "none"}, false),
+			Description:  "The resource details to send with the event",
+		},
+		"messages_to_send": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"all", "text", "html", "markdown", "none"}, false),
+			Description:  "The messages to send with the event",
+		},
+		"detailed_messages_to_send": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"all", "text", "html", "markdown", "none"}, false),
+			Description:  "The detailed messages to send with the event",
+		},
+	}
+}
+
+func expandMessagesConfig(d *schema.ResourceData, consumerInputs map[string]string) {
+	consumerInputs["resourceDetailsToSend"] = d.Get("resource_details_to_send").(string)
+	consumerInputs["messagesToSend"] = d.Get("messages_to_send").(string)
+	consumerInputs["detailedMessagesToSend"] = d.Get("detailed_messages_to_send").(string)
+}
+
+func flattenMessagesConfig(d *schema.ResourceData, consumerInputs map[string]string) {
+	for attribute, input := range map[string]string{
+		"resource_details_to_send":  "resourceDetailsToSend",
+		"messages_to_send":          "messagesToSend",
+		"detailed_messages_to_send": "detailedMessagesToSend",
+	} {
+		if v, ok := consumerInputs[input]; ok && v != "" {
+			d.Set(attribute, v)
+		} else {
+			d.Set(attribute, "all")
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// DataAgents schema and implementation for the agents of an agent pool
+func DataAgents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataAgentsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"demands": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"agents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisioning_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_capabilities": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"user_capabilities": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"assigned_request": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"request_id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"job_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"plan_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"definition_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"owner_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"assign_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataAgentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	poolId := d.Get("pool_id").(int)
+	args := taskagent.GetAgentsArgs{
+		PoolId:                 converter.Int(poolId),
+		IncludeCapabilities:    converter.Bool(true),
+		IncludeAssignedRequest: converter.Bool(true),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		args.AgentName = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("demands"); ok {
+		demands := tfhelper.ExpandStringSet(v.(*schema.Set))
+		args.Demands = &demands
+	}
+
+	agents, err := clients.TaskAgentClient.GetAgents(ctx, args)
+	if err != nil {
+		return diag.Errorf(" Reading agents. Pool ID: %d, Error: %+v", poolId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", poolId))
+	if err := d.Set("agents", flattenAgents(agents)); err != nil {
+		return diag.Errorf(" Setting agents: %+v", err)
+	}
+	return nil
+}
+
+func flattenAgents(agents *[]taskagent.TaskAgent) []interface{} {
+	if agents == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*agents))
+	for _, agent := range *agents {
+		output := map[string]interface{}{
+			"name":               converter.ToString(agent.Name, ""),
+			"version":            converter.ToString(agent.Version, ""),
+			"os_description":     converter.ToString(agent.OsDescription, ""),
+			"enabled":            converter.ToBool(agent.Enabled, false),
+			"provisioning_state": converter.ToString(agent.ProvisioningState, ""),
+			"assigned_request":   flattenAgentAssignedRequest(agent.AssignedRequest),
+		}
+		if agent.Id != nil {
+			output["id"] = *agent.Id
+		}
+		if agent.Status != nil {
+			output["status"] = string(*agent.Status)
+		}
+		if agent.CreatedOn != nil {
+			output["created_on"] = agent.CreatedOn.Time.Format(time.RFC3339)
+		}
+		if agent.SystemCapabilities != nil {
+			output["system_capabilities"] = *agent.SystemCapabilities
+		}
+		if agent.UserCapabilities != nil {
+			output["user_capabilities"] = *agent.UserCapabilities
+		}
+		results = append(results, output)
+	}
+	return results
+}
+
+func flattenAgentAssignedRequest(request *taskagent.TaskAgentJobRequest) []interface{} {
+	if request == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"job_name":  converter.ToString(request.JobName, ""),
+		"plan_type": converter.ToString(request.PlanType, ""),
+	}
+	if request.RequestId != nil {
+		output["request_id"] = int(*request.RequestId)
+	}
+	if request.Definition != nil {
+		output["definition_name"] = converter.ToString(request.Definition.Name, "")
+	}
+	if request.Owner != nil {
+		output["owner_name"] = converter.ToString(request.Owner.Name, "")
+	}
+	if request.AssignTime != nil {
+		output["assign_time"] = request.AssignTime.Time.Format(time.RFC3339)
+	}
+	return []interface{}{output}
+}
@@ -0,0 +1,75 @@
+//go:build (all || data_sources || data_agents) && (!exclude_data_sources || !exclude_data_agents)
+// +build all data_sources data_agents
+// +build !exclude_data_sources !exclude_data_agents
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataSourceAgents_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	requestId := uint64(1234)
+	agents := []taskagent.TaskAgent{
+		{
+			Id:                 converter.Int(5),
+			Name:               converter.String("agent-01"),
+			Version:            converter.String("3.236.1"),
+			OsDescription:      converter.String("Linux"),
+			Enabled:            converter.Bool(true),
+			Status:             &taskagent.TaskAgentStatusValues.Online,
+			SystemCapabilities: &map[string]string{"Agent_OS": "Linux"},
+			UserCapabilities:   &map[string]string{"docker": "true"},
+			AssignedRequest: &taskagent.TaskAgentJobRequest{
+				RequestId: &requestId,
+				JobName:   converter.String("Build"),
+				PlanType:  converter.String("Build"),
+				Definition: &taskagent.TaskOrchestrationOwner{
+					Name: converter.String("CI"),
+				},
+			},
+		},
+	}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.Ctx, taskagent.GetAgentsArgs{
+			PoolId:                 converter.Int(10),
+			IncludeCapabilities:    converter.Bool(true),
+			IncludeAssignedRequest: converter.Bool(true),
+		}).
+		Return(&agents, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAgents().Schema, nil)
+	resourceData.Set("pool_id", 10)
+	diags := dataAgentsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "10", resourceData.Id())
+	require.Equal(t, 1, resourceData.Get("agents.#"))
+	require.Equal(t, "agent-01", resourceData.Get("agents.0.name"))
+	require.Equal(t, "online", resourceData.Get("agents.0.status"))
+	require.Equal(t, "Linux", resourceData.Get("agents.0.system_capabilities.Agent_OS"))
+	require.Equal(t, "true", resourceData.Get("agents.0.user_capabilities.docker"))
+	require.Equal(t, 1234, resourceData.Get("agents.0.assigned_request.0.request_id"))
+	require.Equal(t, "CI", resourceData.Get("agents.0.assigned_request.0.definition_name"))
+}
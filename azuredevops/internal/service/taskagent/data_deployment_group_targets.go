@@ -0,0 +1,167 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// DataDeploymentGroupTargets schema and implementation for deployment group targets data source
+func DataDeploymentGroupTargets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataDeploymentGroupTargetsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"deployment_group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"agent_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataDeploymentGroupTargetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectId := d.Get("project_id").(string)
+	deploymentGroupId := d.Get("deployment_group_id").(int)
+
+	args := taskagent.GetDeploymentTargetsArgs{
+		Project:           converter.String(projectId),
+		DeploymentGroupId: converter.Int(deploymentGroupId),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		args.Name = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		tags := tfhelper.ExpandStringSet(v.(*schema.Set))
+		args.Tags = &tags
+	}
+
+	targets, err := getDeploymentTargets(ctx, clients, args)
+	if err != nil {
+		return diag.Errorf(" Reading deployment targets. Project ID: %s, Deployment Group ID: %d, Error: %+v", projectId, deploymentGroupId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", projectId, deploymentGroupId))
+	if err := d.Set("targets", flattenDeploymentTargets(targets)); err != nil {
+		return diag.Errorf(" Setting targets: %+v", err)
+	}
+	return nil
+}
+
+func getDeploymentTargets(ctx context.Context, clients *client.AggregatedClient, args taskagent.GetDeploymentTargetsArgs) ([]taskagent.DeploymentMachine, error) {
+	var targets []taskagent.DeploymentMachine
+	for {
+		resp, err := clients.TaskAgentClient.GetDeploymentTargets(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			break
+		}
+		targets = append(targets, resp.Value...)
+		if resp.ContinuationToken == "" {
+			break
+		}
+		args.ContinuationToken = converter.String(resp.ContinuationToken)
+	}
+	return targets, nil
+}
+
+func flattenDeploymentTargets(targets []taskagent.DeploymentMachine) []interface{} {
+	results := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		output := map[string]interface{}{}
+		if target.Id != nil {
+			output["id"] = *target.Id
+		}
+		if target.Tags != nil {
+			output["tags"] = *target.Tags
+		}
+		if agent := target.Agent; agent != nil {
+			if agent.Id != nil {
+				output["agent_id"] = *agent.Id
+			}
+			output["name"] = converter.ToString(agent.Name, "")
+			output["enabled"] = converter.ToBool(agent.Enabled, false)
+			output["version"] = converter.ToString(agent.Version, "")
+			output["os_description"] = converter.ToString(agent.OsDescription, "")
+			if agent.Status != nil {
+				output["status"] = string(*agent.Status)
+			}
+		}
+		results = append(results, output)
+	}
+	return results
+}
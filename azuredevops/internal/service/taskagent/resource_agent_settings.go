@@ -0,0 +1,206 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/taskagentextras"
+)
+
+// ResourceAgentSettings schema and implementation for the settings of an existing agent
+func ResourceAgentSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgentSettingsCreate,
+		ReadContext:   resourceAgentSettingsRead,
+		UpdateContext: resourceAgentSettingsUpdate,
+		DeleteContext: resourceAgentSettingsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				poolId, agentId, err := parseAgentSettingsId(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("pool_id", poolId)
+				d.Set("agent_id", agentId)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"agent_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"user_capabilities": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"agent_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAgentSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	poolId := d.Get("pool_id").(int)
+	agentName := d.Get("agent_name").(string)
+	agents, err := clients.TaskAgentClient.GetAgents(ctx, taskagent.GetAgentsArgs{
+		PoolId:    converter.Int(poolId),
+		AgentName: converter.String(agentName),
+	})
+	if err != nil {
+		return diag.Errorf(" Finding agent %s in pool %d: %+v", agentName, poolId, err)
+	}
+	if agents == nil || len(*agents) == 0 || (*agents)[0].Id == nil {
+		return diag.Errorf(" Agent %s was not found in pool %d", agentName, poolId)
+	}
+
+	agentId := *(*agents)[0].Id
+	if err := updateAgentSettings(ctx, clients, poolId, agentId, d.Get("enabled").(bool), tfhelper.ExpandStringMap(d.Get("user_capabilities"))); err != nil {
+		return diag.Errorf(" Updating settings of agent %s in pool %d: %+v", agentName, poolId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d", poolId, agentId))
+	return resourceAgentSettingsRead(ctx, d, m)
+}
+
+func resourceAgentSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	poolId, agentId, err := parseAgentSettingsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agent, err := clients.TaskAgentClient.GetAgent(ctx, taskagent.GetAgentArgs{
+		PoolId:              converter.Int(poolId),
+		AgentId:             converter.Int(agentId),
+		IncludeCapabilities: converter.Bool(true),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading agent. Pool ID: %d, Agent ID: %d, Error: %+v", poolId, agentId, err)
+	}
+
+	if agent == nil || agent.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("pool_id", poolId)
+	d.Set("agent_id", *agent.Id)
+	d.Set("agent_name", converter.ToString(agent.Name, ""))
+	d.Set("enabled", converter.ToBool(agent.Enabled, false))
+	if agent.UserCapabilities != nil {
+		d.Set("user_capabilities", *agent.UserCapabilities)
+	} else {
+		d.Set("user_capabilities", map[string]string{})
+	}
+	return nil
+}
+
+func resourceAgentSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	poolId, agentId, err := parseAgentSettingsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateAgentSettings(ctx, clients, poolId, agentId, d.Get("enabled").(bool), tfhelper.ExpandStringMap(d.Get("user_capabilities"))); err != nil {
+		return diag.Errorf(" Updating settings of agent. Pool ID: %d, Agent ID: %d, Error: %+v", poolId, agentId, err)
+	}
+	return resourceAgentSettingsRead(ctx, d, m)
+}
+
+func resourceAgentSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	poolId, agentId, err := parseAgentSettingsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Restore the defaults of a freshly registered agent: enabled without user capabilities
+	err = updateAgentSettings(ctx, clients, poolId, agentId, true, map[string]string{})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Resetting settings of agent. Pool ID: %d, Agent ID: %d, Error: %+v", poolId, agentId, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func updateAgentSettings(ctx context.Context, clients *client.AggregatedClient, poolId, agentId int, enabled bool, userCapabilities map[string]string) error {
+	_, err := clients.TaskAgentClient.UpdateAgent(ctx, taskagent.UpdateAgentArgs{
+		PoolId:  converter.Int(poolId),
+		AgentId: converter.Int(agentId),
+		Agent: &taskagent.TaskAgent{
+			Id:      converter.Int(agentId),
+			Enabled: converter.Bool(enabled),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.TaskAgentClientExtras.UpdateAgentUserCapabilities(ctx, taskagentextras.UpdateAgentUserCapabilitiesArgs{
+		PoolId:           converter.Int(poolId),
+		AgentId:          converter.Int(agentId),
+		UserCapabilities: &userCapabilities,
+	})
+	return err
+}
+
+func parseAgentSettingsId(id string) (int, int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected ID format %q, expected <poolID>/<agentID>", id)
+	}
+	poolId, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("agent pool ID was expected to be integer, but was not: %+v", err)
+	}
+	agentId, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("agent ID was expected to be integer, but was not: %+v", err)
+	}
+	return poolId, agentId, nil
+}
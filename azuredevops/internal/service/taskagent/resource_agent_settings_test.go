@@ -0,0 +1,90 @@
+//go:build (all || resource_agent_settings) && !exclude_resource_agent_settings
+// +build all resource_agent_settings
+// +build !exclude_resource_agent_settings
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/taskagentextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAgentSettings_Create_AgentNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentSettings().Schema, nil)
+	resourceData.Set("pool_id", 10)
+	resourceData.Set("agent_name", "agent-01")
+
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.Ctx, gomock.Any()).
+		Return(&[]taskagent.TaskAgent{}, nil).
+		Times(1)
+
+	diags := resourceAgentSettingsCreate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "was not found")
+}
+
+func TestAgentSettings_Update_DoesNotSwallowCapabilitiesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	taskAgentClientExtras := azdosdkmocks.NewMockTaskagentextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		TaskAgentClientExtras: taskAgentClientExtras,
+		Ctx:                   context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentSettings().Schema, nil)
+	resourceData.SetId("10/5")
+	resourceData.Set("enabled", false)
+	resourceData.Set("user_capabilities", map[string]interface{}{"docker": "true"})
+
+	taskAgentClient.
+		EXPECT().
+		UpdateAgent(clients.Ctx, taskagent.UpdateAgentArgs{
+			PoolId:  converter.Int(10),
+			AgentId: converter.Int(5),
+			Agent: &taskagent.TaskAgent{
+				Id:      converter.Int(5),
+				Enabled: converter.Bool(false),
+			},
+		}).
+		Return(&taskagent.TaskAgent{}, nil).
+		Times(1)
+
+	taskAgentClientExtras.
+		EXPECT().
+		UpdateAgentUserCapabilities(clients.Ctx, taskagentextras.UpdateAgentUserCapabilitiesArgs{
+			PoolId:           converter.Int(10),
+			AgentId:          converter.Int(5),
+			UserCapabilities: &map[string]string{"docker": "true"},
+		}).
+		Return(nil, errors.New("UpdateAgentUserCapabilities() Failed")).
+		Times(1)
+
+	diags := resourceAgentSettingsUpdate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "UpdateAgentUserCapabilities() Failed")
+}
@@ -0,0 +1,187 @@
+package taskagent
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceDeploymentGroup schema and implementation for deployment group resource
+func ResourceDeploymentGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentGroupCreate,
+		ReadContext:   resourceDeploymentGroupRead,
+		UpdateContext: resourceDeploymentGroupUpdate,
+		DeleteContext: resourceDeploymentGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"pool_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"machine_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"machine_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDeploymentGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	parameter := &taskagent.DeploymentGroupCreateParameter{
+		Name:        converter.String(d.Get("name").(string)),
+		Description: converter.String(d.Get("description").(string)),
+	}
+	if v, ok := d.GetOk("pool_id"); ok {
+		parameter.PoolId = converter.Int(v.(int))
+	}
+
+	projectId := d.Get("project_id").(string)
+	deploymentGroup, err := clients.TaskAgentClient.AddDeploymentGroup(ctx, taskagent.AddDeploymentGroupArgs{
+		Project:         converter.String(projectId),
+		DeploymentGroup: parameter,
+	})
+	if err != nil {
+		return diag.Errorf(" Creating deployment group in Azure DevOps. Project ID: %s, Error: %+v", projectId, err)
+	}
+
+	d.SetId(strconv.Itoa(*deploymentGroup.Id))
+	return resourceDeploymentGroupRead(ctx, d, m)
+}
+
+func resourceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing deployment group ID: %+v", err)
+	}
+
+	projectId := d.Get("project_id").(string)
+	deploymentGroup, err := clients.TaskAgentClient.GetDeploymentGroup(ctx, taskagent.GetDeploymentGroupArgs{
+		Project:           converter.String(projectId),
+		DeploymentGroupId: &deploymentGroupId,
+		Expand:            &taskagent.DeploymentGroupExpandsValues.Tags,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading deployment group. Project ID: %s, Deployment Group ID: %d, Error: %+v", projectId, deploymentGroupId, err)
+	}
+
+	if deploymentGroup == nil || deploymentGroup.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenDeploymentGroup(d, deploymentGroup)
+	return nil
+}
+
+func resourceDeploymentGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing deployment group ID: %+v", err)
+	}
+
+	projectId := d.Get("project_id").(string)
+	_, err = clients.TaskAgentClient.UpdateDeploymentGroup(ctx, taskagent.UpdateDeploymentGroupArgs{
+		Project:           converter.String(projectId),
+		DeploymentGroupId: &deploymentGroupId,
+		DeploymentGroup: &taskagent.DeploymentGroupUpdateParameter{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Updating deployment group. Project ID: %s, Deployment Group ID: %d, Error: %+v", projectId, deploymentGroupId, err)
+	}
+
+	return resourceDeploymentGroupRead(ctx, d, m)
+}
+
+func resourceDeploymentGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing deployment group ID: %+v", err)
+	}
+
+	projectId := d.Get("project_id").(string)
+	err = clients.TaskAgentClient.DeleteDeploymentGroup(ctx, taskagent.DeleteDeploymentGroupArgs{
+		Project:           converter.String(projectId),
+		DeploymentGroupId: &deploymentGroupId,
+	})
+	if err != nil {
+		return diag.Errorf(" Deleting deployment group. Project ID: %s, Deployment Group ID: %d, Error: %+v", projectId, deploymentGroupId, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenDeploymentGroup(d *schema.ResourceData, deploymentGroup *taskagent.DeploymentGroup) {
+	d.SetId(strconv.Itoa(*deploymentGroup.Id))
+	if deploymentGroup.Project != nil && deploymentGroup.Project.Id != nil {
+		d.Set("project_id", deploymentGroup.Project.Id.String())
+	}
+	d.Set("name", converter.ToString(deploymentGroup.Name, ""))
+	d.Set("description", converter.ToString(deploymentGroup.Description, ""))
+	if deploymentGroup.Pool != nil && deploymentGroup.Pool.Id != nil {
+		d.Set("pool_id", *deploymentGroup.Pool.Id)
+	}
+	if deploymentGroup.MachineCount != nil {
+		d.Set("machine_count", *deploymentGroup.MachineCount)
+	}
+	if deploymentGroup.MachineTags != nil {
+		d.Set("machine_tags", *deploymentGroup.MachineTags)
+	} else {
+		d.Set("machine_tags", []string{})
+	}
+}
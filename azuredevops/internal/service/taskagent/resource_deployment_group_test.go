@@ -0,0 +1,136 @@
+//go:build (all || resource_deployment_group) && !exclude_resource_deployment_group
+// +build all resource_deployment_group
+// +build !exclude_resource_deployment_group
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testDeploymentGroupProjectId = uuid.New()
+
+var testDeploymentGroup = taskagent.DeploymentGroup{
+	Id:           converter.Int(7),
+	Name:         converter.String("DeploymentGroupName"),
+	Description:  converter.String("Managed by Terraform"),
+	MachineCount: converter.Int(2),
+	MachineTags:  &[]string{"web", "prod"},
+	Pool: &taskagent.TaskAgentPoolReference{
+		Id: converter.Int(42),
+	},
+	Project: &taskagent.ProjectReference{
+		Id: &testDeploymentGroupProjectId,
+	},
+}
+
+func TestDeploymentGroup_Flatten(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, nil)
+	flattenDeploymentGroup(resourceData, &testDeploymentGroup)
+
+	require.Equal(t, "7", resourceData.Id())
+	require.Equal(t, testDeploymentGroupProjectId.String(), resourceData.Get("project_id"))
+	require.Equal(t, "DeploymentGroupName", resourceData.Get("name"))
+	require.Equal(t, "Managed by Terraform", resourceData.Get("description"))
+	require.Equal(t, 42, resourceData.Get("pool_id"))
+	require.Equal(t, 2, resourceData.Get("machine_count"))
+	require.ElementsMatch(t, []interface{}{"web", "prod"}, resourceData.Get("machine_tags").(*schema.Set).List())
+}
+
+func TestDeploymentGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, nil)
+	resourceData.Set("project_id", testDeploymentGroupProjectId.String())
+	resourceData.Set("name", *testDeploymentGroup.Name)
+	resourceData.Set("pool_id", 42)
+
+	expectedArgs := taskagent.AddDeploymentGroupArgs{
+		Project: converter.String(testDeploymentGroupProjectId.String()),
+		DeploymentGroup: &taskagent.DeploymentGroupCreateParameter{
+			Name:        testDeploymentGroup.Name,
+			Description: converter.String(""),
+			PoolId:      converter.Int(42),
+		},
+	}
+
+	taskAgentClient.
+		EXPECT().
+		AddDeploymentGroup(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("AddDeploymentGroup() Failed")).
+		Times(1)
+
+	diags := resourceDeploymentGroupCreate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "AddDeploymentGroup() Failed")
+}
+
+func TestDeploymentGroup_Read_RemovesFromStateIfNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, nil)
+	flattenDeploymentGroup(resourceData, &testDeploymentGroup)
+
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroup(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	diags := resourceDeploymentGroupRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestDeploymentGroupTargets_Flatten(t *testing.T) {
+	targets := []taskagent.DeploymentMachine{
+		{
+			Id:   converter.Int(1),
+			Tags: &[]string{"web"},
+			Agent: &taskagent.TaskAgent{
+				Id:            converter.Int(11),
+				Name:          converter.String("vm-01"),
+				Enabled:       converter.Bool(true),
+				Version:       converter.String("3.236.1"),
+				OsDescription: converter.String("Linux"),
+				Status:        &taskagent.TaskAgentStatusValues.Online,
+			},
+		},
+	}
+
+	flattened := flattenDeploymentTargets(targets)
+	require.Len(t, flattened, 1)
+
+	target := flattened[0].(map[string]interface{})
+	require.Equal(t, 1, target["id"])
+	require.Equal(t, 11, target["agent_id"])
+	require.Equal(t, "vm-01", target["name"])
+	require.Equal(t, "online", target["status"])
+	require.Equal(t, true, target["enabled"])
+	require.Equal(t, []string{"web"}, target["tags"])
+}
@@ -0,0 +1,160 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceDeploymentTargetTags schema and implementation for the tags of a deployment group target
+func ResourceDeploymentTargetTags() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentTargetTagsCreateUpdate,
+		ReadContext:   resourceDeploymentTargetTagsRead,
+		UpdateContext: resourceDeploymentTargetTagsCreateUpdate,
+		DeleteContext: resourceDeploymentTargetTagsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				id := strings.Split(d.Id(), "/")
+				if len(id) != 3 {
+					return nil, fmt.Errorf("unexpected ID format, expected <projectID>/<deploymentGroupID>/<targetID>")
+				}
+				projectId, err := tfhelper.GetRealProjectId(id[0], meta)
+				if err != nil {
+					return nil, err
+				}
+				deploymentGroupId, err := strconv.Atoi(id[1])
+				if err != nil {
+					return nil, fmt.Errorf("deployment group ID was expected to be integer, but was not: %+v", err)
+				}
+				targetId, err := strconv.Atoi(id[2])
+				if err != nil {
+					return nil, fmt.Errorf("deployment target ID was expected to be integer, but was not: %+v", err)
+				}
+				d.Set("project_id", projectId)
+				d.Set("deployment_group_id", deploymentGroupId)
+				d.Set("target_id", targetId)
+				d.SetId(fmt.Sprintf("%d/%d", deploymentGroupId, targetId))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"deployment_group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"target_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func resourceDeploymentTargetTagsCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupId := d.Get("deployment_group_id").(int)
+	targetId := d.Get("target_id").(int)
+	tags := tfhelper.ExpandStringSet(d.Get("tags").(*schema.Set))
+	if err := updateDeploymentTargetTags(ctx, clients, d.Get("project_id").(string), deploymentGroupId, targetId, tags); err != nil {
+		return diag.Errorf(" Updating tags of deployment target. Deployment Group ID: %d, Target ID: %d, Error: %+v", deploymentGroupId, targetId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d", deploymentGroupId, targetId))
+	return resourceDeploymentTargetTagsRead(ctx, d, m)
+}
+
+func resourceDeploymentTargetTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupId := d.Get("deployment_group_id").(int)
+	targetId := d.Get("target_id").(int)
+	target, err := clients.TaskAgentClient.GetDeploymentTarget(ctx, taskagent.GetDeploymentTargetArgs{
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: &deploymentGroupId,
+		TargetId:          &targetId,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading deployment target. Deployment Group ID: %d, Target ID: %d, Error: %+v", deploymentGroupId, targetId, err)
+	}
+
+	if target == nil || target.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if target.Tags != nil {
+		d.Set("tags", *target.Tags)
+	} else {
+		d.Set("tags", []string{})
+	}
+	return nil
+}
+
+func resourceDeploymentTargetTagsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupId := d.Get("deployment_group_id").(int)
+	targetId := d.Get("target_id").(int)
+	err := updateDeploymentTargetTags(ctx, clients, d.Get("project_id").(string), deploymentGroupId, targetId, []string{})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Removing tags from deployment target. Deployment Group ID: %d, Target ID: %d, Error: %+v", deploymentGroupId, targetId, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func updateDeploymentTargetTags(ctx context.Context, clients *client.AggregatedClient, projectId string, deploymentGroupId, targetId int, tags []string) error {
+	_, err := clients.TaskAgentClient.UpdateDeploymentTargets(ctx, taskagent.UpdateDeploymentTargetsArgs{
+		Project:           converter.String(projectId),
+		DeploymentGroupId: &deploymentGroupId,
+		Machines: &[]taskagent.DeploymentTargetUpdateParameter{
+			{
+				Id:   &targetId,
+				Tags: &tags,
+			},
+		},
+	})
+	return err
+}
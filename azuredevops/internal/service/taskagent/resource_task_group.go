@@ -0,0 +1,604 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/taskagentextras"
+)
+
+const (
+	taskGroupDefinitionTypeTask     = "task"
+	taskGroupDefinitionTypeMetaTask = "metaTask"
+)
+
+// ResourceTaskGroup schema and implementation for task group resource
+func ResourceTaskGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTaskGroupCreate,
+		ReadContext:   resourceTaskGroupRead,
+		UpdateContext: resourceTaskGroupUpdate,
+		DeleteContext: resourceTaskGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if d.Id() == "" || !d.HasChange("major_version") {
+				return nil
+			}
+			oldVersion, newVersion := d.GetChange("major_version")
+			if newVersion.(int) < oldVersion.(int) {
+				return fmt.Errorf(" `major_version` cannot be decreased from %d to %d", oldVersion.(int), newVersion.(int))
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Deploy",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Build", "Deploy", "Package", "Test", "Utility",
+				}, false),
+			},
+			"runs_on": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Agent", "DeploymentGroup", "Server", "ServerGate"}, false),
+				},
+			},
+			"instance_name_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "string",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"help_markdown": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"options": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"task": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"definition_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  taskGroupDefinitionTypeTask,
+							ValidateFunc: validation.StringInSlice([]string{
+								taskGroupDefinitionTypeTask,
+								taskGroupDefinitionTypeMetaTask,
+							}, false),
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"always_run": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"continue_on_error": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"condition": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "succeeded()",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"retry_count_on_task_failure": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 10),
+						},
+						"inputs": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"major_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"disable_prior_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTaskGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	tasks, err := expandTaskGroupSteps(d.Get("task").([]interface{}))
+	if err != nil {
+		return diag.Errorf(" Expanding task group tasks: %+v", err)
+	}
+
+	majorVersion := 1
+	if v, ok := d.GetOk("major_version"); ok {
+		majorVersion = v.(int)
+	}
+
+	projectId := d.Get("project_id").(string)
+	taskGroup, err := clients.TaskAgentClient.AddTaskGroup(ctx, taskagent.AddTaskGroupArgs{
+		Project: converter.String(projectId),
+		TaskGroup: &taskagent.TaskGroupCreateParameter{
+			Name:               converter.String(d.Get("name").(string)),
+			Description:        converter.String(d.Get("description").(string)),
+			Category:           converter.String(d.Get("category").(string)),
+			Author:             expandOptionalString(d, "author"),
+			InstanceNameFormat: expandTaskGroupInstanceNameFormat(d),
+			RunsOn:             expandTaskGroupRunsOn(d),
+			Inputs:             expandTaskGroupInputs(d.Get("input").([]interface{})),
+			Tasks:              tasks,
+			Version:            newTaskGroupVersion(majorVersion),
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Creating task group in Azure DevOps. Project ID: %s, Error: %+v", projectId, err)
+	}
+
+	d.SetId(taskGroup.Id.String())
+
+	if d.Get("preview").(bool) {
+		if err := updateTaskGroupPreview(ctx, clients, d, taskGroup); err != nil {
+			return diag.Errorf(" Marking task group %s as preview: %+v", d.Id(), err)
+		}
+	}
+	return resourceTaskGroupRead(ctx, d, m)
+}
+
+func resourceTaskGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	taskGroup, err := getTaskGroup(ctx, clients, d.Get("project_id").(string), d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading task group %s: %+v", d.Id(), err)
+	}
+
+	if taskGroup == nil || converter.ToBool(taskGroup.Deleted, false) {
+		d.SetId("")
+		return nil
+	}
+
+	if err := flattenTaskGroup(d, taskGroup); err != nil {
+		return diag.Errorf(" Flattening task group %s: %+v", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceTaskGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	taskGroupId, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing task group ID: %+v", err)
+	}
+
+	tasks, err := expandTaskGroupSteps(d.Get("task").([]interface{}))
+	if err != nil {
+		return diag.Errorf(" Expanding task group tasks: %+v", err)
+	}
+
+	projectId := d.Get("project_id").(string)
+	current, err := getTaskGroup(ctx, clients, projectId, d.Id())
+	if err != nil {
+		return diag.Errorf(" Reading task group %s: %+v", d.Id(), err)
+	}
+	if current == nil {
+		return diag.Errorf(" Task group %s no longer exists", d.Id())
+	}
+
+	version := current.Version
+	if d.HasChange("major_version") {
+		version = newTaskGroupVersion(d.Get("major_version").(int))
+	}
+
+	taskGroup, err := clients.TaskAgentClient.UpdateTaskGroup(ctx, taskagent.UpdateTaskGroupArgs{
+		Project:     converter.String(projectId),
+		TaskGroupId: &taskGroupId,
+		TaskGroup: &taskagent.TaskGroupUpdateParameter{
+			Id:                 &taskGroupId,
+			Revision:           current.Revision,
+			Name:               converter.String(d.Get("name").(string)),
+			Description:        converter.String(d.Get("description").(string)),
+			Category:           converter.String(d.Get("category").(string)),
+			Comment:            converter.String(d.Get("comment").(string)),
+			Author:             expandOptionalString(d, "author"),
+			InstanceNameFormat: expandTaskGroupInstanceNameFormat(d),
+			RunsOn:             expandTaskGroupRunsOn(d),
+			Inputs:             expandTaskGroupInputs(d.Get("input").([]interface{})),
+			Tasks:              tasks,
+			Version:            version,
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Updating task group %s: %+v", d.Id(), err)
+	}
+
+	if d.HasChanges("preview", "major_version") || converter.ToBool(taskGroup.Preview, false) != d.Get("preview").(bool) {
+		if err := updateTaskGroupPreview(ctx, clients, d, taskGroup); err != nil {
+			return diag.Errorf(" Updating preview state of task group %s: %+v", d.Id(), err)
+		}
+	}
+	return resourceTaskGroupRead(ctx, d, m)
+}
+
+func resourceTaskGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	taskGroupId, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing task group ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteTaskGroup(ctx, taskagent.DeleteTaskGroupArgs{
+		Project:     converter.String(d.Get("project_id").(string)),
+		TaskGroupId: &taskGroupId,
+		Comment:     converter.String(d.Get("comment").(string)),
+	})
+	if err != nil {
+		return diag.Errorf(" Deleting task group %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getTaskGroup returns the latest major version of a task group
+func getTaskGroup(ctx context.Context, clients *client.AggregatedClient, projectId, id string) (*taskagent.TaskGroup, error) {
+	taskGroupId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("parsing task group ID: %+v", err)
+	}
+
+	taskGroups, err := clients.TaskAgentClient.GetTaskGroups(ctx, taskagent.GetTaskGroupsArgs{
+		Project:     converter.String(projectId),
+		TaskGroupId: &taskGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if taskGroups == nil {
+		return nil, nil
+	}
+
+	var latest *taskagent.TaskGroup
+	for i := range *taskGroups {
+		taskGroup := (*taskGroups)[i]
+		if latest == nil || taskGroupMajorVersion(&taskGroup) > taskGroupMajorVersion(latest) {
+			latest = &taskGroup
+		}
+	}
+	return latest, nil
+}
+
+func updateTaskGroupPreview(ctx context.Context, clients *client.AggregatedClient, d *schema.ResourceData, taskGroup *taskagent.TaskGroup) error {
+	_, err := clients.TaskAgentClientExtras.UpdateTaskGroupProperties(ctx, taskagentextras.UpdateTaskGroupPropertiesArgs{
+		Project:              converter.String(d.Get("project_id").(string)),
+		TaskGroupId:          taskGroup.Id,
+		DisablePriorVersions: converter.Bool(d.Get("disable_prior_versions").(bool)),
+		TaskGroupUpdateProperties: &taskagent.TaskGroupPublishPreviewParameter{
+			Comment:  converter.String(d.Get("comment").(string)),
+			Preview:  converter.Bool(d.Get("preview").(bool)),
+			Revision: taskGroup.Revision,
+			Version:  taskGroup.Version,
+		},
+	})
+	return err
+}
+
+func taskGroupMajorVersion(taskGroup *taskagent.TaskGroup) int {
+	if taskGroup.Version == nil || taskGroup.Version.Major == nil {
+		return 0
+	}
+	return *taskGroup.Version.Major
+}
+
+func newTaskGroupVersion(major int) *taskagent.TaskVersion {
+	return &taskagent.TaskVersion{
+		Major:  converter.Int(major),
+		Minor:  converter.Int(0),
+		Patch:  converter.Int(0),
+		IsTest: converter.Bool(false),
+	}
+}
+
+func expandOptionalString(d *schema.ResourceData, key string) *string {
+	if v, ok := d.GetOk(key); ok {
+		return converter.String(v.(string))
+	}
+	return nil
+}
+
+func expandTaskGroupInstanceNameFormat(d *schema.ResourceData) *string {
+	if v, ok := d.GetOk("instance_name_format"); ok {
+		return converter.String(v.(string))
+	}
+	return converter.String(fmt.Sprintf("Task group: %s", d.Get("name").(string)))
+}
+
+func expandTaskGroupRunsOn(d *schema.ResourceData) *[]string {
+	if v, ok := d.GetOk("runs_on"); ok {
+		runsOn := tfhelper.ExpandStringSet(v.(*schema.Set))
+		return &runsOn
+	}
+	return &[]string{"Agent", "DeploymentGroup"}
+}
+
+func expandTaskGroupInputs(input []interface{}) *[]taskagent.TaskInputDefinition {
+	inputs := make([]taskagent.TaskInputDefinition, 0, len(input))
+	for _, raw := range input {
+		inputMap := raw.(map[string]interface{})
+		name := inputMap["name"].(string)
+		label := inputMap["label"].(string)
+		if label == "" {
+			label = name
+		}
+		definition := taskagent.TaskInputDefinition{
+			Name:         converter.String(name),
+			Label:        converter.String(label),
+			Type:         converter.String(inputMap["type"].(string)),
+			DefaultValue: converter.String(inputMap["default_value"].(string)),
+			Required:     converter.Bool(inputMap["required"].(bool)),
+			HelpMarkDown: converter.String(inputMap["help_markdown"].(string)),
+		}
+		if options := tfhelper.ExpandStringMap(inputMap["options"]); len(options) > 0 {
+			definition.Options = &options
+		}
+		inputs = append(inputs, definition)
+	}
+	return &inputs
+}
+
+func expandTaskGroupSteps(input []interface{}) (*[]taskagent.TaskGroupStep, error) {
+	steps := make([]taskagent.TaskGroupStep, 0, len(input))
+	for _, raw := range input {
+		stepMap := raw.(map[string]interface{})
+		taskId, err := uuid.Parse(stepMap["task_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing task ID %q: %+v", stepMap["task_id"].(string), err)
+		}
+		inputs := tfhelper.ExpandStringMap(stepMap["inputs"])
+		environment := tfhelper.ExpandStringMap(stepMap["environment"])
+		steps = append(steps, taskagent.TaskGroupStep{
+			Task: &taskagent.TaskDefinitionReference{
+				Id:             &taskId,
+				VersionSpec:    converter.String(stepMap["version"].(string)),
+				DefinitionType: converter.String(stepMap["definition_type"].(string)),
+			},
+			DisplayName:             converter.String(stepMap["display_name"].(string)),
+			Enabled:                 converter.Bool(stepMap["enabled"].(bool)),
+			AlwaysRun:               converter.Bool(stepMap["always_run"].(bool)),
+			ContinueOnError:         converter.Bool(stepMap["continue_on_error"].(bool)),
+			Condition:               converter.String(stepMap["condition"].(string)),
+			TimeoutInMinutes:        converter.Int(stepMap["timeout_in_minutes"].(int)),
+			RetryCountOnTaskFailure: converter.Int(stepMap["retry_count_on_task_failure"].(int)),
+			Inputs:                  &inputs,
+			Environment:             &environment,
+		})
+	}
+	return &steps, nil
+}
+
+func flattenTaskGroup(d *schema.ResourceData, taskGroup *taskagent.TaskGroup) error {
+	d.Set("name", converter.ToString(taskGroup.Name, ""))
+	d.Set("description", converter.ToString(taskGroup.Description, ""))
+	d.Set("category", converter.ToString(taskGroup.Category, ""))
+	d.Set("author", converter.ToString(taskGroup.Author, ""))
+	d.Set("instance_name_format", converter.ToString(taskGroup.InstanceNameFormat, ""))
+	d.Set("preview", converter.ToBool(taskGroup.Preview, false))
+	if taskGroup.RunsOn != nil {
+		d.Set("runs_on", *taskGroup.RunsOn)
+	}
+	if taskGroup.Revision != nil {
+		d.Set("revision", *taskGroup.Revision)
+	}
+	if v := taskGroup.Version; v != nil {
+		d.Set("major_version", taskGroupMajorVersion(taskGroup))
+		d.Set("version", fmt.Sprintf("%d.%d.%d", converter.ToInt(v.Major, 0), converter.ToInt(v.Minor, 0), converter.ToInt(v.Patch, 0)))
+	}
+	if err := d.Set("input", flattenTaskGroupInputs(taskGroup.Inputs)); err != nil {
+		return fmt.Errorf("setting input: %+v", err)
+	}
+	if err := d.Set("task", flattenTaskGroupSteps(taskGroup.Tasks)); err != nil {
+		return fmt.Errorf("setting task: %+v", err)
+	}
+	return nil
+}
+
+func flattenTaskGroupInputs(inputs *[]taskagent.TaskInputDefinition) []interface{} {
+	if inputs == nil {
+		return []interface{}{}
+	}
+	results := make([]interface{}, 0, len(*inputs))
+	for _, input := range *inputs {
+		output := map[string]interface{}{
+			"name":          converter.ToString(input.Name, ""),
+			"label":         converter.ToString(input.Label, ""),
+			"type":          converter.ToString(input.Type, ""),
+			"default_value": converter.ToString(input.DefaultValue, ""),
+			"required":      converter.ToBool(input.Required, false),
+			"help_markdown": converter.ToString(input.HelpMarkDown, ""),
+		}
+		if input.Options != nil {
+			output["options"] = *input.Options
+		}
+		results = append(results, output)
+	}
+	return results
+}
+
+func flattenTaskGroupSteps(steps *[]taskagent.TaskGroupStep) []interface{} {
+	if steps == nil {
+		return []interface{}{}
+	}
+	results := make([]interface{}, 0, len(*steps))
+	for _, step := range *steps {
+		output := map[string]interface{}{
+			"display_name":                converter.ToString(step.DisplayName, ""),
+			"enabled":                     converter.ToBool(step.Enabled, true),
+			"always_run":                  converter.ToBool(step.AlwaysRun, false),
+			"continue_on_error":           converter.ToBool(step.ContinueOnError, false),
+			"condition":                   converter.ToString(step.Condition, ""),
+			"timeout_in_minutes":          converter.ToInt(step.TimeoutInMinutes, 0),
+			"retry_count_on_task_failure": converter.ToInt(step.RetryCountOnTaskFailure, 0),
+		}
+		if step.Task != nil {
+			if step.Task.Id != nil {
+				output["task_id"] = step.Task.Id.String()
+			}
+			output["version"] = converter.ToString(step.Task.VersionSpec, "")
+			output["definition_type"] = converter.ToString(step.Task.DefinitionType, taskGroupDefinitionTypeTask)
+		}
+		if step.Inputs != nil {
+			output["inputs"] = *step.Inputs
+		}
+		if step.Environment != nil {
+			output["environment"] = *step.Environment
+		}
+		results = append(results, output)
+	}
+	return results
+}
@@ -0,0 +1,166 @@
+//go:build (all || resource_task_group) && !exclude_resource_task_group
+// +build all resource_task_group
+// +build !exclude_resource_task_group
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testTaskGroupProjectId = uuid.New()
+	testTaskGroupId        = uuid.New()
+	testTaskGroupTaskId    = uuid.New()
+)
+
+func testTaskGroup(major int) taskagent.TaskGroup {
+	return taskagent.TaskGroup{
+		Id:                 &testTaskGroupId,
+		Name:               converter.String("TaskGroupName"),
+		Description:        converter.String("Managed by Terraform"),
+		Category:           converter.String("Deploy"),
+		InstanceNameFormat: converter.String("Task group: TaskGroupName"),
+		RunsOn:             &[]string{"Agent"},
+		Revision:           converter.Int(3),
+		Preview:            converter.Bool(false),
+		Version:            newTaskGroupVersion(major),
+		Inputs: &[]taskagent.TaskInputDefinition{
+			{
+				Name:         converter.String("message"),
+				Label:        converter.String("message"),
+				Type:         converter.String("string"),
+				DefaultValue: converter.String("hello"),
+				Required:     converter.Bool(true),
+			},
+		},
+		Tasks: &[]taskagent.TaskGroupStep{
+			{
+				DisplayName: converter.String("Echo"),
+				Enabled:     converter.Bool(true),
+				Condition:   converter.String("succeeded()"),
+				Inputs:      &map[string]string{"script": "echo $(message)"},
+				Task: &taskagent.TaskDefinitionReference{
+					Id:             &testTaskGroupTaskId,
+					VersionSpec:    converter.String("2.*"),
+					DefinitionType: converter.String("task"),
+				},
+			},
+		},
+	}
+}
+
+func TestTaskGroup_ExpandFlatten_Roundtrip(t *testing.T) {
+	taskGroup := testTaskGroup(2)
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, nil)
+	require.NoError(t, flattenTaskGroup(resourceData, &taskGroup))
+
+	require.Equal(t, 2, resourceData.Get("major_version"))
+	require.Equal(t, "2.0.0", resourceData.Get("version"))
+	require.Equal(t, 3, resourceData.Get("revision"))
+
+	tasks, err := expandTaskGroupSteps(resourceData.Get("task").([]interface{}))
+	require.NoError(t, err)
+	require.Len(t, *tasks, 1)
+	require.Equal(t, testTaskGroupTaskId, *(*tasks)[0].Task.Id)
+	require.Equal(t, "2.*", *(*tasks)[0].Task.VersionSpec)
+	require.Equal(t, "echo $(message)", (*(*tasks)[0].Inputs)["script"])
+
+	inputs := expandTaskGroupInputs(resourceData.Get("input").([]interface{}))
+	require.Len(t, *inputs, 1)
+	require.Equal(t, "message", *(*inputs)[0].Name)
+	require.Equal(t, "hello", *(*inputs)[0].DefaultValue)
+	require.True(t, *(*inputs)[0].Required)
+}
+
+func TestTaskGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	taskGroup := testTaskGroup(1)
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, nil)
+	require.NoError(t, flattenTaskGroup(resourceData, &taskGroup))
+	resourceData.Set("project_id", testTaskGroupProjectId.String())
+
+	taskAgentClient.
+		EXPECT().
+		AddTaskGroup(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("AddTaskGroup() Failed")).
+		Times(1)
+
+	diags := resourceTaskGroupCreate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "AddTaskGroup() Failed")
+}
+
+func TestTaskGroup_Read_UsesLatestMajorVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, nil)
+	resourceData.SetId(testTaskGroupId.String())
+	resourceData.Set("project_id", testTaskGroupProjectId.String())
+
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+			Project:     converter.String(testTaskGroupProjectId.String()),
+			TaskGroupId: &testTaskGroupId,
+		}).
+		Return(&[]taskagent.TaskGroup{testTaskGroup(1), testTaskGroup(3), testTaskGroup(2)}, nil).
+		Times(1)
+
+	diags := resourceTaskGroupRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, 3, resourceData.Get("major_version"))
+}
+
+func TestTaskGroup_Read_RemovesFromStateIfDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, nil)
+	resourceData.SetId(testTaskGroupId.String())
+	resourceData.Set("project_id", testTaskGroupProjectId.String())
+
+	deleted := testTaskGroup(1)
+	deleted.Deleted = converter.Bool(true)
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, gomock.Any()).
+		Return(&[]taskagent.TaskGroup{deleted}, nil).
+		Times(1)
+
+	diags := resourceTaskGroupRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
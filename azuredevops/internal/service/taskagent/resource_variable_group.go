@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -82,9 +83,10 @@ func ResourceVariableGroup() *schema.Resource {
 				Default:  false,
 			},
 			"variable": {
-				Type:     schema.TypeSet,
-				Required: true,
-				MinItems: 1,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				AtLeastOneOf: []string{"variable", "secret_variable"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -125,6 +127,32 @@ func ResourceVariableGroup() *schema.Resource {
 					},
 				},
 			},
+			// Set blocks cannot hold write-only arguments, hence the secret variables with a write-only value live in
+			// their own list block
+			"secret_variable": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"key_vault"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"value_wo": {
+							Type:      schema.TypeString,
+							Required:  true,
+							WriteOnly: true,
+							Sensitive: true,
+						},
+						"value_wo_version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"key_vault": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -351,6 +379,15 @@ func deleteVariableGroup(clients *client.AggregatedClient, projectId *string, va
 	return err
 }
 
+// rawVariableIterator iterates over the `variable` blocks of the raw configuration, which are absent when the variable
+// group only holds secret variables
+func rawVariableIterator(rawVariables cty.Value) cty.ElementIterator {
+	if !rawVariables.IsKnown() || rawVariables.IsNull() {
+		return cty.ListValEmpty(cty.DynamicPseudoType).ElementIterator()
+	}
+	return rawVariables.ElementIterator()
+}
+
 // Convert internal Terraform data structure to an AzDO data structure
 func expandVariableGroupParameters(clients *client.AggregatedClient, d *schema.ResourceData) (*taskagent.VariableGroupParameters, *string, error) {
 	projectID := converter.String(d.Get("project_id").(string))
@@ -360,7 +397,11 @@ func expandVariableGroupParameters(clients *client.AggregatedClient, d *schema.R
 
 	// needed to detect if the secret_value attribute is set in the config
 	// see https://github.com/hashicorp/terraform-plugin-sdk/issues/741
-	for it := d.GetRawConfig().AsValueMap()["variable"].ElementIterator(); it.Next(); {
+	rawVariables := cty.NullVal(cty.DynamicPseudoType)
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		rawVariables = rawConfig.GetAttr("variable")
+	}
+	for it := rawVariableIterator(rawVariables); it.Next(); {
 		_, ctyVariable := it.Element()
 		ctyVariableAsMap := ctyVariable.AsValueMap()
 		name := ctyVariableAsMap["name"].AsString()
@@ -392,6 +433,20 @@ func expandVariableGroupParameters(clients *client.AggregatedClient, d *schema.R
 		}
 	}
 
+	for i, variable := range d.Get("secret_variable").([]interface{}) {
+		asMap := variable.(map[string]interface{})
+		name := asMap["name"].(string)
+		if _, ok := variableMap[name]; ok {
+			return nil, nil, fmt.Errorf("`%s` variable is defined both as a `variable` and as a `secret_variable`", name)
+		}
+
+		value, _ := tfhelper.GetWriteOnlyString(d, cty.GetAttrPath("secret_variable").IndexInt(i).GetAttr("value_wo"))
+		variableMap[name] = taskagent.VariableValue{
+			Value:    converter.String(value),
+			IsSecret: converter.Bool(true),
+		}
+	}
+
 	projectUUId, err := uuid.Parse(*projectID)
 	if err != nil {
 		return nil, nil, err
@@ -465,6 +520,13 @@ func flattenVariableGroup(d *schema.ResourceData, variableGroup *taskagent.Varia
 		return err
 	}
 
+	// the data source does not have the `secret_variable` blocks
+	if _, ok := d.Get("secret_variable").([]interface{}); ok {
+		if err = d.Set("secret_variable", flattenSecretVariables(d, variableGroup)); err != nil {
+			return err
+		}
+	}
+
 	if isKeyVaultVariableGroupType(variableGroup.Type) {
 		keyVault, err := flattenKeyVault(d, variableGroup)
 		if err != nil {
@@ -482,32 +544,41 @@ func isKeyVaultVariableGroupType(variableGrouptype *string) bool {
 	return variableGrouptype != nil && *variableGrouptype == azureKeyVaultType
 }
 
-// Convert AzDO Variables data structure to Terraform TypeSet
+// Convert AzDO Variables data structure to Terraform TypeSet, the variables of the `secret_variable` blocks excepted
 //
 // Note: The AzDO API does not return the value for variables marked as a secret. For this reason
 //
 //	variables marked as secret will need to be pulled from the state itself
 func flattenVariables(d *schema.ResourceData, variableGroup *taskagent.VariableGroup) (interface{}, error) {
-	variables := make([]map[string]interface{}, len(*variableGroup.Variables))
+	secretVariables := map[string]bool{}
+	if secretVariableBlocks, ok := d.Get("secret_variable").([]interface{}); ok {
+		for _, variable := range secretVariableBlocks {
+			secretVariables[variable.(map[string]interface{})["name"].(string)] = true
+		}
+	}
 
-	index := 0
+	variables := make([]map[string]interface{}, 0, len(*variableGroup.Variables))
 	for varName, varVal := range *variableGroup.Variables {
+		if secretVariables[varName] {
+			continue
+		}
+
 		variableAsJSON, err := json.Marshal(varVal)
 		if err != nil {
 			return nil, fmt.Errorf("Unable to marshal variable into JSON: %+v", err)
 		}
 
+		var variable map[string]interface{}
 		if isKeyVaultVariableGroupType(variableGroup.Type) {
-			variables[index], err = flattenKeyVaultVariable(variableAsJSON, varName)
+			variable, err = flattenKeyVaultVariable(variableAsJSON, varName)
 		} else {
-			variables[index], err = flattenVariable(d, variableAsJSON, varName)
+			variable, err = flattenVariable(d, variableAsJSON, varName)
 		}
 
 		if err != nil {
 			return nil, err
 		}
-
-		index++
+		variables = append(variables, variable)
 	}
 
 	return variables, nil
@@ -783,3 +854,23 @@ func getSkipToken(link *string) (string, error) {
 	// if skip token not found, just return "" as the skip token
 	return "", nil
 }
+
+// Convert the secret variables with a write-only value to the `secret_variable` blocks. Neither the value nor its
+// version is returned by the API, the blocks from the state are kept as long as the variable still exists.
+func flattenSecretVariables(d *schema.ResourceData, variableGroup *taskagent.VariableGroup) []interface{} {
+	secretVariables := []interface{}{}
+	if variableGroup.Variables == nil {
+		return secretVariables
+	}
+
+	for _, variable := range d.Get("secret_variable").([]interface{}) {
+		asMap := variable.(map[string]interface{})
+		if _, ok := (*variableGroup.Variables)[asMap["name"].(string)]; ok {
+			secretVariables = append(secretVariables, map[string]interface{}{
+				"name":             asMap["name"],
+				"value_wo_version": asMap["value_wo_version"],
+			})
+		}
+	}
+	return secretVariables
+}
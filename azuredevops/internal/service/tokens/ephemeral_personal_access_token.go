@@ -0,0 +1,246 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	sdktokens "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/tokens"
+)
+
+// privateKeyPersonalAccessToken is the private data key of the data needed to revoke the token on close.
+const privateKeyPersonalAccessToken = "personal_access_token"
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &personalAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &personalAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &personalAccessTokenEphemeralResource{}
+)
+
+// NewPersonalAccessTokenEphemeralResource schema and implementation for the personal access token ephemeral resource.
+// The token is issued when the ephemeral resource is opened and revoked when it is closed.
+func NewPersonalAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &personalAccessTokenEphemeralResource{}
+}
+
+type personalAccessTokenEphemeralResource struct {
+	clients *client.AggregatedClient
+}
+
+type personalAccessTokenModel struct {
+	DisplayName      types.String `tfsdk:"display_name"`
+	Scope            types.Set    `tfsdk:"scope"`
+	ValidTo          types.String `tfsdk:"valid_to"`
+	AllOrganizations types.Bool   `tfsdk:"all_organizations"`
+	AuthorizationId  types.String `tfsdk:"authorization_id"`
+	ValidFrom        types.String `tfsdk:"valid_from"`
+	Token            types.String `tfsdk:"token"`
+}
+
+// personalAccessTokenPrivate is the private data needed to revoke the token on close.
+type personalAccessTokenPrivate struct {
+	OrganizationName string `json:"organization_name"`
+	AuthorizationId  string `json:"authorization_id"`
+}
+
+func (r *personalAccessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_personal_access_token"
+}
+
+func (r *personalAccessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a personal access token of the authenticated user, which is revoked once Terraform no longer needs it.",
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The display name of the personal access token.",
+			},
+			"scope": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The scopes of the personal access token, e.g. `vso.code_write`.",
+			},
+			"valid_to": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The expiry of the personal access token in RFC3339 format.",
+			},
+			"all_organizations": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the personal access token is valid for all organizations of the user.",
+			},
+			"authorization_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The authorization ID of the personal access token.",
+			},
+			"valid_from": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the personal access token became valid in RFC3339 format.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The personal access token.",
+			},
+		},
+	}
+}
+
+func (r *personalAccessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.AggregatedClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *client.AggregatedClient, got %T", req.ProviderData))
+		return
+	}
+	r.clients = clients
+}
+
+func (r *personalAccessTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config personalAccessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.DisplayName.IsUnknown() && !config.DisplayName.IsNull() && strings.TrimSpace(config.DisplayName.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("display_name"), "Invalid display_name", "display_name must not be empty")
+	}
+
+	if !config.Scope.IsUnknown() && !config.Scope.IsNull() && len(config.Scope.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("scope"), "Invalid scope", "at least one scope must be specified")
+	}
+
+	if !config.ValidTo.IsUnknown() && !config.ValidTo.IsNull() {
+		if _, err := time.Parse(time.RFC3339, config.ValidTo.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("valid_to"), "Invalid valid_to", fmt.Sprintf("valid_to must be in RFC3339 format: %+v", err))
+		}
+	}
+}
+
+func (r *personalAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data personalAccessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, err := expandPersonalAccessToken(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Reading the personal access token configuration", err.Error())
+		return
+	}
+
+	organizationName, err := utils.GetOrganizationName(r.clients.OrganizationURL)
+	if err != nil {
+		resp.Diagnostics.AddError("Issuing personal access token", err.Error())
+		return
+	}
+
+	result, err := r.clients.TokensClient.CreatePersonalAccessToken(ctx, sdktokens.CreatePersonalAccessTokenArgs{
+		OrganizationName: &organizationName,
+		Token:            request,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Issuing personal access token", err.Error())
+		return
+	}
+	if result.PatTokenError != nil && !strings.EqualFold(*result.PatTokenError, "none") {
+		resp.Diagnostics.AddError("Issuing personal access token", fmt.Sprintf("the service returned %s", *result.PatTokenError))
+		return
+	}
+	if result.PatToken == nil || result.PatToken.AuthorizationId == nil {
+		resp.Diagnostics.AddError("Issuing personal access token", "the service did not return a token")
+		return
+	}
+
+	private, err := json.Marshal(personalAccessTokenPrivate{
+		OrganizationName: organizationName,
+		AuthorizationId:  *result.PatToken.AuthorizationId,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Storing the personal access token authorization ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyPersonalAccessToken, private)...)
+
+	flattenPersonalAccessToken(&data, result.PatToken)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *personalAccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, privateKeyPersonalAccessToken)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var data personalAccessTokenPrivate
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError("Reading the personal access token authorization ID", err.Error())
+		return
+	}
+
+	err := r.clients.TokensClient.RevokePersonalAccessToken(ctx, sdktokens.RevokePersonalAccessTokenArgs{
+		OrganizationName: &data.OrganizationName,
+		AuthorizationId:  &data.AuthorizationId,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Revoking personal access token %s", data.AuthorizationId), err.Error())
+	}
+}
+
+func expandPersonalAccessToken(ctx context.Context, data personalAccessTokenModel) (*sdktokens.PatTokenCreateRequest, error) {
+	request := &sdktokens.PatTokenCreateRequest{
+		DisplayName: data.DisplayName.ValueStringPointer(),
+		AllOrgs:     data.AllOrganizations.ValueBoolPointer(),
+	}
+
+	var scopes []string
+	if diags := data.Scope.ElementsAs(ctx, &scopes, false); diags.HasError() {
+		return nil, fmt.Errorf("reading scope: %s", diags.Errors()[0].Detail())
+	}
+	sort.Strings(scopes)
+	request.Scope = converter.String(strings.Join(scopes, " "))
+
+	if !data.ValidTo.IsNull() && !data.ValidTo.IsUnknown() {
+		t, err := time.Parse(time.RFC3339, data.ValidTo.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("parsing valid_to: %+v", err)
+		}
+		request.ValidTo = &azuredevops.Time{Time: t}
+	}
+	return request, nil
+}
+
+func flattenPersonalAccessToken(data *personalAccessTokenModel, token *sdktokens.PatToken) {
+	data.AuthorizationId = types.StringPointerValue(token.AuthorizationId)
+	data.Token = types.StringPointerValue(token.Token)
+	data.ValidFrom = types.StringPointerValue(formatTime(token.ValidFrom))
+	if token.ValidTo != nil {
+		data.ValidTo = types.StringPointerValue(formatTime(token.ValidTo))
+	} else if data.ValidTo.IsUnknown() {
+		data.ValidTo = types.StringNull()
+	}
+}
+
+func formatTime(t *azuredevops.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return converter.String(t.Time.Format(time.RFC3339))
+}
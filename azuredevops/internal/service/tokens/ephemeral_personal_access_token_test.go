@@ -0,0 +1,243 @@
+//go:build (all || ephemeral_personal_access_token) && !exclude_ephemeral_personal_access_token
+// +build all ephemeral_personal_access_token
+// +build !exclude_ephemeral_personal_access_token
+
+package tokens
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	sdktokens "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/tokens"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const personalAccessTokenTypeName = "azuredevops_personal_access_token"
+
+// testProvider serves the ephemeral resource with the given clients over protocol v6
+type testProvider struct {
+	clients *client.AggregatedClient
+}
+
+func (p *testProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "azuredevops"
+}
+
+func (p *testProvider) Schema(context.Context, provider.SchemaRequest, *provider.SchemaResponse) {}
+
+func (p *testProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.EphemeralResourceData = p.clients
+}
+
+func (p *testProvider) Resources(context.Context) []func() resource.Resource { return nil }
+
+func (p *testProvider) DataSources(context.Context) []func() datasource.DataSource { return nil }
+
+func (p *testProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{NewPersonalAccessTokenEphemeralResource}
+}
+
+func newTestServer(t *testing.T, clients *client.AggregatedClient) (tfprotov6.ProviderServer, tftypes.Object) {
+	server := providerserver.NewProtocol6(&testProvider{clients: clients})()
+
+	providerSchema, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, providerSchema.Diagnostics)
+
+	config, err := tfprotov6.NewDynamicValue(providerSchema.Provider.ValueType(), tftypes.NewValue(providerSchema.Provider.ValueType(), map[string]tftypes.Value{}))
+	require.NoError(t, err)
+	resp, err := server.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{Config: &config})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	return server, providerSchema.EphemeralResourceSchemas[personalAccessTokenTypeName].ValueType().(tftypes.Object)
+}
+
+func personalAccessTokenConfig(t *testing.T, typ tftypes.Object, attrs map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	values := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, v := range attrs {
+		require.Contains(t, values, name)
+		values[name] = v
+	}
+	config, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+	require.NoError(t, err)
+	return &config
+}
+
+func scopeValue(scopes ...string) tftypes.Value {
+	values := make([]tftypes.Value, 0, len(scopes))
+	for _, scope := range scopes {
+		values = append(values, tftypes.NewValue(tftypes.String, scope))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values)
+}
+
+func TestPersonalAccessToken_ValidateConfig(t *testing.T) {
+	server, typ := newTestServer(t, &client.AggregatedClient{})
+
+	validate := func(attrs map[string]tftypes.Value) []*tfprotov6.Diagnostic {
+		resp, err := server.ValidateEphemeralResourceConfig(context.Background(), &tfprotov6.ValidateEphemeralResourceConfigRequest{
+			TypeName: personalAccessTokenTypeName,
+			Config:   personalAccessTokenConfig(t, typ, attrs),
+		})
+		require.NoError(t, err)
+		return resp.Diagnostics
+	}
+
+	require.Empty(t, validate(map[string]tftypes.Value{
+		"display_name": tftypes.NewValue(tftypes.String, "pipeline"),
+		"scope":        scopeValue("vso.code"),
+		"valid_to":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}))
+
+	diags := validate(map[string]tftypes.Value{
+		"display_name": tftypes.NewValue(tftypes.String, "pipeline"),
+		"scope":        scopeValue(),
+	})
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Detail, "at least one scope")
+
+	diags = validate(map[string]tftypes.Value{
+		"display_name": tftypes.NewValue(tftypes.String, "pipeline"),
+		"scope":        scopeValue("vso.code"),
+		"valid_to":     tftypes.NewValue(tftypes.String, "tomorrow"),
+	})
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Detail, "RFC3339")
+}
+
+func TestPersonalAccessToken_OpenAndClose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokensClient := azdosdkmocks.NewMockTokensClient(ctrl)
+	clients := &client.AggregatedClient{
+		OrganizationURL: "https://dev.azure.com/myorg",
+		TokensClient:    tokensClient,
+		Ctx:             context.Background(),
+	}
+	server, typ := newTestServer(t, clients)
+
+	validTo := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	tokensClient.
+		EXPECT().
+		CreatePersonalAccessToken(gomock.Any(), sdktokens.CreatePersonalAccessTokenArgs{
+			OrganizationName: converter.String("myorg"),
+			Token: &sdktokens.PatTokenCreateRequest{
+				DisplayName: converter.String("pipeline"),
+				Scope:       converter.String("vso.code vso.packaging"),
+				ValidTo:     &azuredevops.Time{Time: validTo},
+			},
+		}).
+		Return(&sdktokens.PatTokenResult{
+			PatToken: &sdktokens.PatToken{
+				AuthorizationId: converter.String("00000000-0000-0000-0000-000000000001"),
+				DisplayName:     converter.String("pipeline"),
+				Token:           converter.String("secret"),
+				ValidFrom:       &azuredevops.Time{Time: validTo.Add(-time.Hour)},
+				ValidTo:         &azuredevops.Time{Time: validTo},
+			},
+			PatTokenError: converter.String("none"),
+		}, nil).
+		Times(1)
+
+	openResp, err := server.OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: personalAccessTokenTypeName,
+		Config: personalAccessTokenConfig(t, typ, map[string]tftypes.Value{
+			"display_name": tftypes.NewValue(tftypes.String, "pipeline"),
+			"scope":        scopeValue("vso.packaging", "vso.code"),
+			"valid_to":     tftypes.NewValue(tftypes.String, validTo.Format(time.RFC3339)),
+		}),
+	})
+	require.NoError(t, err)
+	require.Empty(t, openResp.Diagnostics)
+
+	result, err := openResp.Result.Unmarshal(typ)
+	require.NoError(t, err)
+	attrs := map[string]tftypes.Value{}
+	require.NoError(t, result.As(&attrs))
+	var token, authorizationId, validFrom string
+	require.NoError(t, attrs["token"].As(&token))
+	require.NoError(t, attrs["authorization_id"].As(&authorizationId))
+	require.NoError(t, attrs["valid_from"].As(&validFrom))
+	require.Equal(t, "secret", token)
+	require.Equal(t, "00000000-0000-0000-0000-000000000001", authorizationId)
+	require.Equal(t, "2030-01-02T02:04:05Z", validFrom)
+	require.NotContains(t, string(openResp.Private), "secret", "The token must not be kept in the private data")
+
+	tokensClient.
+		EXPECT().
+		RevokePersonalAccessToken(gomock.Any(), sdktokens.RevokePersonalAccessTokenArgs{
+			OrganizationName: converter.String("myorg"),
+			AuthorizationId:  converter.String("00000000-0000-0000-0000-000000000001"),
+		}).
+		Return(nil).
+		Times(1)
+
+	closeResp, err := server.CloseEphemeralResource(context.Background(), &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: personalAccessTokenTypeName,
+		Private:  openResp.Private,
+	})
+	require.NoError(t, err)
+	require.Empty(t, closeResp.Diagnostics)
+}
+
+func TestPersonalAccessToken_Open_ServiceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokensClient := azdosdkmocks.NewMockTokensClient(ctrl)
+	clients := &client.AggregatedClient{
+		OrganizationURL: "https://myorg.visualstudio.com/",
+		TokensClient:    tokensClient,
+		Ctx:             context.Background(),
+	}
+	server, typ := newTestServer(t, clients)
+
+	req := &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: personalAccessTokenTypeName,
+		Config: personalAccessTokenConfig(t, typ, map[string]tftypes.Value{
+			"display_name": tftypes.NewValue(tftypes.String, "pipeline"),
+			"scope":        scopeValue("vso.code"),
+		}),
+	}
+
+	tokensClient.
+		EXPECT().
+		CreatePersonalAccessToken(gomock.Any(), gomock.Any()).
+		Return(&sdktokens.PatTokenResult{PatTokenError: converter.String("invalidScope")}, nil).
+		Times(1)
+
+	resp, err := server.OpenEphemeralResource(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	require.Contains(t, resp.Diagnostics[0].Detail, "invalidScope")
+
+	tokensClient.
+		EXPECT().
+		CreatePersonalAccessToken(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("CreatePersonalAccessToken() Failed")).
+		Times(1)
+
+	resp, err = server.OpenEphemeralResource(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	require.Contains(t, resp.Diagnostics[0].Detail, "CreatePersonalAccessToken() Failed")
+}
@@ -0,0 +1,93 @@
+package work
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// concurrentModificationError explains a rejected update of an object which was changed since it was read
+func concurrentModificationError(err error, object string) error {
+	if utils.ResponseWasStatusCode(err, http.StatusPreconditionFailed) || utils.ResponseWasStatusCode(err, http.StatusConflict) {
+		return fmt.Errorf("the %s was modified by someone else while it was being updated, run `terraform apply` again to reconcile it: %+v", object, err)
+	}
+	return err
+}
+
+// filterClauseSchema the field conditions of card style rules and delivery plan criteria
+func filterClauseSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"field_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"operator": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"logical_operator": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "And",
+				ValidateFunc: validation.StringInSlice([]string{"And", "Or"}, false),
+			},
+		},
+	}
+}
+
+// expandFilterClauses returns the clauses together with the equivalent filter expression
+func expandFilterClauses(configured []interface{}) ([]work.FilterClause, string) {
+	clauses := make([]work.FilterClause, 0, len(configured))
+	filter := make([]string, 0, len(configured))
+	for i, raw := range configured {
+		c := raw.(map[string]interface{})
+		clause := work.FilterClause{
+			FieldName: converter.String(c["field_name"].(string)),
+			Index:     converter.Int(i + 1),
+			Operator:  converter.String(c["operator"].(string)),
+			Value:     converter.String(c["value"].(string)),
+		}
+		condition := fmt.Sprintf("[%s] %s '%s'", c["field_name"].(string), c["operator"].(string), strings.ReplaceAll(c["value"].(string), "'", "''"))
+		if i > 0 {
+			clause.LogicalOperator = converter.String(c["logical_operator"].(string))
+			condition = strings.ToLower(c["logical_operator"].(string)) + " " + condition
+		}
+		clauses = append(clauses, clause)
+		filter = append(filter, condition)
+	}
+	return clauses, strings.Join(filter, " ")
+}
+
+func flattenFilterClauses(clauses *[]work.FilterClause) []interface{} {
+	results := []interface{}{}
+	if clauses == nil {
+		return results
+	}
+	for _, clause := range *clauses {
+		// the logical operator of the first clause has no meaning and is reported as the default
+		logicalOperator := "And"
+		if len(results) > 0 && strings.EqualFold(converter.ToString(clause.LogicalOperator, ""), "Or") {
+			logicalOperator = "Or"
+		}
+		results = append(results, map[string]interface{}{
+			"field_name":       converter.ToString(clause.FieldName, ""),
+			"operator":         converter.ToString(clause.Operator, ""),
+			"value":            converter.ToString(clause.Value, ""),
+			"logical_operator": logicalOperator,
+		})
+	}
+	return results
+}
@@ -0,0 +1,416 @@
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workextras"
+)
+
+const markerDateFormat = "2006-01-02"
+
+// ResourceDeliveryPlan schema and implementation for a delivery plan spanning the backlogs of several teams
+func ResourceDeliveryPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeliveryPlanCreate,
+		ReadContext:   resourceDeliveryPlanRead,
+		UpdateContext: resourceDeliveryPlanUpdate,
+		DeleteContext: resourceDeliveryPlanDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"team": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"backlog_level": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     filterClauseSchema(),
+			},
+			"marker": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMarkerDate,
+						},
+						"label": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"color": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "#0078D4",
+							ValidateFunc: validation.StringMatch(regexpColor, "must be a hex color, e.g. `#FF0000`"),
+						},
+					},
+				},
+			},
+			"card": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"show_id": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"show_assigned_to": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"assigned_to_display_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(work.IdentityDisplayFormatValues.AvatarAndFullName),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(work.IdentityDisplayFormatValues.AvatarOnly),
+								string(work.IdentityDisplayFormatValues.FullName),
+								string(work.IdentityDisplayFormatValues.AvatarAndFullName),
+							}, false),
+						},
+						"show_state": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"show_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"show_parent": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"show_child_rollup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"show_empty_fields": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeliveryPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+
+	plan, err := clients.WorkClient.CreatePlan(ctx, work.CreatePlanArgs{
+		Project: converter.String(projectId),
+		PostedPlan: &work.CreatePlan{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+			Type:        &work.PlanTypeValues.DeliveryTimelineView,
+			Properties:  expandDeliveryPlanProperties(d, nil),
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Creating delivery plan. Project ID: %s, Error: %+v", projectId, err)
+	}
+
+	d.SetId(plan.Id.String())
+	return resourceDeliveryPlanRead(ctx, d, m)
+}
+
+func resourceDeliveryPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+
+	plan, err := clients.WorkClient.GetPlan(ctx, work.GetPlanArgs{
+		Project: converter.String(projectId),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading delivery plan. Project ID: %s, Plan ID: %s, Error: %+v", projectId, d.Id(), err)
+	}
+
+	properties, err := convertDeliveryPlanProperties(plan.Properties)
+	if err != nil {
+		return diag.Errorf(" Reading the properties of delivery plan. Project ID: %s, Plan ID: %s, Error: %+v", projectId, d.Id(), err)
+	}
+
+	d.Set("name", converter.ToString(plan.Name, ""))
+	d.Set("description", converter.ToString(plan.Description, ""))
+	d.Set("revision", converter.ToInt(plan.Revision, 0))
+	d.Set("url", converter.ToString(plan.Url, ""))
+	if err := flattenDeliveryPlanProperties(d, properties); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceDeliveryPlanUpdate sends the revision which was read last, the service rejects the update if the plan was
+// changed in the meantime
+func resourceDeliveryPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+
+	plan, err := clients.WorkClient.GetPlan(ctx, work.GetPlanArgs{
+		Project: converter.String(projectId),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		return diag.Errorf(" Reading delivery plan. Project ID: %s, Plan ID: %s, Error: %+v", projectId, d.Id(), err)
+	}
+	current, err := convertDeliveryPlanProperties(plan.Properties)
+	if err != nil {
+		return diag.Errorf(" Reading the properties of delivery plan. Project ID: %s, Plan ID: %s, Error: %+v", projectId, d.Id(), err)
+	}
+
+	_, err = clients.WorkClient.UpdatePlan(ctx, work.UpdatePlanArgs{
+		Project: converter.String(projectId),
+		Id:      converter.String(d.Id()),
+		UpdatedPlan: &work.UpdatePlan{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+			Type:        &work.PlanTypeValues.DeliveryTimelineView,
+			Revision:    converter.Int(d.Get("revision").(int)),
+			Properties:  expandDeliveryPlanProperties(d, current),
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Updating delivery plan. Project ID: %s, Plan ID: %s, Error: %+v", projectId, d.Id(), concurrentModificationError(err, "delivery plan"))
+	}
+	return resourceDeliveryPlanRead(ctx, d, m)
+}
+
+func resourceDeliveryPlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+
+	err := clients.WorkClient.DeletePlan(ctx, work.DeletePlanArgs{
+		Project: converter.String(projectId),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Deleting delivery plan. Project ID: %s, Plan ID: %s, Error: %+v", projectId, d.Id(), err)
+	}
+	return nil
+}
+
+func validateMarkerDate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if _, err := time.Parse(markerDateFormat, v); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a date in the format YYYY-MM-DD, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// convertDeliveryPlanProperties converts the untyped properties of a plan
+func convertDeliveryPlanProperties(properties interface{}) (*workextras.DeliveryViewPropertyCollection, error) {
+	result := workextras.DeliveryViewPropertyCollection{}
+	if properties == nil {
+		return &result, nil
+	}
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// expandDeliveryPlanProperties builds the plan properties. The card style rules and the tag colors, which can only be
+// set in the web UI, as well as the fields shown on the cards are taken over from the current properties.
+func expandDeliveryPlanProperties(d *schema.ResourceData, current *workextras.DeliveryViewPropertyCollection) *workextras.DeliveryViewPropertyCollection {
+	properties := workextras.DeliveryViewPropertyCollection{}
+	if current != nil {
+		properties.StyleSettings = current.StyleSettings
+		properties.TagStyleSettings = current.TagStyleSettings
+		properties.CardSettings = current.CardSettings
+	}
+
+	mappings := []work.TeamBacklogMapping{}
+	for _, raw := range d.Get("team").([]interface{}) {
+		t := raw.(map[string]interface{})
+		teamId := uuid.MustParse(t["team_id"].(string))
+		mappings = append(mappings, work.TeamBacklogMapping{
+			TeamId:                &teamId,
+			CategoryReferenceName: converter.String(t["backlog_level"].(string)),
+		})
+	}
+	properties.TeamBacklogMappings = &mappings
+
+	criteria, _ := expandFilterClauses(d.Get("criteria").([]interface{}))
+	properties.Criteria = &criteria
+
+	markers := []work.Marker{}
+	for _, raw := range d.Get("marker").([]interface{}) {
+		mk := raw.(map[string]interface{})
+		// the date is validated by the schema
+		date, _ := time.Parse(markerDateFormat, mk["date"].(string))
+		markers = append(markers, work.Marker{
+			Date:  &azuredevops.Time{Time: date},
+			Label: converter.String(mk["label"].(string)),
+			Color: converter.String(mk["color"].(string)),
+		})
+	}
+	properties.Markers = &markers
+
+	if v, ok := d.GetOk("card"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		c := v.([]interface{})[0].(map[string]interface{})
+		fields := work.CardFieldSettings{}
+		if properties.CardSettings != nil && properties.CardSettings.Fields != nil {
+			fields = *properties.CardSettings.Fields
+		}
+		displayFormat := work.IdentityDisplayFormat(c["assigned_to_display_format"].(string))
+		fields.ShowId = converter.Bool(c["show_id"].(bool))
+		fields.ShowAssignedTo = converter.Bool(c["show_assigned_to"].(bool))
+		fields.AssignedToDisplayFormat = &displayFormat
+		fields.ShowState = converter.Bool(c["show_state"].(bool))
+		fields.ShowTags = converter.Bool(c["show_tags"].(bool))
+		fields.ShowParent = converter.Bool(c["show_parent"].(bool))
+		fields.ShowChildRollup = converter.Bool(c["show_child_rollup"].(bool))
+		fields.ShowEmptyFields = converter.Bool(c["show_empty_fields"].(bool))
+		properties.CardSettings = &work.CardSettings{Fields: &fields}
+	}
+	return &properties
+}
+
+func flattenDeliveryPlanProperties(d *schema.ResourceData, properties *workextras.DeliveryViewPropertyCollection) error {
+	teams := []interface{}{}
+	if properties.TeamBacklogMappings != nil {
+		for _, mapping := range *properties.TeamBacklogMappings {
+			teamId := ""
+			if mapping.TeamId != nil {
+				teamId = mapping.TeamId.String()
+			}
+			teams = append(teams, map[string]interface{}{
+				"team_id":       teamId,
+				"backlog_level": converter.ToString(mapping.CategoryReferenceName, ""),
+			})
+		}
+	}
+	if err := d.Set("team", teams); err != nil {
+		return fmt.Errorf(" Setting team: %+v", err)
+	}
+
+	if err := d.Set("criteria", flattenFilterClauses(properties.Criteria)); err != nil {
+		return fmt.Errorf(" Setting criteria: %+v", err)
+	}
+
+	markers := []interface{}{}
+	if properties.Markers != nil {
+		for _, marker := range *properties.Markers {
+			date := ""
+			if marker.Date != nil {
+				date = marker.Date.Time.UTC().Format(markerDateFormat)
+			}
+			markers = append(markers, map[string]interface{}{
+				"date":  date,
+				"label": converter.ToString(marker.Label, ""),
+				"color": converter.ToString(marker.Color, ""),
+			})
+		}
+	}
+	if err := d.Set("marker", markers); err != nil {
+		return fmt.Errorf(" Setting marker: %+v", err)
+	}
+
+	cards := []interface{}{}
+	if properties.CardSettings != nil && properties.CardSettings.Fields != nil {
+		fields := properties.CardSettings.Fields
+		displayFormat := string(work.IdentityDisplayFormatValues.AvatarAndFullName)
+		if fields.AssignedToDisplayFormat != nil {
+			displayFormat = string(*fields.AssignedToDisplayFormat)
+		}
+		cards = append(cards, map[string]interface{}{
+			"show_id":                    converter.ToBool(fields.ShowId, false),
+			"show_assigned_to":           converter.ToBool(fields.ShowAssignedTo, false),
+			"assigned_to_display_format": displayFormat,
+			"show_state":                 converter.ToBool(fields.ShowState, false),
+			"show_tags":                  converter.ToBool(fields.ShowTags, false),
+			"show_parent":                converter.ToBool(fields.ShowParent, false),
+			"show_child_rollup":          converter.ToBool(fields.ShowChildRollup, false),
+			"show_empty_fields":          converter.ToBool(fields.ShowEmptyFields, false),
+		})
+	}
+	if err := d.Set("card", cards); err != nil {
+		return fmt.Errorf(" Setting card: %+v", err)
+	}
+	return nil
+}
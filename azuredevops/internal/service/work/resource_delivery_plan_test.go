@@ -0,0 +1,132 @@
+//go:build (all || resource_delivery_plan) && !exclude_resource_delivery_plan
+// +build all resource_delivery_plan
+// +build !exclude_resource_delivery_plan
+
+package work
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testDeliveryPlanProjectID = "e7a5f3b1-3d5a-4b0e-9a55-0e0a4f0d7c21"
+	testDeliveryPlanTeamID    = "1b6e3a0c-2f4d-4d8e-b6a1-7c9d5e2f8a13"
+	testDeliveryPlanID        = "3c0d4b7e-9f1a-4d2b-8e6c-5a7f9b1d2e4c"
+)
+
+func getDeliveryPlanResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceDeliveryPlan().Schema, map[string]interface{}{
+		"project_id": testDeliveryPlanProjectID,
+		"name":       "Release train",
+		"team": []interface{}{
+			map[string]interface{}{
+				"team_id":       testDeliveryPlanTeamID,
+				"backlog_level": "Microsoft.RequirementCategory",
+			},
+		},
+		"criteria": []interface{}{
+			map[string]interface{}{"field_name": "System.Tags", "operator": "contains", "value": "release"},
+		},
+		"marker": []interface{}{
+			map[string]interface{}{"date": "2026-03-31", "label": "Release"},
+		},
+		"card": []interface{}{
+			map[string]interface{}{"show_tags": false, "assigned_to_display_format": "avatarOnly"},
+		},
+	})
+}
+
+func TestDeliveryPlan_ExpandProperties_KeepsStylesAndFields(t *testing.T) {
+	resourceData := getDeliveryPlanResourceData(t)
+	styles := []workextras.Rule{{Name: converter.String("Blocked"), Settings: &map[string]string{"background-color": "#FF0000"}}}
+	coreFields := []work.FieldInfo{{ReferenceName: converter.String("System.Title")}}
+
+	properties := expandDeliveryPlanProperties(resourceData, &workextras.DeliveryViewPropertyCollection{
+		StyleSettings: &styles,
+		CardSettings:  &work.CardSettings{Fields: &work.CardFieldSettings{CoreFields: &coreFields}},
+	})
+
+	require.Equal(t, &styles, properties.StyleSettings)
+	require.Equal(t, &coreFields, properties.CardSettings.Fields.CoreFields)
+	require.False(t, *properties.CardSettings.Fields.ShowTags)
+	require.True(t, *properties.CardSettings.Fields.ShowId)
+	require.Equal(t, work.IdentityDisplayFormatValues.AvatarOnly, *properties.CardSettings.Fields.AssignedToDisplayFormat)
+	require.Len(t, *properties.TeamBacklogMappings, 1)
+	require.Equal(t, testDeliveryPlanTeamID, (*properties.TeamBacklogMappings)[0].TeamId.String())
+	require.Len(t, *properties.Criteria, 1)
+	require.Equal(t, "2026-03-31", (*properties.Markers)[0].Date.Time.Format(markerDateFormat))
+	require.Equal(t, "#0078D4", *(*properties.Markers)[0].Color)
+}
+
+func TestDeliveryPlan_Properties_RoundTrip(t *testing.T) {
+	resourceData := getDeliveryPlanResourceData(t)
+
+	// the properties of a plan are untyped in the SDK, the service returns them as plain JSON objects
+	properties, err := convertDeliveryPlanProperties(expandDeliveryPlanProperties(resourceData, nil))
+	require.NoError(t, err)
+
+	flattened := schema.TestResourceDataRaw(t, ResourceDeliveryPlan().Schema, map[string]interface{}{})
+	require.NoError(t, flattenDeliveryPlanProperties(flattened, properties))
+	for _, key := range []string{"team", "criteria", "marker", "card"} {
+		require.Equal(t, resourceData.Get(key), flattened.Get(key), key)
+	}
+}
+
+func TestDeliveryPlan_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	resourceData := getDeliveryPlanResourceData(t)
+	mockClient.EXPECT().CreatePlan(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args work.CreatePlanArgs) (*work.Plan, error) {
+			require.Equal(t, testDeliveryPlanProjectID, *args.Project)
+			require.Equal(t, work.PlanTypeValues.DeliveryTimelineView, *args.PostedPlan.Type)
+			return nil, errors.New("CreatePlan() Failed")
+		}).Times(1)
+
+	diags := resourceDeliveryPlanCreate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "CreatePlan() Failed")
+}
+
+func TestDeliveryPlan_Update_ReportsConcurrentChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	resourceData := getDeliveryPlanResourceData(t)
+	resourceData.SetId(testDeliveryPlanID)
+	resourceData.Set("revision", 3)
+
+	mockClient.EXPECT().GetPlan(clients.Ctx, gomock.Any()).Return(&work.Plan{Revision: converter.Int(4)}, nil).Times(1)
+	mockClient.EXPECT().UpdatePlan(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args work.UpdatePlanArgs) (*work.Plan, error) {
+			require.Equal(t, 3, *args.UpdatedPlan.Revision)
+			return nil, azuredevops.WrappedError{
+				StatusCode: converter.Int(http.StatusConflict),
+				Message:    converter.String("The plan has been updated by another user"),
+			}
+		}).Times(1)
+
+	diags := resourceDeliveryPlanUpdate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "modified by someone else")
+}
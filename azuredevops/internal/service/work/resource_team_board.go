@@ -0,0 +1,752 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workextras"
+)
+
+var regexpColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+const (
+	// the card rule type of the card styles, the other types, e.g. `tagStyle`, are left untouched
+	cardRuleTypeFill = "fill"
+
+	cardSettingFieldIdentifier = "fieldIdentifier"
+	cardSettingDisplayFormat   = "displayFormat"
+	cardSettingShowEmptyFields = "showEmptyFields"
+
+	cardStyleBackgroundColor = "background-color"
+	cardStyleTitleColor      = "title-color"
+	cardStyleTitleBold       = "title-font-weight"
+	cardStyleTitleItalic     = "title-font-style"
+	cardStyleTitleUnderline  = "title-text-decoration"
+)
+
+// ResourceTeamBoard schema and implementation for the Kanban board of a team's backlog level
+func ResourceTeamBoard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamBoardCreateUpdate,
+		ReadContext:   resourceTeamBoardRead,
+		UpdateContext: resourceTeamBoardCreateUpdate,
+		DeleteContext: resourceTeamBoardDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				projectId, teamId, board, err := parseTeamBoardId(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectId)
+				d.Set("team_id", teamId)
+				d.Set("board", board)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"board": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"columns_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rows_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"column": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MinItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"column_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"item_limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"split": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"definition_of_done": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"state_mappings": {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"row": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"color": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexpColor, "must be a hex color, e.g. `#FF0000`"),
+						},
+					},
+				},
+			},
+			"card": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"work_item_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"show_empty_fields": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"field": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"reference_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"display_format": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"AvatarOnly", "FullName", "AvatarAndFullName",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"card_style_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"background_color": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexpColor, "must be a hex color, e.g. `#FF0000`"),
+						},
+						"title_color": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexpColor, "must be a hex color, e.g. `#FF0000`"),
+						},
+						"title_bold": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"title_italic": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"title_underline": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"clause": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     filterClauseSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTeamBoardCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+	teamId := d.Get("team_id").(string)
+	board := d.Get("board").(string)
+
+	// Only the configured parts of the board are updated, the omitted ones are left as they are.
+	if d.HasChange("column") {
+		if err := updateBoardColumns(ctx, clients, d, projectId, teamId, board); err != nil {
+			return diag.Errorf(" Updating board columns. Project ID: %s, Team ID: %s, Board: %s, Error: %+v", projectId, teamId, board, err)
+		}
+	}
+	if d.HasChange("row") {
+		if err := updateBoardRows(ctx, clients, d, projectId, teamId, board); err != nil {
+			return diag.Errorf(" Updating board rows. Project ID: %s, Team ID: %s, Board: %s, Error: %+v", projectId, teamId, board, err)
+		}
+	}
+	if d.HasChange("card") {
+		if err := updateBoardCardSettings(ctx, clients, d, projectId, teamId, board); err != nil {
+			return diag.Errorf(" Updating board card settings. Project ID: %s, Team ID: %s, Board: %s, Error: %+v", projectId, teamId, board, err)
+		}
+	}
+	if d.HasChange("card_style_rule") {
+		if err := updateBoardCardStyleRules(ctx, clients, d, projectId, teamId, board); err != nil {
+			return diag.Errorf(" Updating board card style rules. Project ID: %s, Team ID: %s, Board: %s, Error: %+v", projectId, teamId, board, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", projectId, teamId, board))
+	return resourceTeamBoardRead(ctx, d, m)
+}
+
+func resourceTeamBoardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+	teamId := d.Get("team_id").(string)
+	board := d.Get("board").(string)
+
+	columns, err := clients.WorkClientExtras.GetBoardColumns(ctx, workextras.GetBoardColumnsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(teamId),
+		Board:   converter.String(board),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading board columns. Project ID: %s, Team ID: %s, Board: %s, Error: %+v", projectId, teamId, board, err)
+	}
+
+	rows, err := clients.WorkClientExtras.GetBoardRows(ctx, workextras.GetBoardRowsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(teamId),
+		Board:   converter.String(board),
+	})
+	if err != nil {
+		return diag.Errorf(" Reading board rows. Project ID: %s, Team ID: %s, Board: %s, Error: %+v", projectId, teamId, board, err)
+	}
+
+	cardSettings, err := clients.WorkClientExtras.GetBoardCardSettings(ctx, workextras.GetBoardCardSettingsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(teamId),
+		Board:   converter.String(board),
+	})
+	if err != nil {
+		return diag.Errorf(" Reading board card settings. Project ID: %s, Team ID: %s, Board: %s, Error: %+v", projectId, teamId, board, err)
+	}
+
+	cardRules, err := clients.WorkClientExtras.GetBoardCardRuleSettings(ctx, workextras.GetBoardCardRuleSettingsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(teamId),
+		Board:   converter.String(board),
+	})
+	if err != nil {
+		return diag.Errorf(" Reading board card style rules. Project ID: %s, Team ID: %s, Board: %s, Error: %+v", projectId, teamId, board, err)
+	}
+
+	d.Set("columns_etag", converter.ToString(columns.ETag, ""))
+	d.Set("rows_etag", converter.ToString(rows.ETag, ""))
+	if err := d.Set("column", flattenBoardColumns(columns.Columns)); err != nil {
+		return diag.Errorf(" Setting column: %+v", err)
+	}
+	if err := d.Set("row", flattenBoardRows(rows.Rows)); err != nil {
+		return diag.Errorf(" Setting row: %+v", err)
+	}
+	if err := d.Set("card", flattenBoardCardSettings(cardSettings, d.Get("card").([]interface{}))); err != nil {
+		return diag.Errorf(" Setting card: %+v", err)
+	}
+	if err := d.Set("card_style_rule", flattenBoardCardStyleRules(cardRules)); err != nil {
+		return diag.Errorf(" Setting card_style_rule: %+v", err)
+	}
+	return nil
+}
+
+func resourceTeamBoardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// nothing to do, as the original board layout is unknown.
+	return nil
+}
+
+// updateBoardColumns replaces the columns of the board. The update is guarded by the ETag of the columns in the state,
+// so that changes made since they were last read, e.g. in the web UI, are not overwritten.
+func updateBoardColumns(ctx context.Context, clients *client.AggregatedClient, d *schema.ResourceData, projectId, teamId, board string) error {
+	current, err := clients.WorkClientExtras.GetBoardColumns(ctx, workextras.GetBoardColumnsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(teamId),
+		Board:   converter.String(board),
+	})
+	if err != nil {
+		return err
+	}
+
+	columns, err := expandBoardColumns(d.Get("column").([]interface{}), current.Columns)
+	if err != nil {
+		return err
+	}
+	_, err = clients.WorkClientExtras.UpdateBoardColumns(ctx, workextras.UpdateBoardColumnsArgs{
+		BoardColumns: &columns,
+		Project:      converter.String(projectId),
+		Team:         converter.String(teamId),
+		Board:        converter.String(board),
+		ETag:         boardETag(d, "columns_etag", current.ETag),
+	})
+	return concurrentModificationError(err, "board")
+}
+
+// updateBoardRows replaces the rows of the board, guarded by the ETag of the rows in the state
+func updateBoardRows(ctx context.Context, clients *client.AggregatedClient, d *schema.ResourceData, projectId, teamId, board string) error {
+	current, err := clients.WorkClientExtras.GetBoardRows(ctx, workextras.GetBoardRowsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(teamId),
+		Board:   converter.String(board),
+	})
+	if err != nil {
+		return err
+	}
+
+	rows := expandBoardRows(d.Get("row").([]interface{}), current.Rows)
+	_, err = clients.WorkClientExtras.UpdateBoardRows(ctx, workextras.UpdateBoardRowsArgs{
+		BoardRows: &rows,
+		Project:   converter.String(projectId),
+		Team:      converter.String(teamId),
+		Board:     converter.String(board),
+		ETag:      boardETag(d, "rows_etag", current.ETag),
+	})
+	return concurrentModificationError(err, "board")
+}
+
+// updateBoardCardSettings replaces the card settings of the configured work item types, the other work item types
+// keep their settings. The card settings have no ETag, the update is not guarded against concurrent changes.
+func updateBoardCardSettings(ctx context.Context, clients *client.AggregatedClient, d *schema.ResourceData, projectId, teamId, board string) error {
+	current, err := clients.WorkClientExtras.GetBoardCardSettings(ctx, workextras.GetBoardCardSettingsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(teamId),
+		Board:   converter.String(board),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.WorkClientExtras.UpdateBoardCardSettings(ctx, workextras.UpdateBoardCardSettingsArgs{
+		BoardCardSettingsToSave: expandBoardCardSettings(d.Get("card").([]interface{}), current),
+		Project:                 converter.String(projectId),
+		Team:                    converter.String(teamId),
+		Board:                   converter.String(board),
+	})
+	return err
+}
+
+// updateBoardCardStyleRules replaces the card style rules, the other card rules, e.g. the tag colors, are kept. The card
+// rule settings have no ETag, the update is not guarded against concurrent changes.
+func updateBoardCardStyleRules(ctx context.Context, clients *client.AggregatedClient, d *schema.ResourceData, projectId, teamId, board string) error {
+	current, err := clients.WorkClientExtras.GetBoardCardRuleSettings(ctx, workextras.GetBoardCardRuleSettingsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(teamId),
+		Board:   converter.String(board),
+	})
+	if err != nil {
+		return err
+	}
+
+	rules := map[string][]workextras.Rule{}
+	if current.Rules != nil {
+		for ruleType, r := range *current.Rules {
+			rules[ruleType] = r
+		}
+	}
+	rules[cardRuleTypeFill] = expandBoardCardStyleRules(d.Get("card_style_rule").([]interface{}))
+
+	_, err = clients.WorkClientExtras.UpdateBoardCardRuleSettings(ctx, workextras.UpdateBoardCardRuleSettingsArgs{
+		BoardCardRuleSettings: &workextras.BoardCardRuleSettings{Rules: &rules},
+		Project:               converter.String(projectId),
+		Team:                  converter.String(teamId),
+		Board:                 converter.String(board),
+	})
+	return err
+}
+
+// boardETag returns the ETag stored in the state by the last read. A board which was not read yet, i.e. on create,
+// falls back to the ETag read right before the update.
+func boardETag(d *schema.ResourceData, key string, current *string) *string {
+	if etag := d.Get(key).(string); etag != "" {
+		return converter.String(etag)
+	}
+	return current
+}
+
+// expandBoardColumns builds the columns in the configured order. The first column is the incoming column and the last
+// one the outgoing column. Existing columns are matched by name so that the work items stay in their column.
+func expandBoardColumns(configured []interface{}, existing *[]work.BoardColumn) ([]work.BoardColumn, error) {
+	existingIds := map[string]*uuid.UUID{}
+	if existing != nil {
+		for _, column := range *existing {
+			existingIds[strings.ToLower(converter.ToString(column.Name, ""))] = column.Id
+		}
+	}
+
+	columns := make([]work.BoardColumn, 0, len(configured))
+	for i, raw := range configured {
+		c := raw.(map[string]interface{})
+		name := c["name"].(string)
+
+		columnType := work.BoardColumnTypeValues.InProgress
+		if i == 0 {
+			columnType = work.BoardColumnTypeValues.Incoming
+		} else if i == len(configured)-1 {
+			columnType = work.BoardColumnTypeValues.Outgoing
+		}
+		if columnType != work.BoardColumnTypeValues.InProgress && (c["split"].(bool) || c["item_limit"].(int) > 0) {
+			return nil, fmt.Errorf("column %s: the first and the last column can neither be split nor have an item limit", name)
+		}
+
+		stateMappings := map[string]string{}
+		for workItemType, state := range c["state_mappings"].(map[string]interface{}) {
+			stateMappings[workItemType] = state.(string)
+		}
+
+		columns = append(columns, work.BoardColumn{
+			Id:            existingIds[strings.ToLower(name)],
+			Name:          converter.String(name),
+			ColumnType:    &columnType,
+			ItemLimit:     converter.Int(c["item_limit"].(int)),
+			IsSplit:       converter.Bool(c["split"].(bool)),
+			Description:   converter.String(c["definition_of_done"].(string)),
+			StateMappings: &stateMappings,
+		})
+	}
+	return columns, nil
+}
+
+func flattenBoardColumns(columns *[]work.BoardColumn) []interface{} {
+	if columns == nil {
+		return []interface{}{}
+	}
+	results := make([]interface{}, 0, len(*columns))
+	for _, column := range *columns {
+		id := ""
+		if column.Id != nil {
+			id = column.Id.String()
+		}
+		columnType := ""
+		if column.ColumnType != nil {
+			columnType = string(*column.ColumnType)
+		}
+		stateMappings := map[string]interface{}{}
+		if column.StateMappings != nil {
+			for workItemType, state := range *column.StateMappings {
+				stateMappings[workItemType] = state
+			}
+		}
+		results = append(results, map[string]interface{}{
+			"id":                 id,
+			"name":               converter.ToString(column.Name, ""),
+			"column_type":        columnType,
+			"item_limit":         converter.ToInt(column.ItemLimit, 0),
+			"split":              converter.ToBool(column.IsSplit, false),
+			"definition_of_done": converter.ToString(column.Description, ""),
+			"state_mappings":     stateMappings,
+		})
+	}
+	return results
+}
+
+// isDefaultBoardRow reports whether a row is the default swimlane, which always exists, has no name and the empty ID
+func isDefaultBoardRow(row work.BoardRow) bool {
+	return (row.Id != nil && *row.Id == uuid.Nil) || converter.ToString(row.Name, "") == ""
+}
+
+// expandBoardRows builds the swimlanes in the configured order after the default swimlane. Existing swimlanes are
+// matched by name so that the work items stay in their swimlane.
+func expandBoardRows(configured []interface{}, existing *[]work.BoardRow) []work.BoardRow {
+	rows := []work.BoardRow{}
+	existingIds := map[string]*uuid.UUID{}
+	if existing != nil {
+		for _, row := range *existing {
+			if isDefaultBoardRow(row) {
+				rows = append(rows, row)
+				continue
+			}
+			existingIds[strings.ToLower(converter.ToString(row.Name, ""))] = row.Id
+		}
+	}
+
+	for _, raw := range configured {
+		r := raw.(map[string]interface{})
+		name := r["name"].(string)
+		row := work.BoardRow{
+			Id:   existingIds[strings.ToLower(name)],
+			Name: converter.String(name),
+		}
+		if color := r["color"].(string); color != "" {
+			row.Color = converter.String(color)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func flattenBoardRows(rows *[]work.BoardRow) []interface{} {
+	results := []interface{}{}
+	if rows == nil {
+		return results
+	}
+	for _, row := range *rows {
+		if isDefaultBoardRow(row) {
+			continue
+		}
+		id := ""
+		if row.Id != nil {
+			id = row.Id.String()
+		}
+		results = append(results, map[string]interface{}{
+			"id":    id,
+			"name":  converter.ToString(row.Name, ""),
+			"color": converter.ToString(row.Color, ""),
+		})
+	}
+	return results
+}
+
+// expandBoardCardSettings merges the configured work item types into the current card settings. Attributes of a
+// field which are not managed by the provider are kept.
+func expandBoardCardSettings(configured []interface{}, current *workextras.BoardCardSettings) *workextras.BoardCardSettings {
+	cards := map[string][]map[string]interface{}{}
+	if current != nil && current.Cards != nil {
+		for workItemType, settings := range *current.Cards {
+			cards[workItemType] = settings
+		}
+	}
+
+	for _, raw := range configured {
+		c := raw.(map[string]interface{})
+		workItemType := c["work_item_type"].(string)
+
+		existingFields := map[string]map[string]interface{}{}
+		for _, setting := range cards[workItemType] {
+			if field, ok := setting[cardSettingFieldIdentifier]; ok {
+				existingFields[strings.ToLower(fmt.Sprint(field))] = setting
+			}
+		}
+
+		settings := []map[string]interface{}{}
+		for _, rawField := range c["field"].([]interface{}) {
+			f := rawField.(map[string]interface{})
+			referenceName := f["reference_name"].(string)
+			setting := map[string]interface{}{}
+			for k, v := range existingFields[strings.ToLower(referenceName)] {
+				setting[k] = v
+			}
+			setting[cardSettingFieldIdentifier] = referenceName
+			delete(setting, cardSettingDisplayFormat)
+			if displayFormat := f["display_format"].(string); displayFormat != "" {
+				setting[cardSettingDisplayFormat] = displayFormat
+			}
+			settings = append(settings, setting)
+		}
+		settings = append(settings, map[string]interface{}{
+			cardSettingShowEmptyFields: fmt.Sprintf("%t", c["show_empty_fields"].(bool)),
+		})
+		cards[workItemType] = settings
+	}
+	return &workextras.BoardCardSettings{Cards: &cards}
+}
+
+// flattenBoardCardSettings only tracks the configured work item types, unless none are configured
+func flattenBoardCardSettings(settings *workextras.BoardCardSettings, configured []interface{}) []interface{} {
+	results := []interface{}{}
+	if settings == nil || settings.Cards == nil {
+		return results
+	}
+
+	var workItemTypes []string
+	for _, raw := range configured {
+		if c, ok := raw.(map[string]interface{}); ok {
+			workItemTypes = append(workItemTypes, c["work_item_type"].(string))
+		}
+	}
+	if len(workItemTypes) == 0 {
+		for workItemType := range *settings.Cards {
+			workItemTypes = append(workItemTypes, workItemType)
+		}
+		sort.Strings(workItemTypes)
+	}
+
+	for _, workItemType := range workItemTypes {
+		cardSettings, ok := (*settings.Cards)[workItemType]
+		if !ok {
+			continue
+		}
+		fields := []interface{}{}
+		showEmptyFields := false
+		for _, setting := range cardSettings {
+			if v, ok := setting[cardSettingShowEmptyFields]; ok {
+				showEmptyFields = strings.EqualFold(fmt.Sprint(v), "true")
+			}
+			field, ok := setting[cardSettingFieldIdentifier]
+			if !ok {
+				continue
+			}
+			displayFormat := ""
+			if v, ok := setting[cardSettingDisplayFormat]; ok {
+				displayFormat = fmt.Sprint(v)
+			}
+			fields = append(fields, map[string]interface{}{
+				"reference_name": fmt.Sprint(field),
+				"display_format": displayFormat,
+			})
+		}
+		results = append(results, map[string]interface{}{
+			"work_item_type":    workItemType,
+			"show_empty_fields": showEmptyFields,
+			"field":             fields,
+		})
+	}
+	return results
+}
+
+func expandBoardCardStyleRules(configured []interface{}) []workextras.Rule {
+	rules := make([]workextras.Rule, 0, len(configured))
+	for _, raw := range configured {
+		r := raw.(map[string]interface{})
+
+		clauses, filter := expandFilterClauses(r["clause"].([]interface{}))
+
+		settings := map[string]string{}
+		if v := r["background_color"].(string); v != "" {
+			settings[cardStyleBackgroundColor] = v
+		}
+		if v := r["title_color"].(string); v != "" {
+			settings[cardStyleTitleColor] = v
+		}
+		if r["title_bold"].(bool) {
+			settings[cardStyleTitleBold] = "bold"
+		}
+		if r["title_italic"].(bool) {
+			settings[cardStyleTitleItalic] = "italic"
+		}
+		if r["title_underline"].(bool) {
+			settings[cardStyleTitleUnderline] = "underline"
+		}
+
+		rules = append(rules, workextras.Rule{
+			Name:      converter.String(r["name"].(string)),
+			IsEnabled: converter.String(fmt.Sprintf("%t", r["enabled"].(bool))),
+			Clauses:   &clauses,
+			Filter:    converter.String(filter),
+			Settings:  &settings,
+		})
+	}
+	return rules
+}
+
+func flattenBoardCardStyleRules(settings *workextras.BoardCardRuleSettings) []interface{} {
+	results := []interface{}{}
+	if settings == nil || settings.Rules == nil {
+		return results
+	}
+	for _, rule := range (*settings.Rules)[cardRuleTypeFill] {
+		ruleSettings := map[string]string{}
+		if rule.Settings != nil {
+			ruleSettings = *rule.Settings
+		}
+		results = append(results, map[string]interface{}{
+			"name":             converter.ToString(rule.Name, ""),
+			"enabled":          strings.EqualFold(converter.ToString(rule.IsEnabled, "true"), "true"),
+			"background_color": ruleSettings[cardStyleBackgroundColor],
+			"title_color":      ruleSettings[cardStyleTitleColor],
+			"title_bold":       ruleSettings[cardStyleTitleBold] == "bold",
+			"title_italic":     ruleSettings[cardStyleTitleItalic] == "italic",
+			"title_underline":  ruleSettings[cardStyleTitleUnderline] == "underline",
+			"clause":           flattenFilterClauses(rule.Clauses),
+		})
+	}
+	return results
+}
+
+func parseTeamBoardId(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf(" Unexpected format of ID (%s), expected <project ID>/<team ID>/<board name>", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
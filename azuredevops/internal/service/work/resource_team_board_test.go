@@ -0,0 +1,268 @@
+//go:build (all || resource_team_board) && !exclude_resource_team_board
+// +build all resource_team_board
+// +build !exclude_resource_team_board
+
+package work
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testTeamBoardProjectID = "e7a5f3b1-3d5a-4b0e-9a55-0e0a4f0d7c21"
+	testTeamBoardTeamID    = "1b6e3a0c-2f4d-4d8e-b6a1-7c9d5e2f8a13"
+)
+
+func testTeamBoardColumns() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name":               "New",
+			"item_limit":         0,
+			"split":              false,
+			"definition_of_done": "",
+			"state_mappings":     map[string]interface{}{"User Story": "New"},
+		},
+		map[string]interface{}{
+			"name":               "Active",
+			"item_limit":         5,
+			"split":              true,
+			"definition_of_done": "Reviewed",
+			"state_mappings":     map[string]interface{}{"User Story": "Active"},
+		},
+		map[string]interface{}{
+			"name":               "Closed",
+			"item_limit":         0,
+			"split":              false,
+			"definition_of_done": "",
+			"state_mappings":     map[string]interface{}{"User Story": "Closed"},
+		},
+	}
+}
+
+func TestTeamBoard_ExpandColumns_DerivesTypesAndReusesIds(t *testing.T) {
+	activeId := uuid.New()
+	columns, err := expandBoardColumns(testTeamBoardColumns(), &[]work.BoardColumn{
+		{Id: &activeId, Name: converter.String("active")},
+	})
+	require.NoError(t, err)
+	require.Len(t, columns, 3)
+
+	require.Equal(t, work.BoardColumnTypeValues.Incoming, *columns[0].ColumnType)
+	require.Nil(t, columns[0].Id)
+	require.Equal(t, work.BoardColumnTypeValues.InProgress, *columns[1].ColumnType)
+	require.Equal(t, activeId, *columns[1].Id)
+	require.Equal(t, 5, *columns[1].ItemLimit)
+	require.True(t, *columns[1].IsSplit)
+	require.Equal(t, "Reviewed", *columns[1].Description)
+	require.Equal(t, work.BoardColumnTypeValues.Outgoing, *columns[2].ColumnType)
+}
+
+func TestTeamBoard_ExpandColumns_RejectsSplitOutgoingColumn(t *testing.T) {
+	configured := testTeamBoardColumns()
+	configured[2].(map[string]interface{})["split"] = true
+
+	_, err := expandBoardColumns(configured, nil)
+	require.ErrorContains(t, err, "column Closed")
+}
+
+func TestTeamBoard_ExpandRows_KeepsDefaultRowFirst(t *testing.T) {
+	expediteId := uuid.New()
+	rows := expandBoardRows([]interface{}{
+		map[string]interface{}{"name": "Expedite", "color": "#FF0000"},
+		map[string]interface{}{"name": "Blocked", "color": ""},
+	}, &[]work.BoardRow{
+		{Id: &uuid.Nil},
+		{Id: &expediteId, Name: converter.String("Expedite")},
+		{Id: converter.UUID(uuid.New().String()), Name: converter.String("Removed")},
+	})
+
+	require.Len(t, rows, 3)
+	require.Equal(t, uuid.Nil, *rows[0].Id)
+	require.Equal(t, expediteId, *rows[1].Id)
+	require.Equal(t, "#FF0000", *rows[1].Color)
+	require.Nil(t, rows[2].Id)
+	require.Nil(t, rows[2].Color)
+	require.Len(t, flattenBoardRows(&rows), 2)
+}
+
+func TestTeamBoard_ExpandCardSettings_KeepsOtherWorkItemTypesAndAttributes(t *testing.T) {
+	settings := expandBoardCardSettings([]interface{}{
+		map[string]interface{}{
+			"work_item_type":    "User Story",
+			"show_empty_fields": true,
+			"field": []interface{}{
+				map[string]interface{}{"reference_name": "System.Title", "display_format": ""},
+				map[string]interface{}{"reference_name": "System.AssignedTo", "display_format": "AvatarOnly"},
+			},
+		},
+	}, &workextras.BoardCardSettings{Cards: &map[string][]map[string]interface{}{
+		"User Story": {
+			{"fieldIdentifier": "System.Title", "isCoreField": "true"},
+			{"fieldIdentifier": "System.Tags"},
+		},
+		"Bug": {
+			{"fieldIdentifier": "System.Title"},
+		},
+	}})
+
+	cards := *settings.Cards
+	require.Equal(t, []map[string]interface{}{{"fieldIdentifier": "System.Title"}}, cards["Bug"])
+	require.Equal(t, []map[string]interface{}{
+		{"fieldIdentifier": "System.Title", "isCoreField": "true"},
+		{"fieldIdentifier": "System.AssignedTo", "displayFormat": "AvatarOnly"},
+		{"showEmptyFields": "true"},
+	}, cards["User Story"])
+
+	flattened := flattenBoardCardSettings(settings, []interface{}{map[string]interface{}{"work_item_type": "User Story"}})
+	require.Len(t, flattened, 1)
+	require.Equal(t, true, flattened[0].(map[string]interface{})["show_empty_fields"])
+	require.Len(t, flattened[0].(map[string]interface{})["field"], 2)
+}
+
+func TestTeamBoard_ExpandCardStyleRules_BuildsFilter(t *testing.T) {
+	rules := expandBoardCardStyleRules([]interface{}{
+		map[string]interface{}{
+			"name":             "Blocked",
+			"enabled":          true,
+			"background_color": "#FF0000",
+			"title_color":      "",
+			"title_bold":       true,
+			"title_italic":     false,
+			"title_underline":  false,
+			"clause": []interface{}{
+				map[string]interface{}{"field_name": "System.Tags", "operator": "contains", "value": "Blocked", "logical_operator": "And"},
+				map[string]interface{}{"field_name": "System.Title", "operator": "contains", "value": "it's", "logical_operator": "Or"},
+			},
+		},
+	})
+
+	require.Len(t, rules, 1)
+	require.Equal(t, "[System.Tags] contains 'Blocked' or [System.Title] contains 'it''s'", *rules[0].Filter)
+	require.Equal(t, map[string]string{"background-color": "#FF0000", "title-font-weight": "bold"}, *rules[0].Settings)
+	require.Nil(t, (*rules[0].Clauses)[0].LogicalOperator)
+
+	flattened := flattenBoardCardStyleRules(&workextras.BoardCardRuleSettings{Rules: &map[string][]workextras.Rule{"fill": rules}})
+	require.Len(t, flattened, 1)
+	rule := flattened[0].(map[string]interface{})
+	require.Equal(t, true, rule["title_bold"])
+	require.Equal(t, "Or", rule["clause"].([]interface{})[1].(map[string]interface{})["logical_operator"])
+}
+
+func TestTeamBoard_UpdateCardStyleRules_KeepsTagStyles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkextrasClient(ctrl)
+	clients := &client.AggregatedClient{WorkClientExtras: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamBoard().Schema, map[string]interface{}{
+		"project_id":      testTeamBoardProjectID,
+		"team_id":         testTeamBoardTeamID,
+		"board":           "Stories",
+		"card_style_rule": []interface{}{},
+	})
+
+	tagStyles := []workextras.Rule{{Name: converter.String("urgent")}}
+	mockClient.EXPECT().GetBoardCardRuleSettings(clients.Ctx, gomock.Any()).Return(&workextras.BoardCardRuleSettings{
+		Rules: &map[string][]workextras.Rule{
+			"fill":     {{Name: converter.String("old")}},
+			"tagStyle": tagStyles,
+		},
+	}, nil).Times(1)
+	mockClient.EXPECT().UpdateBoardCardRuleSettings(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workextras.UpdateBoardCardRuleSettingsArgs) (*workextras.BoardCardRuleSettings, error) {
+			rules := *args.BoardCardRuleSettings.Rules
+			require.Empty(t, rules["fill"])
+			require.Equal(t, tagStyles, rules["tagStyle"])
+			return args.BoardCardRuleSettings, nil
+		}).Times(1)
+
+	err := updateBoardCardStyleRules(clients.Ctx, clients, resourceData, testTeamBoardProjectID, testTeamBoardTeamID, "Stories")
+	require.NoError(t, err)
+}
+
+func TestTeamBoard_UpdateColumns_UsesStateETagAndReportsConcurrentChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkextrasClient(ctrl)
+	clients := &client.AggregatedClient{WorkClientExtras: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamBoard().Schema, map[string]interface{}{
+		"project_id": testTeamBoardProjectID,
+		"team_id":    testTeamBoardTeamID,
+		"board":      "Stories",
+		"column":     testTeamBoardColumns(),
+	})
+	resourceData.Set("columns_etag", `"41"`)
+
+	// the columns were changed since the last read, the update must not be guarded by the current ETag
+	mockClient.EXPECT().GetBoardColumns(clients.Ctx, gomock.Any()).Return(&workextras.BoardColumns{
+		Columns: &[]work.BoardColumn{},
+		ETag:    converter.String(`"42"`),
+	}, nil).Times(1)
+	mockClient.EXPECT().UpdateBoardColumns(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workextras.UpdateBoardColumnsArgs) (*[]work.BoardColumn, error) {
+			require.Equal(t, `"41"`, *args.ETag)
+			require.Len(t, *args.BoardColumns, 3)
+			return nil, azuredevops.WrappedError{
+				StatusCode: converter.Int(http.StatusPreconditionFailed),
+				Message:    converter.String("The board has been modified"),
+			}
+		}).Times(1)
+
+	err := updateBoardColumns(clients.Ctx, clients, resourceData, testTeamBoardProjectID, testTeamBoardTeamID, "Stories")
+	require.ErrorContains(t, err, "modified by someone else")
+}
+
+func TestTeamBoard_UpdateRows_WithoutStateETagUsesCurrentETag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkextrasClient(ctrl)
+	clients := &client.AggregatedClient{WorkClientExtras: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamBoard().Schema, map[string]interface{}{
+		"project_id": testTeamBoardProjectID,
+		"team_id":    testTeamBoardTeamID,
+		"board":      "Stories",
+		"row":        []interface{}{map[string]interface{}{"name": "Expedite"}},
+	})
+
+	mockClient.EXPECT().GetBoardRows(clients.Ctx, gomock.Any()).Return(&workextras.BoardRows{
+		Rows: &[]work.BoardRow{},
+		ETag: converter.String(`"7"`),
+	}, nil).Times(1)
+	mockClient.EXPECT().UpdateBoardRows(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workextras.UpdateBoardRowsArgs) (*[]work.BoardRow, error) {
+			require.Equal(t, `"7"`, *args.ETag)
+			return args.BoardRows, nil
+		}).Times(1)
+
+	err := updateBoardRows(clients.Ctx, clients, resourceData, testTeamBoardProjectID, testTeamBoardTeamID, "Stories")
+	require.NoError(t, err)
+}
+
+func TestTeamBoard_ParseId(t *testing.T) {
+	projectId, teamId, board, err := parseTeamBoardId(testTeamBoardProjectID + "/" + testTeamBoardTeamID + "/Stories")
+	require.NoError(t, err)
+	require.Equal(t, testTeamBoardProjectID, projectId)
+	require.Equal(t, testTeamBoardTeamID, teamId)
+	require.Equal(t, "Stories", board)
+
+	_, _, _, err = parseTeamBoardId(testTeamBoardProjectID + "/Stories")
+	require.Error(t, err)
+}
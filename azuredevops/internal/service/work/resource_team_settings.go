@@ -0,0 +1,197 @@
+package work
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+var (
+	workingDays          = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+	regexpIterationMacro = regexp.MustCompile(`^@currentIteration(\s*[+-]\s*\d+)?$`)
+)
+
+// ResourceTeamSettings schema and implementation for the work settings of a team
+func ResourceTeamSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamSettingsCreateUpdate,
+		ReadContext:   resourceTeamSettingsRead,
+		UpdateContext: resourceTeamSettingsCreateUpdate,
+		DeleteContext: resourceTeamSettingsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			// an empty set does not differ from an unset optional and computed attribute, plan the removal explicitly
+			if isEmptyWorkingDaysConfigured(d.GetRawConfig()) && d.Get("working_days").(*schema.Set).Len() > 0 {
+				return d.SetNew("working_days", []interface{}{})
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"working_days": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(workingDays, false),
+				},
+			},
+			"bugs_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(work.BugsBehaviorValues.AsRequirements),
+					string(work.BugsBehaviorValues.AsTasks),
+					string(work.BugsBehaviorValues.Off),
+				}, false),
+			},
+			"backlog_visibilities": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
+			"default_iteration_macro": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexpIterationMacro, "must be `@currentIteration`, optionally with an offset, e.g. `@currentIteration + 1`"),
+			},
+		},
+	}
+}
+
+func resourceTeamSettingsCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+	teamId := d.Get("team_id").(string)
+
+	_, err := clients.WorkClient.UpdateTeamSettings(ctx, work.UpdateTeamSettingsArgs{
+		Project:           converter.String(projectId),
+		Team:              converter.String(teamId),
+		TeamSettingsPatch: expandTeamSettings(d),
+	})
+	if err != nil {
+		return diag.Errorf(" Updating team settings. Project ID: %s, Team ID: %s, Error: %+v", projectId, teamId, err)
+	}
+
+	d.SetId(teamId)
+	return resourceTeamSettingsRead(ctx, d, m)
+}
+
+func resourceTeamSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+
+	settings, err := clients.WorkClient.GetTeamSettings(ctx, work.GetTeamSettingsArgs{
+		Project: converter.String(projectId),
+		Team:    converter.String(d.Id()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading team settings. Project ID: %s, Team ID: %s, Error: %+v", projectId, d.Id(), err)
+	}
+
+	d.Set("team_id", d.Id())
+	flattenTeamSettings(d, settings)
+	return nil
+}
+
+func resourceTeamSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// nothing to do, as the original settings are unknown.
+	return nil
+}
+
+// expandTeamSettings only includes the configured settings, the service leaves the omitted ones unchanged
+func expandTeamSettings(d *schema.ResourceData) *work.TeamSettingsPatch {
+	patch := work.TeamSettingsPatch{}
+	if v, ok := d.GetOk("working_days"); ok {
+		days := tfhelper.ExpandStringSet(v.(*schema.Set))
+		patch.WorkingDays = &days
+	} else if isEmptyWorkingDaysConfigured(d.GetRawConfig()) {
+		patch.WorkingDays = &[]string{}
+	}
+	if v, ok := d.GetOk("bugs_behavior"); ok {
+		behavior := work.BugsBehavior(v.(string))
+		patch.BugsBehavior = &behavior
+	}
+	if v, ok := d.GetOk("backlog_visibilities"); ok {
+		visibilities := map[string]bool{}
+		for category, visible := range v.(map[string]interface{}) {
+			visibilities[category] = visible.(bool)
+		}
+		patch.BacklogVisibilities = &visibilities
+	}
+	if v, ok := d.GetOk("default_iteration_macro"); ok {
+		patch.DefaultIterationMacro = converter.String(v.(string))
+	}
+	return &patch
+}
+
+// isEmptyWorkingDaysConfigured reports whether `working_days` is configured as an empty set, which is not distinguishable
+// from an unset attribute once the configuration is read through the schema
+func isEmptyWorkingDaysConfigured(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	days := rawConfig.GetAttr("working_days")
+	return days.IsKnown() && !days.IsNull() && days.LengthInt() == 0
+}
+
+func flattenTeamSettings(d *schema.ResourceData, settings *work.TeamSetting) {
+	if settings.WorkingDays != nil {
+		days := make([]string, 0, len(*settings.WorkingDays))
+		for _, day := range *settings.WorkingDays {
+			days = append(days, strings.ToLower(day))
+		}
+		d.Set("working_days", days)
+	}
+	if settings.BugsBehavior != nil {
+		d.Set("bugs_behavior", string(*settings.BugsBehavior))
+	}
+	if settings.BacklogVisibilities != nil {
+		// only the configured backlog levels are tracked, unless none are configured
+		configured := d.Get("backlog_visibilities").(map[string]interface{})
+		visibilities := map[string]interface{}{}
+		for category, visible := range *settings.BacklogVisibilities {
+			if _, ok := configured[category]; ok || len(configured) == 0 {
+				visibilities[category] = visible
+			}
+		}
+		d.Set("backlog_visibilities", visibilities)
+	}
+	d.Set("default_iteration_macro", converter.ToString(settings.DefaultIterationMacro, ""))
+}
@@ -0,0 +1,149 @@
+//go:build (all || resource_team_settings) && !exclude_resource_team_settings
+// +build all resource_team_settings
+// +build !exclude_resource_team_settings
+
+package work
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testTeamSettingsProjectID = "e7a5f3b1-3d5a-4b0e-9a55-0e0a4f0d7c21"
+	testTeamSettingsTeamID    = "1b6e3a0c-2f4d-4d8e-b6a1-7c9d5e2f8a13"
+)
+
+func TestTeamSettings_Expand_OnlyConfiguredSettings(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamSettings().Schema, map[string]interface{}{
+		"project_id":    testTeamSettingsProjectID,
+		"team_id":       testTeamSettingsTeamID,
+		"bugs_behavior": "asTasks",
+		"backlog_visibilities": map[string]interface{}{
+			"Microsoft.EpicCategory": false,
+		},
+	})
+
+	patch := expandTeamSettings(resourceData)
+	require.Equal(t, work.BugsBehaviorValues.AsTasks, *patch.BugsBehavior)
+	require.Equal(t, map[string]bool{"Microsoft.EpicCategory": false}, *patch.BacklogVisibilities)
+	require.Nil(t, patch.WorkingDays)
+	require.Nil(t, patch.DefaultIterationMacro)
+}
+
+func TestTeamSettings_IsEmptyWorkingDaysConfigured(t *testing.T) {
+	configType := ResourceTeamSettings().CoreConfigSchema().ImpliedType()
+	config := func(workingDays cty.Value) cty.Value {
+		values := map[string]cty.Value{}
+		for name, attributeType := range configType.AttributeTypes() {
+			values[name] = cty.NullVal(attributeType)
+		}
+		values["working_days"] = workingDays
+		return cty.ObjectVal(values)
+	}
+
+	require.True(t, isEmptyWorkingDaysConfigured(config(cty.SetValEmpty(cty.String))))
+	require.False(t, isEmptyWorkingDaysConfigured(config(cty.NullVal(cty.Set(cty.String)))))
+	require.False(t, isEmptyWorkingDaysConfigured(config(cty.UnknownVal(cty.Set(cty.String)))))
+	require.False(t, isEmptyWorkingDaysConfigured(config(cty.SetVal([]cty.Value{cty.StringVal("monday")}))))
+	require.False(t, isEmptyWorkingDaysConfigured(cty.NullVal(configType)))
+}
+
+func TestTeamSettings_Flatten_TracksConfiguredBacklogLevels(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamSettings().Schema, map[string]interface{}{
+		"project_id": testTeamSettingsProjectID,
+		"team_id":    testTeamSettingsTeamID,
+		"backlog_visibilities": map[string]interface{}{
+			"Microsoft.EpicCategory": false,
+		},
+	})
+
+	flattenTeamSettings(resourceData, &work.TeamSetting{
+		WorkingDays:  &[]string{"Monday", "Tuesday"},
+		BugsBehavior: &work.BugsBehaviorValues.Off,
+		BacklogVisibilities: &map[string]bool{
+			"Microsoft.EpicCategory":    false,
+			"Microsoft.FeatureCategory": true,
+		},
+		DefaultIterationMacro: converter.String("@currentIteration"),
+	})
+
+	require.ElementsMatch(t, []interface{}{"monday", "tuesday"}, resourceData.Get("working_days").(*schema.Set).List())
+	require.Equal(t, "off", resourceData.Get("bugs_behavior"))
+	require.Equal(t, map[string]interface{}{"Microsoft.EpicCategory": false}, resourceData.Get("backlog_visibilities"))
+	require.Equal(t, "@currentIteration", resourceData.Get("default_iteration_macro"))
+}
+
+func TestTeamSettings_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamSettings().Schema, map[string]interface{}{
+		"project_id":   testTeamSettingsProjectID,
+		"team_id":      testTeamSettingsTeamID,
+		"working_days": []interface{}{"monday"},
+	})
+
+	mockClient.
+		EXPECT().
+		UpdateTeamSettings(clients.Ctx, work.UpdateTeamSettingsArgs{
+			Project:           converter.String(testTeamSettingsProjectID),
+			Team:              converter.String(testTeamSettingsTeamID),
+			TeamSettingsPatch: &work.TeamSettingsPatch{WorkingDays: &[]string{"monday"}},
+		}).
+		Return(nil, errors.New("UpdateTeamSettings() Failed")).
+		Times(1)
+
+	diags := resourceTeamSettingsCreateUpdate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "UpdateTeamSettings() Failed")
+	require.Empty(t, resourceData.Id())
+}
+
+func TestTeamSettings_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamSettings().Schema, map[string]interface{}{
+		"project_id": testTeamSettingsProjectID,
+	})
+	resourceData.SetId(testTeamSettingsTeamID)
+
+	mockClient.
+		EXPECT().
+		GetTeamSettings(clients.Ctx, work.GetTeamSettingsArgs{
+			Project: converter.String(testTeamSettingsProjectID),
+			Team:    converter.String(testTeamSettingsTeamID),
+		}).
+		Return(nil, errors.New("GetTeamSettings() Failed")).
+		Times(1)
+
+	diags := resourceTeamSettingsRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetTeamSettings() Failed")
+}
+
+func TestTeamSettings_ValidateIterationMacro(t *testing.T) {
+	for _, macro := range []string{"@currentIteration", "@currentIteration + 1", "@currentIteration-2"} {
+		require.True(t, regexpIterationMacro.MatchString(macro), macro)
+	}
+	for _, macro := range []string{"@today", "currentIteration", "@currentIteration + x"} {
+		require.False(t, regexpIterationMacro.MatchString(macro), macro)
+	}
+}
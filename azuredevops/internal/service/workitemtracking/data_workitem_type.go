@@ -0,0 +1,238 @@
+package workitemtracking
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataWorkItemType schema and implementation for the work item type data source
+func DataWorkItemType() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkItemTypeRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"reference_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"color": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"icon_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reference_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"help_text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"always_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"states": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"transitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWorkItemTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	workItemType, err := getWorkItemType(ctx, clients, projectId, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the allowed values are only returned when the fields are requested with the corresponding expansion
+	fields, err := getWorkItemTypeFields(ctx, clients, projectId, name, workitemtracking.WorkItemTypeFieldsExpandLevelValues.AllowedValues)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectId + "/" + converter.ToString(workItemType.ReferenceName, name))
+	d.Set("name", converter.ToString(workItemType.Name, name))
+	d.Set("reference_name", converter.ToString(workItemType.ReferenceName, ""))
+	d.Set("description", converter.ToString(workItemType.Description, ""))
+	d.Set("color", converter.ToString(workItemType.Color, ""))
+	d.Set("is_disabled", converter.ToBool(workItemType.IsDisabled, false))
+	if workItemType.Icon != nil {
+		d.Set("icon_url", converter.ToString(workItemType.Icon.Url, ""))
+	}
+
+	if err := d.Set("fields", flattenWorkItemTypeFields(fields)); err != nil {
+		return diag.Errorf(" Setting fields: %+v", err)
+	}
+	if err := d.Set("states", flattenWorkItemTypeStates(workItemType.States)); err != nil {
+		return diag.Errorf(" Setting states: %+v", err)
+	}
+	if err := d.Set("transitions", flattenWorkItemTypeTransitions(workItemType.Transitions)); err != nil {
+		return diag.Errorf(" Setting transitions: %+v", err)
+	}
+	return nil
+}
+
+func flattenWorkItemTypeFields(fields map[string]workitemtracking.WorkItemTypeFieldWithReferences) []interface{} {
+	referenceNames := make([]string, 0, len(fields))
+	for referenceName := range fields {
+		referenceNames = append(referenceNames, referenceName)
+	}
+	sort.Strings(referenceNames)
+
+	results := make([]interface{}, 0, len(fields))
+	for _, referenceName := range referenceNames {
+		field := fields[referenceName]
+		defaultValue := ""
+		if field.DefaultValue != nil {
+			defaultValue = fmt.Sprint(field.DefaultValue)
+		}
+		results = append(results, map[string]interface{}{
+			"reference_name":  converter.ToString(field.ReferenceName, ""),
+			"name":            converter.ToString(field.Name, ""),
+			"help_text":       converter.ToString(field.HelpText, ""),
+			"always_required": converter.ToBool(field.AlwaysRequired, false),
+			"default_value":   defaultValue,
+			"allowed_values":  workItemTypeFieldAllowedValues(field),
+		})
+	}
+	return results
+}
+
+func flattenWorkItemTypeStates(states *[]workitemtracking.WorkItemStateColor) []interface{} {
+	if states == nil {
+		return []interface{}{}
+	}
+	results := make([]interface{}, 0, len(*states))
+	for _, state := range *states {
+		results = append(results, map[string]interface{}{
+			"name":     converter.ToString(state.Name, ""),
+			"category": converter.ToString(state.Category, ""),
+			"color":    converter.ToString(state.Color, ""),
+		})
+	}
+	return results
+}
+
+// flattenWorkItemTypeTransitions returns the transitions ordered by the source state. The transitions of new work
+// items have an empty source state.
+func flattenWorkItemTypeTransitions(transitions *map[string][]workitemtracking.WorkItemStateTransition) []interface{} {
+	if transitions == nil {
+		return []interface{}{}
+	}
+	fromStates := make([]string, 0, len(*transitions))
+	for from := range *transitions {
+		fromStates = append(fromStates, from)
+	}
+	sort.Strings(fromStates)
+
+	results := make([]interface{}, 0, len(fromStates))
+	for _, from := range fromStates {
+		results = append(results, map[string]interface{}{
+			"from": from,
+			"to":   workItemStateTransitionTargets((*transitions)[from]),
+		})
+	}
+	return results
+}
+
+func workItemStateTransitionTargets(transitions []workitemtracking.WorkItemStateTransition) []string {
+	targets := []string{}
+	for _, transition := range transitions {
+		if to := strings.TrimSpace(converter.ToString(transition.To, "")); to != "" {
+			targets = append(targets, to)
+		}
+	}
+	return targets
+}
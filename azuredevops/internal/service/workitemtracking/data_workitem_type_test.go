@@ -0,0 +1,82 @@
+//go:build (all || data_sources || data_workitem_type) && (!exclude_data_sources || !exclude_data_workitem_type)
+// +build all data_sources data_workitem_type
+// +build !exclude_data_sources !exclude_data_workitem_type
+
+package workitemtracking
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataWorkItemType_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetWorkItemType(clients.Ctx, workitemtracking.GetWorkItemTypeArgs{
+		Project: converter.String(testWorkItemTypeProjectID),
+		Type:    converter.String("Bug"),
+	}).Return(testWorkItemTypeDefinition(), nil).Times(1)
+	mockClient.EXPECT().GetWorkItemTypeFieldsWithReferences(clients.Ctx, workitemtracking.GetWorkItemTypeFieldsWithReferencesArgs{
+		Project: converter.String(testWorkItemTypeProjectID),
+		Type:    converter.String("Bug"),
+		Expand:  &workitemtracking.WorkItemTypeFieldsExpandLevelValues.AllowedValues,
+	}).Return(testWorkItemTypeFields(), nil).Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataWorkItemType().Schema, map[string]interface{}{
+		"project_id": testWorkItemTypeProjectID,
+		"name":       "Bug",
+	})
+	diags := dataSourceWorkItemTypeRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "Microsoft.VSTS.WorkItemTypes.Bug", resourceData.Get("reference_name"))
+	require.Equal(t, "https://example.com/icon_insect", resourceData.Get("icon_url"))
+	require.Equal(t, 5, resourceData.Get("fields.#"))
+	require.Equal(t, "Custom.Component", resourceData.Get("fields.0.reference_name"))
+	require.Equal(t, "Microsoft.VSTS.Common.Priority", resourceData.Get("fields.3.reference_name"))
+	require.Equal(t, "2", resourceData.Get("fields.3.default_value"))
+	require.Equal(t, []interface{}{"1", "2", "3", "4"}, resourceData.Get("fields.3.allowed_values"))
+	require.Equal(t, true, resourceData.Get("fields.4.always_required"))
+	require.Equal(t, 3, resourceData.Get("states.#"))
+	require.Equal(t, "InProgress", resourceData.Get("states.1.category"))
+	require.Equal(t, 4, resourceData.Get("transitions.#"))
+	require.Equal(t, "", resourceData.Get("transitions.0.from"))
+	require.Equal(t, "Active", resourceData.Get("transitions.1.from"))
+	require.Equal(t, []interface{}{"Active", "Closed"}, resourceData.Get("transitions.3.to"))
+}
+
+func TestDataWorkItemType_Read_DoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetWorkItemType(clients.Ctx, gomock.Any()).Return(nil, azuredevops.WrappedError{
+		StatusCode: converter.Int(http.StatusNotFound),
+		Message:    converter.String(errors.New("not found").Error()),
+	}).Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataWorkItemType().Schema, map[string]interface{}{
+		"project_id": testWorkItemTypeProjectID,
+		"name":       "Bgu",
+	})
+	diags := dataSourceWorkItemTypeRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "Work item type Bgu does not exist")
+}
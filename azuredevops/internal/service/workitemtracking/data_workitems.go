@@ -0,0 +1,334 @@
+package workitemtracking
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// workItemsBatchSize the maximum number of work items which can be fetched with one request
+const workItemsBatchSize = 200
+
+var defaultWorkItemFields = []string{"System.Title", "System.WorkItemType", "System.State"}
+
+var workItemFilters = []string{"type", "state", "area_path", "iteration_path", "tags"}
+
+// DataWorkItems schema and implementation for the work items data source
+func DataWorkItems() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkItemsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"query": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: workItemFilters,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"area_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"iteration_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"include_relations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"work_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fields": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"relations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rel": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"target_id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWorkItemsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+
+	query := d.Get("query").(string)
+	if query == "" {
+		query = buildWorkItemsQuery(d)
+	}
+
+	result, err := clients.WorkItemTrackingClient.QueryByWiql(ctx, workitemtracking.QueryByWiqlArgs{
+		Project: converter.String(projectId),
+		Wiql:    &workitemtracking.Wiql{Query: converter.String(query)},
+	})
+	if err != nil {
+		return diag.Errorf(" Querying work items. Project ID: %s, Query: %s, Error: %+v", projectId, query, err)
+	}
+	ids := workItemQueryResultIds(result)
+
+	fields := tfhelper.ExpandStringList(d.Get("fields").([]interface{}))
+	if len(fields) == 0 {
+		fields = defaultWorkItemFields
+	}
+	includeRelations := d.Get("include_relations").(bool)
+
+	workItems := make([]workitemtracking.WorkItem, 0, len(ids))
+	for start := 0; start < len(ids); start += workItemsBatchSize {
+		end := min(start+workItemsBatchSize, len(ids))
+		batch, err := getWorkItemsBatch(ctx, clients, projectId, ids[start:end], fields, includeRelations)
+		if err != nil {
+			return diag.Errorf(" Reading work items. Project ID: %s, Error: %+v", projectId, err)
+		}
+		workItems = append(workItems, batch...)
+	}
+
+	d.SetId("workitems-" + uuid.New().String())
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf(" Setting ids: %+v", err)
+	}
+	if err := d.Set("work_items", flattenWorkItems(workItems, fields)); err != nil {
+		return diag.Errorf(" Setting work_items: %+v", err)
+	}
+	return nil
+}
+
+// getWorkItemsBatch fetches the work items in the order of the ids. Work items which were deleted since the query ran
+// are omitted.
+func getWorkItemsBatch(ctx context.Context, clients *client.AggregatedClient, projectId string, ids []int, fields []string, includeRelations bool) ([]workitemtracking.WorkItem, error) {
+	request := workitemtracking.WorkItemBatchGetRequest{
+		Ids:         &ids,
+		ErrorPolicy: &workitemtracking.WorkItemErrorPolicyValues.Omit,
+	}
+	// the service does not allow to combine the fields with an expansion, in that case all fields are returned
+	if includeRelations {
+		request.Expand = &workitemtracking.WorkItemExpandValues.All
+	} else {
+		request.Fields = &fields
+	}
+
+	workItems, err := clients.WorkItemTrackingClient.GetWorkItemsBatch(ctx, workitemtracking.GetWorkItemsBatchArgs{
+		Project:            converter.String(projectId),
+		WorkItemGetRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []workitemtracking.WorkItem
+	if workItems != nil {
+		for _, workItem := range *workItems {
+			// omitted work items are returned as null
+			if workItem.Id != nil {
+				result = append(result, workItem)
+			}
+		}
+	}
+	return result, nil
+}
+
+func buildWorkItemsQuery(d *schema.ResourceData) string {
+	conditions := []string{"[System.TeamProject] = @project"}
+	if v, ok := d.GetOk("type"); ok {
+		conditions = append(conditions, fmt.Sprintf("[System.WorkItemType] = %s", wiqlString(v.(string))))
+	}
+	if v, ok := d.GetOk("state"); ok {
+		conditions = append(conditions, fmt.Sprintf("[System.State] = %s", wiqlString(v.(string))))
+	}
+	if v, ok := d.GetOk("area_path"); ok {
+		conditions = append(conditions, fmt.Sprintf("[System.AreaPath] UNDER %s", wiqlString(v.(string))))
+	}
+	if v, ok := d.GetOk("iteration_path"); ok {
+		conditions = append(conditions, fmt.Sprintf("[System.IterationPath] UNDER %s", wiqlString(v.(string))))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		for _, tag := range tfhelper.ExpandStringSet(v.(*schema.Set)) {
+			conditions = append(conditions, fmt.Sprintf("[System.Tags] CONTAINS %s", wiqlString(tag)))
+		}
+	}
+	return fmt.Sprintf("SELECT [System.Id] FROM WorkItems WHERE %s ORDER BY [System.Id]", strings.Join(conditions, " AND "))
+}
+
+func wiqlString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// workItemQueryResultIds returns the ids of a flat query, or the distinct targets of a link query
+func workItemQueryResultIds(result *workitemtracking.WorkItemQueryResult) []int {
+	ids := []int{}
+	if result == nil {
+		return ids
+	}
+	if result.WorkItems != nil {
+		for _, workItem := range *result.WorkItems {
+			if workItem.Id != nil {
+				ids = append(ids, *workItem.Id)
+			}
+		}
+	}
+	if result.WorkItemRelations != nil {
+		seen := map[int]bool{}
+		for _, link := range *result.WorkItemRelations {
+			if link.Target != nil && link.Target.Id != nil && !seen[*link.Target.Id] {
+				seen[*link.Target.Id] = true
+				ids = append(ids, *link.Target.Id)
+			}
+		}
+	}
+	return ids
+}
+
+func flattenWorkItems(workItems []workitemtracking.WorkItem, fields []string) []interface{} {
+	results := make([]interface{}, 0, len(workItems))
+	for _, workItem := range workItems {
+		values := map[string]interface{}{}
+		if workItem.Fields != nil {
+			for _, field := range fields {
+				if value, ok := (*workItem.Fields)[field]; ok {
+					values[field] = workItemFieldValueToString(value)
+				}
+			}
+		}
+
+		relations := []interface{}{}
+		if workItem.Relations != nil {
+			for _, relation := range *workItem.Relations {
+				url := converter.ToString(relation.Url, "")
+				relations = append(relations, map[string]interface{}{
+					"rel":       converter.ToString(relation.Rel, ""),
+					"url":       url,
+					"target_id": workItemIdFromUrl(url),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":        converter.ToInt(workItem.Id, 0),
+			"url":       converter.ToString(workItem.Url, ""),
+			"fields":    values,
+			"relations": relations,
+		})
+	}
+	return results
+}
+
+// workItemFieldValueToString converts a field value as returned by the service, identities are represented by their
+// unique name
+func workItemFieldValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case map[string]interface{}:
+		if uniqueName, ok := v["uniqueName"]; ok {
+			return fmt.Sprint(uniqueName)
+		}
+		if displayName, ok := v["displayName"]; ok {
+			return fmt.Sprint(displayName)
+		}
+	}
+	return fmt.Sprint(value)
+}
+
+// workItemIdFromUrl returns the ID of a linked work item, 0 for links to other artifacts
+func workItemIdFromUrl(url string) int {
+	idx := strings.LastIndex(strings.ToLower(url), "/workitems/")
+	if idx < 0 {
+		return 0
+	}
+	id, err := strconv.Atoi(url[idx+len("/workitems/"):])
+	if err != nil {
+		return 0
+	}
+	return id
+}
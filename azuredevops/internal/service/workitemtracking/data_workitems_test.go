@@ -0,0 +1,136 @@
+//go:build (all || data_sources || data_workitems) && (!exclude_data_sources || !exclude_data_workitems)
+// +build all data_sources data_workitems
+// +build !exclude_data_sources !exclude_data_workitems
+
+package workitemtracking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testWorkItemsProjectID = "e7a5f3b1-3d5a-4b0e-9a55-0e0a4f0d7c21"
+
+func TestDataWorkItems_BuildQuery_FromFilters(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, DataWorkItems().Schema, map[string]interface{}{
+		"project_id": testWorkItemsProjectID,
+		"type":       "Epic",
+		"state":      "Active",
+		"area_path":  "Project\\Team's area",
+		"tags":       []interface{}{"roadmap"},
+	})
+
+	require.Equal(t,
+		"SELECT [System.Id] FROM WorkItems WHERE [System.TeamProject] = @project AND [System.WorkItemType] = 'Epic' AND [System.State] = 'Active' "+
+			"AND [System.AreaPath] UNDER 'Project\\Team''s area' AND [System.Tags] CONTAINS 'roadmap' ORDER BY [System.Id]",
+		buildWorkItemsQuery(resourceData))
+}
+
+func TestDataWorkItems_Read_FetchesInBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, DataWorkItems().Schema, map[string]interface{}{
+		"project_id": testWorkItemsProjectID,
+		"query":      "SELECT [System.Id] FROM WorkItems",
+		"fields":     []interface{}{"System.Title", "System.AssignedTo"},
+	})
+
+	references := []workitemtracking.WorkItemReference{}
+	for id := 1; id <= 450; id++ {
+		references = append(references, workitemtracking.WorkItemReference{Id: converter.Int(id)})
+	}
+	mockClient.EXPECT().QueryByWiql(clients.Ctx, gomock.Any()).Return(&workitemtracking.WorkItemQueryResult{WorkItems: &references}, nil).Times(1)
+
+	var batchSizes []int
+	mockClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.GetWorkItemsBatchArgs) (*[]workitemtracking.WorkItem, error) {
+			require.Nil(t, args.WorkItemGetRequest.Expand)
+			require.Equal(t, []string{"System.Title", "System.AssignedTo"}, *args.WorkItemGetRequest.Fields)
+			batchSizes = append(batchSizes, len(*args.WorkItemGetRequest.Ids))
+			workItems := []workitemtracking.WorkItem{}
+			for _, id := range *args.WorkItemGetRequest.Ids {
+				workItems = append(workItems, workitemtracking.WorkItem{
+					Id: converter.Int(id),
+					Fields: &map[string]interface{}{
+						"System.Title":      "Title",
+						"System.AssignedTo": map[string]interface{}{"displayName": "Jane", "uniqueName": "jane@example.com"},
+					},
+				})
+			}
+			return &workItems, nil
+		}).Times(3)
+
+	diags := dataSourceWorkItemsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, []int{200, 200, 50}, batchSizes)
+	require.Len(t, resourceData.Get("ids"), 450)
+	require.Equal(t, 450, resourceData.Get("work_items.#"))
+	fields := resourceData.Get("work_items.449.fields").(map[string]interface{})
+	require.Equal(t, "jane@example.com", fields["System.AssignedTo"])
+}
+
+func TestDataWorkItems_Read_IncludesRelations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, DataWorkItems().Schema, map[string]interface{}{
+		"project_id":        testWorkItemsProjectID,
+		"type":              "Epic",
+		"include_relations": true,
+	})
+
+	mockClient.EXPECT().QueryByWiql(clients.Ctx, gomock.Any()).Return(&workitemtracking.WorkItemQueryResult{
+		WorkItems: &[]workitemtracking.WorkItemReference{{Id: converter.Int(7)}},
+	}, nil).Times(1)
+	mockClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.GetWorkItemsBatchArgs) (*[]workitemtracking.WorkItem, error) {
+			require.Equal(t, workitemtracking.WorkItemExpandValues.All, *args.WorkItemGetRequest.Expand)
+			require.Nil(t, args.WorkItemGetRequest.Fields)
+			return &[]workitemtracking.WorkItem{{
+				Id: converter.Int(7),
+				Fields: &map[string]interface{}{
+					"System.Title":         "Epic",
+					"System.WorkItemType":  "Epic",
+					"System.State":         "New",
+					"Microsoft.VSTS.Other": 1.5,
+				},
+				Relations: &[]workitemtracking.WorkItemRelation{{
+					Rel: converter.String("System.LinkTypes.Hierarchy-Forward"),
+					Url: converter.String("https://dev.azure.com/org/_apis/wit/workItems/8"),
+				}},
+			}}, nil
+		}).Times(1)
+
+	diags := dataSourceWorkItemsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Len(t, resourceData.Get("work_items.0.fields").(map[string]interface{}), 3)
+	require.Equal(t, "System.LinkTypes.Hierarchy-Forward", resourceData.Get("work_items.0.relations.0.rel"))
+	require.Equal(t, 8, resourceData.Get("work_items.0.relations.0.target_id"))
+}
+
+func TestDataWorkItems_QueryResultIds_LinkQuery(t *testing.T) {
+	ids := workItemQueryResultIds(&workitemtracking.WorkItemQueryResult{
+		WorkItemRelations: &[]workitemtracking.WorkItemLink{
+			{Target: &workitemtracking.WorkItemReference{Id: converter.Int(1)}},
+			{Source: &workitemtracking.WorkItemReference{Id: converter.Int(1)}, Target: &workitemtracking.WorkItemReference{Id: converter.Int(2)}},
+			{Target: &workitemtracking.WorkItemReference{Id: converter.Int(3)}},
+			{Source: &workitemtracking.WorkItemReference{Id: converter.Int(3)}, Target: &workitemtracking.WorkItemReference{Id: converter.Int(2)}},
+		},
+	})
+	require.Equal(t, []int{1, 2, 3}, ids)
+}
@@ -1,7 +1,9 @@
 package workitemtracking
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -38,11 +40,12 @@ var fieldMapping = map[string]string{
 
 func ResourceWorkItem() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceWorkItemCreate,
-		Read:     resourceWorkItemRead,
-		Update:   resourceWorkItemUpdate,
-		Delete:   resourceWorkItemDelete,
-		Importer: tfhelper.ImportProjectQualifiedResource(),
+		Create:        resourceWorkItemCreate,
+		Read:          resourceWorkItemRead,
+		Update:        resourceWorkItemUpdate,
+		Delete:        resourceWorkItemDelete,
+		Importer:      tfhelper.ImportProjectQualifiedResource(),
+		CustomizeDiff: resourceWorkItemCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -249,6 +252,119 @@ func resourceWorkItemDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceWorkItemCustomizeDiff validates the custom fields and the state against the metadata of the work item type
+// at plan time, when the project and the work item type are known
+func resourceWorkItemCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChanges("project_id", "type", "state", "custom_fields") {
+		return nil
+	}
+	if !d.NewValueKnown("project_id") || !d.NewValueKnown("type") {
+		return nil
+	}
+
+	customFields := map[string]string{}
+	if d.NewValueKnown("custom_fields") && d.HasChanges("project_id", "type", "custom_fields") {
+		for name, value := range d.Get("custom_fields").(map[string]interface{}) {
+			customFields[name] = value.(string)
+		}
+	}
+
+	fromState, toState := "", ""
+	if d.NewValueKnown("state") && d.HasChange("state") {
+		toState = d.Get("state").(string)
+		// the transition only applies to existing work items, changing the project or the type replaces the work item
+		if d.Id() != "" && !d.HasChanges("project_id", "type") {
+			oldState, _ := d.GetChange("state")
+			fromState = oldState.(string)
+		}
+	}
+
+	clients := m.(*client.AggregatedClient)
+	return validateWorkItem(ctx, clients, d.Get("project_id").(string), d.Get("type").(string), customFields, fromState, toState)
+}
+
+// validateWorkItem checks that the custom fields are defined by the work item type and have an allowed value, and
+// that the work item type defines the target state and allows the transition to it
+func validateWorkItem(ctx context.Context, clients *client.AggregatedClient, projectId string, workItemType string, customFields map[string]string, fromState string, toState string) error {
+	if len(customFields) > 0 {
+		fields, err := getWorkItemTypeFields(ctx, clients, projectId, workItemType, workitemtracking.WorkItemTypeFieldsExpandLevelValues.AllowedValues)
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(customFields))
+		referenceNames := make([]string, 0, len(customFields))
+		for name := range customFields {
+			names = append(names, name)
+			referenceNames = append(referenceNames, "Custom."+name)
+		}
+		sort.Strings(names)
+
+		if unknown := unknownWorkItemTypeFields(fields, referenceNames); len(unknown) > 0 {
+			for idx := range unknown {
+				unknown[idx] = strings.TrimPrefix(unknown[idx], "Custom.")
+			}
+			return fmt.Errorf(" `custom_fields` contains fields which are not defined by work item type %s: %s. Custom fields are configured by their name without the `Custom.` prefix", workItemType, strings.Join(unknown, ", "))
+		}
+
+		for _, name := range names {
+			field := fields[strings.ToLower("Custom."+name)]
+			if workItemTypeFieldAllowsValue(field, customFields[name]) {
+				continue
+			}
+			// picklists which allow to enter custom values return the suggested values as allowed values
+			definition, err := clients.WorkItemTrackingClient.GetWorkItemField(ctx, workitemtracking.GetWorkItemFieldArgs{
+				Project:            converter.String(projectId),
+				FieldNameOrRefName: field.ReferenceName,
+			})
+			if err != nil {
+				return fmt.Errorf(" Looking up custom field %s: %+v", name, err)
+			}
+			if converter.ToBool(definition.IsPicklistSuggested, false) {
+				continue
+			}
+			return fmt.Errorf(" Value %q of custom field %s is not allowed by work item type %s. Allowed values: %s", customFields[name], name, workItemType, strings.Join(workItemTypeFieldAllowedValues(field), ", "))
+		}
+	}
+
+	if toState != "" {
+		workItemTypeDefinition, err := getWorkItemType(ctx, clients, projectId, workItemType)
+		if err != nil {
+			return err
+		}
+
+		states := []string{}
+		for _, state := range flattenWorkItemTypeStates(workItemTypeDefinition.States) {
+			states = append(states, state.(map[string]interface{})["name"].(string))
+		}
+		if !containsFold(states, toState) {
+			return fmt.Errorf(" State %s is not defined by work item type %s. Defined states: %s", toState, workItemType, strings.Join(states, ", "))
+		}
+
+		if fromState != "" && !strings.EqualFold(fromState, toState) && workItemTypeDefinition.Transitions != nil {
+			for from, transitions := range *workItemTypeDefinition.Transitions {
+				if !strings.EqualFold(from, fromState) {
+					continue
+				}
+				targets := workItemStateTransitionTargets(transitions)
+				if !containsFold(targets, toState) {
+					return fmt.Errorf(" Work item type %s does not allow the transition from state %s to state %s. Allowed target states: %s", workItemType, fromState, toState, strings.Join(targets, ", "))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
 func expandCustomFields(d *schema.ResourceData, operations []webapi.JsonPatchOperation) []webapi.JsonPatchOperation {
 	customFields := d.Get("custom_fields").(map[string]interface{})
 	for customFieldName, customFieldValue := range customFields {
@@ -0,0 +1,241 @@
+package workitemtracking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceWorkItemTemplate schema and implementation for a work item template of a team
+func ResourceWorkItemTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkItemTemplateCreate,
+		ReadContext:   resourceWorkItemTemplateRead,
+		UpdateContext: resourceWorkItemTemplateUpdate,
+		DeleteContext: resourceWorkItemTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				projectId, teamId, templateId, err := parseWorkItemTemplateImportId(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectId)
+				d.Set("team_id", teamId)
+				d.SetId(templateId)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: resourceWorkItemTemplateCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"work_item_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceWorkItemTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+	teamId := d.Get("team_id").(string)
+
+	template := expandWorkItemTemplate(d)
+	if err := validateWorkItemTypeFields(ctx, clients, projectId, *template.WorkItemTypeName, "fields", workItemTemplateFieldNames(template)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	createdTemplate, err := clients.WorkItemTrackingClient.CreateTemplate(ctx, workitemtracking.CreateTemplateArgs{
+		Project:  converter.String(projectId),
+		Team:     converter.String(teamId),
+		Template: template,
+	})
+	if err != nil {
+		return diag.Errorf(" Creating work item template. Project ID: %s, Team ID: %s, Error: %+v", projectId, teamId, err)
+	}
+
+	d.SetId(createdTemplate.Id.String())
+	return resourceWorkItemTemplateRead(ctx, d, m)
+}
+
+func resourceWorkItemTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+	teamId := d.Get("team_id").(string)
+
+	templateId, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing work item template ID %s: %+v", d.Id(), err)
+	}
+
+	template, err := clients.WorkItemTrackingClient.GetTemplate(ctx, workitemtracking.GetTemplateArgs{
+		Project:    converter.String(projectId),
+		Team:       converter.String(teamId),
+		TemplateId: &templateId,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading work item template. Project ID: %s, Team ID: %s, Template ID: %s, Error: %+v", projectId, teamId, d.Id(), err)
+	}
+
+	flattenWorkItemTemplate(d, template)
+	return nil
+}
+
+func resourceWorkItemTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+	teamId := d.Get("team_id").(string)
+
+	template := expandWorkItemTemplate(d)
+	if d.HasChange("fields") {
+		if err := validateWorkItemTypeFields(ctx, clients, projectId, *template.WorkItemTypeName, "fields", workItemTemplateFieldNames(template)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err := clients.WorkItemTrackingClient.ReplaceTemplate(ctx, workitemtracking.ReplaceTemplateArgs{
+		Project:         converter.String(projectId),
+		Team:            converter.String(teamId),
+		TemplateId:      template.Id,
+		TemplateContent: template,
+	})
+	if err != nil {
+		return diag.Errorf(" Updating work item template. Project ID: %s, Team ID: %s, Template ID: %s, Error: %+v", projectId, teamId, d.Id(), err)
+	}
+	return resourceWorkItemTemplateRead(ctx, d, m)
+}
+
+func resourceWorkItemTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectId := d.Get("project_id").(string)
+	teamId := d.Get("team_id").(string)
+
+	err := clients.WorkItemTrackingClient.DeleteTemplate(ctx, workitemtracking.DeleteTemplateArgs{
+		Project:    converter.String(projectId),
+		Team:       converter.String(teamId),
+		TemplateId: converter.UUID(d.Id()),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Deleting work item template. Project ID: %s, Team ID: %s, Template ID: %s, Error: %+v", projectId, teamId, d.Id(), err)
+	}
+	return nil
+}
+
+// resourceWorkItemTemplateCustomizeDiff validates the field reference names at plan time, when the project and the
+// work item type are known
+func resourceWorkItemTemplateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChanges("project_id", "work_item_type", "fields") {
+		return nil
+	}
+	for _, key := range []string{"project_id", "work_item_type", "fields"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	var referenceNames []string
+	for referenceName := range d.Get("fields").(map[string]interface{}) {
+		referenceNames = append(referenceNames, referenceName)
+	}
+	clients := m.(*client.AggregatedClient)
+	return validateWorkItemTypeFields(ctx, clients, d.Get("project_id").(string), d.Get("work_item_type").(string), "fields", referenceNames)
+}
+
+func expandWorkItemTemplate(d *schema.ResourceData) *workitemtracking.WorkItemTemplate {
+	fields := map[string]string{}
+	for referenceName, value := range d.Get("fields").(map[string]interface{}) {
+		fields[referenceName] = value.(string)
+	}
+
+	template := workitemtracking.WorkItemTemplate{
+		Name:             converter.String(d.Get("name").(string)),
+		Description:      converter.String(d.Get("description").(string)),
+		WorkItemTypeName: converter.String(d.Get("work_item_type").(string)),
+		Fields:           &fields,
+	}
+	if d.Id() != "" {
+		template.Id = converter.UUID(d.Id())
+	}
+	return &template
+}
+
+func flattenWorkItemTemplate(d *schema.ResourceData, template *workitemtracking.WorkItemTemplate) {
+	d.Set("name", converter.ToString(template.Name, ""))
+	d.Set("description", converter.ToString(template.Description, ""))
+	d.Set("work_item_type", converter.ToString(template.WorkItemTypeName, ""))
+
+	fields := map[string]string{}
+	if template.Fields != nil {
+		fields = *template.Fields
+	}
+	d.Set("fields", fields)
+}
+
+func workItemTemplateFieldNames(template *workitemtracking.WorkItemTemplate) []string {
+	var referenceNames []string
+	if template.Fields != nil {
+		for referenceName := range *template.Fields {
+			referenceNames = append(referenceNames, referenceName)
+		}
+	}
+	return referenceNames
+}
+
+func parseWorkItemTemplateImportId(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf(" Unexpected format of ID (%s), expected <project ID>/<team ID>/<template ID>", id)
+	}
+	if _, err := uuid.Parse(parts[2]); err != nil {
+		return "", "", "", fmt.Errorf(" Template ID (%s) is not a valid UUID", parts[2])
+	}
+	return parts[0], parts[1], parts[2], nil
+}
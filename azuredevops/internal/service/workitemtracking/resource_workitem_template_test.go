@@ -0,0 +1,115 @@
+//go:build (all || resource_workitem_template) && !exclude_resource_workitem_template
+// +build all resource_workitem_template
+// +build !exclude_resource_workitem_template
+
+package workitemtracking
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testWorkItemTemplateProjectID = "e7a5f3b1-3d5a-4b0e-9a55-0e0a4f0d7c21"
+	testWorkItemTemplateTeamID    = "1b6e3a0c-2f4d-4d8e-b6a1-7c9d5e2f8a13"
+	testWorkItemTemplateID        = "3c0d4b7e-9f1a-4d2b-8e6c-5a7f9b1d2e4c"
+)
+
+var testBugFields = []workitemtracking.WorkItemTypeFieldWithReferences{
+	{ReferenceName: converter.String("System.Title")},
+	{ReferenceName: converter.String("System.Tags")},
+	{ReferenceName: converter.String("Microsoft.VSTS.TCM.ReproSteps")},
+}
+
+func getWorkItemTemplateResourceData(t *testing.T, fields map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceWorkItemTemplate().Schema, map[string]interface{}{
+		"project_id":     testWorkItemTemplateProjectID,
+		"team_id":        testWorkItemTemplateTeamID,
+		"work_item_type": "Bug",
+		"name":           "New bug from production incident",
+		"fields":         fields,
+	})
+}
+
+func TestWorkItemTemplate_Create_RejectsUnknownFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	resourceData := getWorkItemTemplateResourceData(t, map[string]interface{}{
+		"system.title":  "Incident: ",
+		"Custom.Impact": "High",
+	})
+
+	mockClient.EXPECT().GetWorkItemTypeFieldsWithReferences(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.GetWorkItemTypeFieldsWithReferencesArgs) (*[]workitemtracking.WorkItemTypeFieldWithReferences, error) {
+			require.Equal(t, "Bug", *args.Type)
+			return &testBugFields, nil
+		}).Times(1)
+	mockClient.EXPECT().CreateTemplate(gomock.Any(), gomock.Any()).Times(0)
+
+	diags := resourceWorkItemTemplateCreate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "not defined by work item type Bug: Custom.Impact.")
+	require.NotContains(t, diags[0].Summary, "system.title")
+}
+
+func TestWorkItemTemplate_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	resourceData := getWorkItemTemplateResourceData(t, map[string]interface{}{
+		"System.Tags": "incident",
+	})
+
+	mockClient.EXPECT().GetWorkItemTypeFieldsWithReferences(clients.Ctx, gomock.Any()).Return(&testBugFields, nil).Times(1)
+	mockClient.EXPECT().CreateTemplate(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.CreateTemplateArgs) (*workitemtracking.WorkItemTemplate, error) {
+			require.Equal(t, testWorkItemTemplateTeamID, *args.Team)
+			require.Equal(t, map[string]string{"System.Tags": "incident"}, *args.Template.Fields)
+			return nil, errors.New("CreateTemplate() Failed")
+		}).Times(1)
+
+	diags := resourceWorkItemTemplateCreate(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "CreateTemplate() Failed")
+}
+
+func TestWorkItemTemplate_Flatten(t *testing.T) {
+	resourceData := getWorkItemTemplateResourceData(t, nil)
+	flattenWorkItemTemplate(resourceData, &workitemtracking.WorkItemTemplate{
+		Name:             converter.String("Incident"),
+		Description:      converter.String("Created from an incident"),
+		WorkItemTypeName: converter.String("Bug"),
+		Fields:           &map[string]string{"System.Tags": "incident"},
+	})
+
+	require.Equal(t, "Incident", resourceData.Get("name"))
+	require.Equal(t, "Created from an incident", resourceData.Get("description"))
+	require.Equal(t, map[string]interface{}{"System.Tags": "incident"}, resourceData.Get("fields"))
+}
+
+func TestWorkItemTemplate_ParseImportId(t *testing.T) {
+	projectId, teamId, templateId, err := parseWorkItemTemplateImportId(testWorkItemTemplateProjectID + "/" + testWorkItemTemplateTeamID + "/" + testWorkItemTemplateID)
+	require.NoError(t, err)
+	require.Equal(t, testWorkItemTemplateProjectID, projectId)
+	require.Equal(t, testWorkItemTemplateTeamID, teamId)
+	require.Equal(t, testWorkItemTemplateID, templateId)
+
+	_, _, _, err = parseWorkItemTemplateImportId(testWorkItemTemplateProjectID + "/" + testWorkItemTemplateTeamID + "/template")
+	require.Error(t, err)
+}
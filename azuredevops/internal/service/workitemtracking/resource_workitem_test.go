@@ -5,10 +5,16 @@
 package workitemtracking
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestWorkItem_GetWorkItem(t *testing.T) {
@@ -35,3 +41,66 @@ func TestWorkItem_GetWorkItem(t *testing.T) {
 	require.Equal(t, "SomeValue", custom_fields["SomeName"].(string))
 	require.Equal(t, "bar", custom_fields["foo"].(string))
 }
+
+func TestWorkItem_Validate_UnknownCustomField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetWorkItemTypeFieldsWithReferences(clients.Ctx, gomock.Any()).Return(testWorkItemTypeFields(), nil).Times(1)
+
+	err := validateWorkItem(clients.Ctx, clients, testWorkItemTypeProjectID, "Bug", map[string]string{"Enviroment": "Test", "Notes": "Some notes"}, "", "")
+	require.ErrorContains(t, err, "not defined by work item type Bug: Enviroment.")
+}
+
+func TestWorkItem_Validate_DisallowedPicklistValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetWorkItemTypeFieldsWithReferences(clients.Ctx, gomock.Any()).Return(testWorkItemTypeFields(), nil).Times(1)
+	mockClient.EXPECT().GetWorkItemField(clients.Ctx, workitemtracking.GetWorkItemFieldArgs{
+		Project:            converter.String(testWorkItemTypeProjectID),
+		FieldNameOrRefName: converter.String("Custom.Environment"),
+	}).Return(&workitemtracking.WorkItemField2{IsPicklistSuggested: converter.Bool(false)}, nil).Times(1)
+
+	err := validateWorkItem(clients.Ctx, clients, testWorkItemTypeProjectID, "Bug", map[string]string{"Environment": "Staging", "Component": "frontend"}, "", "")
+	require.ErrorContains(t, err, `Value "Staging" of custom field Environment is not allowed by work item type Bug. Allowed values: Test, Production`)
+}
+
+func TestWorkItem_Validate_SuggestedPicklistValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetWorkItemTypeFieldsWithReferences(clients.Ctx, gomock.Any()).Return(testWorkItemTypeFields(), nil).Times(1)
+	mockClient.EXPECT().GetWorkItemField(clients.Ctx, gomock.Any()).Return(&workitemtracking.WorkItemField2{IsPicklistSuggested: converter.Bool(true)}, nil).Times(1)
+
+	err := validateWorkItem(clients.Ctx, clients, testWorkItemTypeProjectID, "Bug", map[string]string{"Environment": "Staging"}, "", "")
+	require.NoError(t, err)
+}
+
+func TestWorkItem_Validate_States(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetWorkItemType(clients.Ctx, gomock.Any()).Return(testWorkItemTypeDefinition(), nil).Times(4)
+
+	err := validateWorkItem(clients.Ctx, clients, testWorkItemTypeProjectID, "Bug", nil, "", "Resolved")
+	require.ErrorContains(t, err, "State Resolved is not defined by work item type Bug. Defined states: New, Active, Closed")
+
+	err = validateWorkItem(clients.Ctx, clients, testWorkItemTypeProjectID, "Bug", nil, "Active", "New")
+	require.ErrorContains(t, err, "does not allow the transition from state Active to state New. Allowed target states: Closed")
+
+	require.NoError(t, validateWorkItem(clients.Ctx, clients, testWorkItemTypeProjectID, "Bug", nil, "", "Active"))
+	require.NoError(t, validateWorkItem(clients.Ctx, clients, testWorkItemTypeProjectID, "Bug", nil, "closed", "active"))
+}
@@ -0,0 +1,104 @@
+package workitemtracking
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// getWorkItemType returns a work item type including its states and transitions
+func getWorkItemType(ctx context.Context, clients *client.AggregatedClient, projectId string, workItemType string) (*workitemtracking.WorkItemType, error) {
+	workItemTypeDefinition, err := clients.WorkItemTrackingClient.GetWorkItemType(ctx, workitemtracking.GetWorkItemTypeArgs{
+		Project: converter.String(projectId),
+		Type:    converter.String(workItemType),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, fmt.Errorf(" Work item type %s does not exist in project %s", workItemType, projectId)
+		}
+		return nil, fmt.Errorf(" Looking up work item type %s: %+v", workItemType, err)
+	}
+	return workItemTypeDefinition, nil
+}
+
+// getWorkItemTypeFields returns the fields of a work item type by their lower case reference name
+func getWorkItemTypeFields(ctx context.Context, clients *client.AggregatedClient, projectId string, workItemType string, expand workitemtracking.WorkItemTypeFieldsExpandLevel) (map[string]workitemtracking.WorkItemTypeFieldWithReferences, error) {
+	fields, err := clients.WorkItemTrackingClient.GetWorkItemTypeFieldsWithReferences(ctx, workitemtracking.GetWorkItemTypeFieldsWithReferencesArgs{
+		Project: converter.String(projectId),
+		Type:    converter.String(workItemType),
+		Expand:  &expand,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil, fmt.Errorf(" Work item type %s does not exist in project %s", workItemType, projectId)
+		}
+		return nil, fmt.Errorf(" Looking up the fields of work item type %s: %+v", workItemType, err)
+	}
+
+	result := map[string]workitemtracking.WorkItemTypeFieldWithReferences{}
+	if fields != nil {
+		for _, field := range *fields {
+			result[strings.ToLower(converter.ToString(field.ReferenceName, ""))] = field
+		}
+	}
+	return result, nil
+}
+
+// validateWorkItemTypeFields checks that the fields, given by reference name, are defined by the work item type
+func validateWorkItemTypeFields(ctx context.Context, clients *client.AggregatedClient, projectId string, workItemType string, argument string, referenceNames []string) error {
+	if len(referenceNames) == 0 {
+		return nil
+	}
+	fields, err := getWorkItemTypeFields(ctx, clients, projectId, workItemType, workitemtracking.WorkItemTypeFieldsExpandLevelValues.None)
+	if err != nil {
+		return err
+	}
+
+	if unknown := unknownWorkItemTypeFields(fields, referenceNames); len(unknown) > 0 {
+		return fmt.Errorf(" `%s` contains fields which are not defined by work item type %s: %s. Fields are identified by their reference name, e.g. `System.Title`", argument, workItemType, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// unknownWorkItemTypeFields returns the sorted reference names which are not defined by the work item type
+func unknownWorkItemTypeFields(fields map[string]workitemtracking.WorkItemTypeFieldWithReferences, referenceNames []string) []string {
+	var unknown []string
+	for _, referenceName := range referenceNames {
+		if _, ok := fields[strings.ToLower(referenceName)]; !ok {
+			unknown = append(unknown, referenceName)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// workItemTypeFieldAllowsValue checks a value against the allowed values of a field. Fields without allowed values
+// accept any value.
+func workItemTypeFieldAllowsValue(field workitemtracking.WorkItemTypeFieldWithReferences, value string) bool {
+	if field.AllowedValues == nil || len(*field.AllowedValues) == 0 {
+		return true
+	}
+	for _, allowedValue := range *field.AllowedValues {
+		if strings.EqualFold(fmt.Sprint(allowedValue), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// workItemTypeFieldAllowedValues returns the allowed values of a field as strings
+func workItemTypeFieldAllowedValues(field workitemtracking.WorkItemTypeFieldWithReferences) []string {
+	values := []string{}
+	if field.AllowedValues != nil {
+		for _, allowedValue := range *field.AllowedValues {
+			values = append(values, fmt.Sprint(allowedValue))
+		}
+	}
+	return values
+}
@@ -0,0 +1,83 @@
+package workitemtracking
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+const testWorkItemTypeProjectID = "0f3c1d8a-5b6e-4c2f-8a7d-9e1b2c3d4e5f"
+
+func testWorkItemTypeDefinition() *workitemtracking.WorkItemType {
+	return &workitemtracking.WorkItemType{
+		Name:          converter.String("Bug"),
+		ReferenceName: converter.String("Microsoft.VSTS.WorkItemTypes.Bug"),
+		Color:         converter.String("CC293D"),
+		IsDisabled:    converter.Bool(false),
+		Icon:          &workitemtracking.WorkItemIcon{Url: converter.String("https://example.com/icon_insect")},
+		States: &[]workitemtracking.WorkItemStateColor{
+			{Name: converter.String("New"), Category: converter.String("Proposed"), Color: converter.String("b2b2b2")},
+			{Name: converter.String("Active"), Category: converter.String("InProgress"), Color: converter.String("007acc")},
+			{Name: converter.String("Closed"), Category: converter.String("Completed"), Color: converter.String("339933")},
+		},
+		Transitions: &map[string][]workitemtracking.WorkItemStateTransition{
+			"":       {{To: converter.String("New")}},
+			"New":    {{To: converter.String("Active")}, {To: converter.String("Closed")}},
+			"Active": {{To: converter.String("Closed")}},
+			"Closed": {{To: converter.String("Active")}},
+		},
+	}
+}
+
+func testWorkItemTypeFields() *[]workitemtracking.WorkItemTypeFieldWithReferences {
+	return &[]workitemtracking.WorkItemTypeFieldWithReferences{
+		{
+			ReferenceName:  converter.String("System.Title"),
+			Name:           converter.String("Title"),
+			AlwaysRequired: converter.Bool(true),
+		},
+		{
+			ReferenceName: converter.String("Microsoft.VSTS.Common.Priority"),
+			Name:          converter.String("Priority"),
+			DefaultValue:  float64(2),
+			AllowedValues: &[]interface{}{float64(1), float64(2), float64(3), float64(4)},
+		},
+		{
+			ReferenceName: converter.String("Custom.Environment"),
+			Name:          converter.String("Environment"),
+			AllowedValues: &[]interface{}{"Test", "Production"},
+		},
+		{
+			ReferenceName: converter.String("Custom.Component"),
+			Name:          converter.String("Component"),
+			AllowedValues: &[]interface{}{"Frontend", "Backend"},
+		},
+		{
+			ReferenceName: converter.String("Custom.Notes"),
+			Name:          converter.String("Notes"),
+		},
+	}
+}
+
+func TestWorkItemTypeFields_UnknownFields(t *testing.T) {
+	fields := map[string]workitemtracking.WorkItemTypeFieldWithReferences{}
+	for _, field := range *testWorkItemTypeFields() {
+		fields[strings.ToLower(*field.ReferenceName)] = field
+	}
+
+	require.Equal(t, []string{"Custom.Missing", "System.Titel"}, unknownWorkItemTypeFields(fields, []string{"System.Titel", "system.title", "Custom.Missing"}))
+	require.Empty(t, unknownWorkItemTypeFields(fields, []string{"Custom.Notes"}))
+}
+
+func TestWorkItemTypeFields_AllowsValue(t *testing.T) {
+	fields := *testWorkItemTypeFields()
+
+	require.True(t, workItemTypeFieldAllowsValue(fields[0], "Any title"))
+	require.True(t, workItemTypeFieldAllowsValue(fields[1], "3"))
+	require.False(t, workItemTypeFieldAllowsValue(fields[1], "5"))
+	require.True(t, workItemTypeFieldAllowsValue(fields[2], "production"))
+	require.False(t, workItemTypeFieldAllowsValue(fields[2], "Staging"))
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GetOrganizationName returns the name of the organization, or of the collection for an Azure DevOps Server URL, e.g.
+// https://dev.azure.com/<org>, https://<org>.visualstudio.com or https://<server>/tfs/<collection>
+func GetOrganizationName(organizationURL string) (string, error) {
+	u, err := url.Parse(organizationURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing organization URL %q: %+v", organizationURL, err)
+	}
+	host := strings.ToLower(u.Hostname())
+	if strings.HasSuffix(host, ".visualstudio.com") {
+		return strings.TrimSuffix(host, ".visualstudio.com"), nil
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	name := segments[len(segments)-1]
+	// dev.azure.com URLs may contain more than the organization, e.g. when copied from the browser
+	if host == "dev.azure.com" {
+		name = segments[0]
+	}
+	if name == "" {
+		return "", fmt.Errorf("unable to determine the organization name from organization URL %q", organizationURL)
+	}
+	return name, nil
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestGetOrganizationName(t *testing.T) {
+	cases := map[string]string{
+		"https://dev.azure.com/myorg":                   "myorg",
+		"https://dev.azure.com/myorg/":                  "myorg",
+		"https://dev.azure.com/myorg/myproject":         "myorg",
+		"https://myorg.visualstudio.com":                "myorg",
+		"https://MyOrg.visualstudio.com/":               "myorg",
+		"https://tfs.contoso.com/tfs/DefaultCollection": "DefaultCollection",
+		"https://tfs.contoso.com/DefaultCollection/":    "DefaultCollection",
+	}
+	for organizationURL, expected := range cases {
+		name, err := GetOrganizationName(organizationURL)
+		if err != nil {
+			t.Errorf("Expected no error for %s, got: %+v", organizationURL, err)
+		}
+		if name != expected {
+			t.Errorf("Expected %s for %s, got: %s", expected, organizationURL, name)
+		}
+	}
+
+	for _, organizationURL := range []string{"https://dev.azure.com", "https://tfs.contoso.com/"} {
+		if _, err := GetOrganizationName(organizationURL); err == nil {
+			t.Errorf("Expected an error for %s", organizationURL)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
@@ -102,6 +103,18 @@ func ExpandStringSet(d *schema.Set) []string {
 	return ExpandStringList(d.List())
 }
 
+// ExpandStringMap returns the values of a map of interface as a map of string, the map is empty if d is nil
+func ExpandStringMap(d interface{}) map[string]string {
+	vs := map[string]string{}
+	if d == nil {
+		return vs
+	}
+	for k, v := range d.(map[string]interface{}) {
+		vs[k] = v.(string)
+	}
+	return vs
+}
+
 // ImportProjectQualifiedResource Import a resource by an ID that looks like one of the following:
 //
 //	<project ID>/<resource ID>
@@ -205,3 +218,15 @@ func FindMapInSetWithGivenKeyValue(d *schema.ResourceData, setName string, keyNa
 	}
 	return nil
 }
+
+// GetWriteOnlyString returns the value of a write-only string attribute at the given path. Write-only values are never
+// persisted in the plan or the state, they can only be read from the raw configuration while applying.
+//
+// The second return value is `false` if the attribute is not set in the configuration.
+func GetWriteOnlyString(d *schema.ResourceData, path cty.Path) (string, bool) {
+	value, diags := d.GetRawConfigAt(path)
+	if diags.HasError() || !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+		return "", false
+	}
+	return value.AsString(), true
+}
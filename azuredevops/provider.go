@@ -33,6 +33,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/servicehook"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/wiki"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/work"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtracking"
 )
 
@@ -42,6 +43,7 @@ func Provider() *schema.Provider {
 		ResourcesMap: map[string]*schema.Resource{
 			"azuredevops_agent_pool":                                  taskagent.ResourceAgentPool(),
 			"azuredevops_agent_queue":                                 taskagent.ResourceAgentQueue(),
+			"azuredevops_agent_settings":                              taskagent.ResourceAgentSettings(),
 			"azuredevops_area_permissions":                            permissions.ResourceAreaPermissions(),
 			"azuredevops_branch_policy_auto_reviewers":                branch.ResourceBranchPolicyAutoReviewers(),
 			"azuredevops_branch_policy_build_validation":              branch.ResourceBranchPolicyBuildValidation(),
@@ -55,17 +57,25 @@ func Provider() *schema.Provider {
 			"azuredevops_build_folder":                                build.ResourceBuildFolder(),
 			"azuredevops_build_folder_permissions":                    permissions.ResourceBuildFolderPermissions(),
 			"azuredevops_check_approval":                              approvalsandchecks.ResourceCheckApproval(),
+			"azuredevops_check_azure_function":                        approvalsandchecks.ResourceCheckAzureFunction(),
+			"azuredevops_check_azure_monitor_alerts":                  approvalsandchecks.ResourceCheckAzureMonitorAlerts(),
 			"azuredevops_check_branch_control":                        approvalsandchecks.ResourceCheckBranchControl(),
 			"azuredevops_check_business_hours":                        approvalsandchecks.ResourceCheckBusinessHours(),
+			"azuredevops_check_evaluate_artifact":                     approvalsandchecks.ResourceCheckEvaluateArtifact(),
 			"azuredevops_check_exclusive_lock":                        approvalsandchecks.ResourceCheckExclusiveLock(),
 			"azuredevops_check_required_template":                     approvalsandchecks.ResourceCheckRequiredTemplate(),
 			"azuredevops_check_rest_api":                              approvalsandchecks.ResourceCheckRestAPI(),
 			"azuredevops_dashboard":                                   dashboard.ResourceDashboard(),
+			"azuredevops_delivery_plan":                               work.ResourceDeliveryPlan(),
+			"azuredevops_delivery_plan_permissions":                   permissions.ResourceDeliveryPlanPermissions(),
+			"azuredevops_deployment_group":                            taskagent.ResourceDeploymentGroup(),
+			"azuredevops_deployment_target_tags":                      taskagent.ResourceDeploymentTargetTags(),
 			"azuredevops_elastic_pool":                                taskagent.ResourceAgentPoolVMSS(),
 			"azuredevops_environment":                                 taskagent.ResourceEnvironment(),
 			"azuredevops_environment_resource_kubernetes":             taskagent.ResourceEnvironmentKubernetes(),
 			"azuredevops_extension":                                   extension.ResourceExtension(),
 			"azuredevops_feed":                                        feed.ResourceFeed(),
+			"azuredevops_feed_package_version":                        feed.ResourceFeedPackageVersion(),
 			"azuredevops_feed_permission":                             feed.ResourceFeedPermission(),
 			"azuredevops_feed_retention_policy":                       feed.ResourceFeedRetentionPolicy(),
 			"azuredevops_git_permissions":                             permissions.ResourceGitPermissions(),
@@ -104,6 +114,7 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_checkmarx_one":               serviceendpoint.ResourceServiceEndpointCheckMarxOneService(),
 			"azuredevops_serviceendpoint_checkmarx_sca":               serviceendpoint.ResourceServiceEndpointCheckMarxSCA(),
 			"azuredevops_serviceendpoint_checkmarx_sast":              serviceendpoint.ResourceServiceEndpointCheckMarxSAST(),
+			"azuredevops_serviceendpoint_custom":                      serviceendpoint.ResourceServiceEndpointCustom(),
 			"azuredevops_serviceendpoint_dockerregistry":              serviceendpoint.ResourceServiceEndpointDockerRegistry(),
 			"azuredevops_serviceendpoint_dynamics_lifecycle_services": serviceendpoint.ResourceServiceEndpointDynamicsLifecycleServices(),
 			"azuredevops_serviceendpoint_externaltfs":                 serviceendpoint.ResourceServiceEndpointExternalTFS(),
@@ -129,37 +140,51 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_permissions":                 permissions.ResourceServiceEndpointPermissions(),
 			"azuredevops_serviceendpoint_runpipeline":                 serviceendpoint.ResourceServiceEndpointRunPipeline(),
 			"azuredevops_serviceendpoint_servicefabric":               serviceendpoint.ResourceServiceEndpointServiceFabric(),
+			"azuredevops_serviceendpoint_share":                       serviceendpoint.ResourceServiceEndpointShare(),
 			"azuredevops_serviceendpoint_snyk":                        serviceendpoint.ResourceServiceEndpointSnyk(),
 			"azuredevops_serviceendpoint_sonarcloud":                  serviceendpoint.ResourceServiceEndpointSonarCloud(),
 			"azuredevops_serviceendpoint_sonarqube":                   serviceendpoint.ResourceServiceEndpointSonarQube(),
 			"azuredevops_serviceendpoint_ssh":                         serviceendpoint.ResourceServiceEndpointSSH(),
 			"azuredevops_serviceendpoint_visualstudiomarketplace":     serviceendpoint.ResourceServiceEndpointMarketplace(),
+			"azuredevops_servicehook_azure_service_bus":               servicehook.ResourceServicehookAzureServiceBus(),
 			"azuredevops_servicehook_permissions":                     permissions.ResourceServiceHookPermissions(),
 			"azuredevops_servicehook_storage_queue_pipelines":         servicehook.ResourceServicehookStorageQueuePipelines(),
 			"azuredevops_servicehook_subscription":                    servicehook.ResourceServicehookSubscription(),
 			"azuredevops_service_principal_entitlement":               memberentitlementmanagement.ResourceServicePrincipalEntitlement(),
+			"azuredevops_servicehook_webhook":                         servicehook.ResourceServicehookWebhook(),
 			"azuredevops_tagging_permissions":                         permissions.ResourceTaggingPermissions(),
+			"azuredevops_task_group":                                  taskagent.ResourceTaskGroup(),
 			"azuredevops_team":                                        core.ResourceTeam(),
 			"azuredevops_team_administrators":                         core.ResourceTeamAdministrators(),
+			"azuredevops_team_board":                                  work.ResourceTeamBoard(),
 			"azuredevops_team_members":                                core.ResourceTeamMembers(),
+			"azuredevops_team_settings":                               work.ResourceTeamSettings(),
 			"azuredevops_user_entitlement":                            memberentitlementmanagement.ResourceUserEntitlement(),
 			"azuredevops_variable_group":                              taskagent.ResourceVariableGroup(),
 			"azuredevops_variable_group_permissions":                  permissions.ResourceVariableGroupPermissions(),
 			"azuredevops_wiki":                                        wiki.ResourceWiki(),
 			"azuredevops_wiki_page":                                   wiki.ResourceWikiPage(),
 			"azuredevops_workitem":                                    workitemtracking.ResourceWorkItem(),
+			"azuredevops_workitem_template":                           workitemtracking.ResourceWorkItemTemplate(),
 			"azuredevops_workitemquery_permissions":                   permissions.ResourceWorkItemQueryPermissions(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"azuredevops_agent_pool":                     taskagent.DataAgentPool(),
 			"azuredevops_agent_pools":                    taskagent.DataAgentPools(),
 			"azuredevops_agent_queue":                    taskagent.DataAgentQueue(),
+			"azuredevops_agents":                         taskagent.DataAgents(),
 			"azuredevops_area":                           workitemtracking.DataArea(),
 			"azuredevops_build_definition":               build.DataBuildDefinition(),
+			"azuredevops_checks":                         approvalsandchecks.DataChecks(),
 			"azuredevops_client_config":                  service.DataClientConfig(),
+			"azuredevops_deleted_projects":               core.DataDeletedProjects(),
+			"azuredevops_deployment_group_targets":       taskagent.DataDeploymentGroupTargets(),
 			"azuredevops_descriptor":                     graph.DataDescriptor(),
 			"azuredevops_environment":                    taskagent.DataEnvironment(),
 			"azuredevops_feed":                           feed.DataFeed(),
+			"azuredevops_feed_package":                   feed.DataFeedPackage(),
+			"azuredevops_feed_packages":                  feed.DataFeedPackages(),
+			"azuredevops_git_deleted_repositories":       git.DataGitDeletedRepositories(),
 			"azuredevops_git_repositories":               git.DataGitRepositories(),
 			"azuredevops_git_repository":                 git.DataGitRepository(),
 			"azuredevops_git_repository_file":            git.DataGitRepositoryFile(),
@@ -173,6 +198,7 @@ func Provider() *schema.Provider {
 			"azuredevops_project":                        core.DataProject(),
 			"azuredevops_projects":                       core.DataProjects(),
 			"azuredevops_securityrole_definitions":       securityroles.DataSecurityRoleDefinitions(),
+			"azuredevops_serviceendpoint":                serviceendpoint.DataServiceEndpoint(),
 			"azuredevops_serviceendpoint_azurecr":        serviceendpoint.DataResourceServiceEndpointAzureCR(),
 			"azuredevops_serviceendpoint_azurerm":        serviceendpoint.DataServiceEndpointAzureRM(),
 			"azuredevops_serviceendpoint_bitbucket":      serviceendpoint.DataResourceServiceEndpointBitbucket(),
@@ -181,12 +207,17 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_npm":            serviceendpoint.DataResourceServiceEndpointNpm(),
 			"azuredevops_serviceendpoint_sonarcloud":     serviceendpoint.DataResourceServiceEndpointSonarCloud(),
 			"azuredevops_service_principal":              graph.DataServicePrincipal(),
+			"azuredevops_serviceendpoint_usage":          serviceendpoint.DataServiceEndpointUsage(),
+			"azuredevops_serviceendpoints":               serviceendpoint.DataServiceEndpoints(),
+			"azuredevops_servicehook_metadata":           servicehook.DataServicehookMetadata(),
 			"azuredevops_storage_key":                    graph.DataStorageKey(),
 			"azuredevops_team":                           core.DataTeam(),
 			"azuredevops_teams":                          core.DataTeams(),
 			"azuredevops_user":                           graph.DataUser(),
 			"azuredevops_users":                          graph.DataUsers(),
 			"azuredevops_variable_group":                 taskagent.DataVariableGroup(),
+			"azuredevops_workitem_type":                  workitemtracking.DataWorkItemType(),
+			"azuredevops_workitems":                      workitemtracking.DataWorkItems(),
 		},
 		Schema: map[string]*schema.Schema{
 			"org_service_url": {
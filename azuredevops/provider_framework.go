@@ -0,0 +1,143 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/tokens"
+)
+
+var (
+	_ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions          = &frameworkProvider{}
+)
+
+// ProviderServer - The Azure DevOps Provider served over protocol v6, muxing the SDKv2 Provider
+// together with the terraform-plugin-framework provider.
+func ProviderServer(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	sdkProvider := Provider()
+
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, fmt.Errorf("upgrading the SDKv2 provider to protocol v6: %+v", err)
+	}
+
+	// The SDKv2 provider has to be listed first, the servers are configured in order and the
+	// framework provider reuses the client configured by the SDKv2 provider.
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer { return upgradedSdkServer },
+		providerserver.NewProtocol6(NewFrameworkProvider(sdkProvider)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("muxing the SDKv2 and framework providers: %+v", err)
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// NewFrameworkProvider - The terraform-plugin-framework half of the Azure DevOps Provider. New resources
+// which need framework features are added here. It shares the provider configuration with sdkProvider.
+func NewFrameworkProvider(sdkProvider *schema.Provider) fwprovider.Provider {
+	return &frameworkProvider{
+		sdkProvider: sdkProvider,
+	}
+}
+
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "azuredevops"
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	// The muxed servers must expose identical provider schemas, derive it from the SDKv2 provider.
+	attributes := make(map[string]fwschema.Attribute, len(p.sdkProvider.Schema))
+	for name, s := range p.sdkProvider.Schema {
+		attribute, err := frameworkProviderAttribute(s)
+		if err != nil {
+			resp.Diagnostics.AddError("Converting the provider schema", fmt.Sprintf("%s: %+v", name, err))
+			return
+		}
+		attributes[name] = attribute
+	}
+	resp.Schema = fwschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	clients, ok := p.sdkProvider.Meta().(*client.AggregatedClient)
+	if !ok || clients == nil {
+		resp.Diagnostics.AddError("Configuring the provider", "The Azure DevOps client has not been configured by the SDKv2 provider")
+		return
+	}
+
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
+	resp.EphemeralResourceData = clients
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		tokens.NewPersonalAccessTokenEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return nil
+}
+
+func frameworkProviderAttribute(s *schema.Schema) (fwschema.Attribute, error) {
+	switch s.Type {
+	case schema.TypeString:
+		return fwschema.StringAttribute{
+			Required:           s.Required,
+			Optional:           s.Optional,
+			Sensitive:          s.Sensitive,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+	case schema.TypeBool:
+		return fwschema.BoolAttribute{
+			Required:           s.Required,
+			Optional:           s.Optional,
+			Sensitive:          s.Sensitive,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+		}, nil
+	case schema.TypeList:
+		if elem, ok := s.Elem.(*schema.Schema); ok && elem.Type == schema.TypeString {
+			return fwschema.ListAttribute{
+				ElementType:        types.StringType,
+				Required:           s.Required,
+				Optional:           s.Optional,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported provider schema type %s", s.Type)
+}
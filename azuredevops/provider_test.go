@@ -1,9 +1,13 @@
 package azuredevops_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops"
 	"github.com/stretchr/testify/require"
 )
@@ -12,6 +16,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 	expectedResources := []string{
 		"azuredevops_agent_pool",
 		"azuredevops_agent_queue",
+		"azuredevops_agent_settings",
 		"azuredevops_area_permissions",
 		"azuredevops_branch_policy_auto_reviewers",
 		"azuredevops_branch_policy_build_validation",
@@ -25,17 +30,25 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_build_folder",
 		"azuredevops_build_folder_permissions",
 		"azuredevops_check_approval",
+		"azuredevops_check_azure_function",
+		"azuredevops_check_azure_monitor_alerts",
 		"azuredevops_check_branch_control",
 		"azuredevops_check_business_hours",
+		"azuredevops_check_evaluate_artifact",
 		"azuredevops_check_exclusive_lock",
 		"azuredevops_check_required_template",
 		"azuredevops_check_rest_api",
 		"azuredevops_dashboard",
+		"azuredevops_delivery_plan",
+		"azuredevops_delivery_plan_permissions",
+		"azuredevops_deployment_group",
+		"azuredevops_deployment_target_tags",
 		"azuredevops_elastic_pool",
 		"azuredevops_environment",
 		"azuredevops_environment_resource_kubernetes",
 		"azuredevops_extension",
 		"azuredevops_feed",
+		"azuredevops_feed_package_version",
 		"azuredevops_feed_permission",
 		"azuredevops_feed_retention_policy",
 		"azuredevops_git_permissions",
@@ -74,6 +87,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_checkmarx_one",
 		"azuredevops_serviceendpoint_checkmarx_sca",
 		"azuredevops_serviceendpoint_checkmarx_sast",
+		"azuredevops_serviceendpoint_custom",
 		"azuredevops_serviceendpoint_dockerregistry",
 		"azuredevops_serviceendpoint_dynamics_lifecycle_services",
 		"azuredevops_serviceendpoint_externaltfs",
@@ -99,25 +113,32 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_permissions",
 		"azuredevops_serviceendpoint_runpipeline",
 		"azuredevops_serviceendpoint_servicefabric",
+		"azuredevops_serviceendpoint_share",
 		"azuredevops_serviceendpoint_snyk",
 		"azuredevops_serviceendpoint_sonarcloud",
 		"azuredevops_serviceendpoint_sonarqube",
 		"azuredevops_serviceendpoint_ssh",
 		"azuredevops_serviceendpoint_visualstudiomarketplace",
+		"azuredevops_servicehook_azure_service_bus",
 		"azuredevops_servicehook_permissions",
 		"azuredevops_servicehook_storage_queue_pipelines",
 		"azuredevops_servicehook_subscription",
 		"azuredevops_service_principal_entitlement",
+		"azuredevops_servicehook_webhook",
 		"azuredevops_tagging_permissions",
+		"azuredevops_task_group",
 		"azuredevops_team",
 		"azuredevops_team_administrators",
+		"azuredevops_team_board",
 		"azuredevops_team_members",
+		"azuredevops_team_settings",
 		"azuredevops_user_entitlement",
 		"azuredevops_variable_group",
 		"azuredevops_variable_group_permissions",
 		"azuredevops_wiki",
 		"azuredevops_wiki_page",
 		"azuredevops_workitem",
+		"azuredevops_workitem_template",
 		"azuredevops_workitemquery_permissions",
 	}
 
@@ -135,12 +156,19 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_agent_pool",
 		"azuredevops_agent_pools",
 		"azuredevops_agent_queue",
+		"azuredevops_agents",
 		"azuredevops_area",
 		"azuredevops_build_definition",
+		"azuredevops_checks",
 		"azuredevops_client_config",
+		"azuredevops_deleted_projects",
+		"azuredevops_deployment_group_targets",
 		"azuredevops_descriptor",
 		"azuredevops_environment",
 		"azuredevops_feed",
+		"azuredevops_feed_package",
+		"azuredevops_feed_packages",
+		"azuredevops_git_deleted_repositories",
 		"azuredevops_git_repositories",
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_file",
@@ -154,6 +182,7 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_project",
 		"azuredevops_projects",
 		"azuredevops_securityrole_definitions",
+		"azuredevops_serviceendpoint",
 		"azuredevops_serviceendpoint_azurecr",
 		"azuredevops_serviceendpoint_azurerm",
 		"azuredevops_serviceendpoint_bitbucket",
@@ -161,6 +190,9 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_serviceendpoint_github",
 		"azuredevops_serviceendpoint_npm",
 		"azuredevops_serviceendpoint_sonarcloud",
+		"azuredevops_serviceendpoint_usage",
+		"azuredevops_serviceendpoints",
+		"azuredevops_servicehook_metadata",
 		"azuredevops_storage_key",
 		"azuredevops_service_principal",
 		"azuredevops_team",
@@ -168,6 +200,8 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_user",
 		"azuredevops_users",
 		"azuredevops_variable_group",
+		"azuredevops_workitem_type",
+		"azuredevops_workitems",
 	}
 
 	dataSources := azuredevops.Provider().DataSourcesMap
@@ -219,3 +253,89 @@ func TestProvider_SchemaIsValid(t *testing.T) {
 		require.Equal(t, test.required, schema[test.name].Required, "A property in the schema has an incorrect required value")
 	}
 }
+
+func TestProvider_HasEphemeralResources(t *testing.T) {
+	expectedEphemeralResources := []string{
+		"azuredevops_personal_access_token",
+	}
+
+	providerServer, err := azuredevops.ProviderServer(context.Background())
+	require.NoError(t, err)
+
+	providerSchema, err := providerServer().GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, providerSchema.Diagnostics)
+
+	for _, name := range expectedEphemeralResources {
+		require.Contains(t, providerSchema.EphemeralResourceSchemas, name, "An expected ephemeral resource was not registered")
+		require.NotNil(t, providerSchema.EphemeralResourceSchemas[name], "An ephemeral resource cannot have a nil schema")
+	}
+	require.Equal(t, len(expectedEphemeralResources), len(providerSchema.EphemeralResourceSchemas), "There are an unexpected number of registered ephemeral resources")
+}
+
+func TestProvider_MuxServerServesBothProviders(t *testing.T) {
+	providerServer, err := azuredevops.ProviderServer(context.Background())
+	require.NoError(t, err)
+	server := providerServer()
+
+	// The provider schemas of both servers must be identical, the mux server errors out otherwise.
+	providerSchema, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, providerSchema.Diagnostics)
+	require.Equal(t, len(azuredevops.Provider().Schema), len(providerSchema.Provider.Block.Attributes))
+	require.Equal(t, len(azuredevops.Provider().ResourcesMap), len(providerSchema.ResourceSchemas), "The SDKv2 resources must be served unchanged")
+	require.Equal(t, len(azuredevops.Provider().DataSourcesMap), len(providerSchema.DataSourceSchemas), "The SDKv2 data sources must be served unchanged")
+
+	metadata, err := server.GetMetadata(context.Background(), &tfprotov6.GetMetadataRequest{})
+	require.NoError(t, err)
+	require.Empty(t, metadata.Diagnostics)
+
+	// SDKv2 half
+	projectType := providerSchema.ResourceSchemas["azuredevops_project"].ValueType().(tftypes.Object)
+	projectValues := map[string]tftypes.Value{}
+	for name, attrType := range projectType.AttributeTypes {
+		projectValues[name] = tftypes.NewValue(attrType, nil)
+	}
+	projectValues["name"] = tftypes.NewValue(tftypes.String, "example")
+	projectConfig, err := tfprotov6.NewDynamicValue(projectType, tftypes.NewValue(projectType, projectValues))
+	require.NoError(t, err)
+	validateResource, err := server.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: "azuredevops_project",
+		Config:   &projectConfig,
+	})
+	require.NoError(t, err)
+	require.Empty(t, validateResource.Diagnostics)
+
+	// framework half
+	tokenType := providerSchema.EphemeralResourceSchemas["azuredevops_personal_access_token"].ValueType().(tftypes.Object)
+	tokenValues := map[string]tftypes.Value{}
+	for name, attrType := range tokenType.AttributeTypes {
+		tokenValues[name] = tftypes.NewValue(attrType, nil)
+	}
+	tokenValues["display_name"] = tftypes.NewValue(tftypes.String, "example")
+	tokenValues["scope"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{})
+	tokenConfig, err := tfprotov6.NewDynamicValue(tokenType, tftypes.NewValue(tokenType, tokenValues))
+	require.NoError(t, err)
+	validateEphemeral, err := server.ValidateEphemeralResourceConfig(context.Background(), &tfprotov6.ValidateEphemeralResourceConfigRequest{
+		TypeName: "azuredevops_personal_access_token",
+		Config:   &tokenConfig,
+	})
+	require.NoError(t, err)
+	require.Len(t, validateEphemeral.Diagnostics, 1)
+	require.Contains(t, validateEphemeral.Diagnostics[0].Detail, "at least one scope")
+}
+
+func TestProvider_FrameworkProviderRequiresSDKv2Configuration(t *testing.T) {
+	server := providerserver.NewProtocol6(azuredevops.NewFrameworkProvider(azuredevops.Provider()))()
+
+	providerSchema, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, providerSchema.Diagnostics)
+
+	config, err := tfprotov6.NewDynamicValue(providerSchema.Provider.ValueType(), tftypes.NewValue(providerSchema.Provider.ValueType(), nil))
+	require.NoError(t, err)
+	resp, err := server.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{Config: &config})
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	require.Contains(t, resp.Diagnostics[0].Detail, "has not been configured by the SDKv2 provider")
+}
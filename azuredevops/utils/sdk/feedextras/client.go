@@ -0,0 +1,63 @@
+// This file contains feed APIs that are incomplete in github.com/microsoft/azure-devops-go-api/azuredevops/feed/client.go
+// The existing version does not return the upstream behavior of the feed.
+
+// This file cannot be under "internal", because azdosdkmocks/feedextras_sdk_mock.go depends on it.
+
+package feedextras
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+)
+
+var feedsLocationId, _ = uuid.Parse("c65009a7-474a-4ad1-8b42-7d852107ef8c") //nolint:errcheck
+
+type Client interface {
+	// [Preview API] Get the settings for a specific feed, including its upstream behavior
+	GetFeed(context.Context, feed.GetFeedArgs) (*Feed, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, feed.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Get the settings for a specific feed, including its upstream behavior
+func (client *ClientImpl) GetFeed(ctx context.Context, args feed.GetFeedArgs) (*Feed, error) {
+	routeValues := make(map[string]string)
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+	if args.FeedId == nil || *args.FeedId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.FeedId"}
+	}
+	routeValues["feedId"] = *args.FeedId
+
+	queryParams := url.Values{}
+	if args.IncludeDeletedUpstreams != nil {
+		queryParams.Add("includeDeletedUpstreams", strconv.FormatBool(*args.IncludeDeletedUpstreams))
+	}
+	resp, err := client.Client.Send(ctx, http.MethodGet, feedsLocationId, "7.1-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue Feed
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
@@ -0,0 +1,17 @@
+// This file contains feed models that are incomplete in github.com/microsoft/azure-devops-go-api/azuredevops/feed/models.go
+// The feed is generated without the upstream behavior, which is only part of the feed update.
+
+// This file cannot be under "internal", because azdosdkmocks/feedextras_sdk_mock.go depends on it.
+
+package feedextras
+
+import (
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+)
+
+// Feed a feed together with its upstream behavior
+type Feed struct {
+	feed.Feed
+	// If set, the feed allows package names to conflict with the names of packages in its upstream sources.
+	AllowUpstreamNameConflict *bool `json:"allowUpstreamNameConflict,omitempty"`
+}
@@ -0,0 +1,105 @@
+// This file contains task agent APIs that are missing from github.com/microsoft/azure-devops-go-api/azuredevops/taskagent/client.go
+
+// This file cannot be under "internal", because azdosdkmocks/taskagentextras_sdk_mock.go depends on it.
+
+package taskagentextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+)
+
+var ResourceAreaId, _ = uuid.Parse("a85b8835-c1a1-4aac-ae97-1c3d0ba72dbd") //nolint:errcheck
+
+type Client interface {
+	// [Preview API] Update the properties of a task group, e.g. publish a preview version of the task group.
+	UpdateTaskGroupProperties(context.Context, UpdateTaskGroupPropertiesArgs) (*[]taskagent.TaskGroup, error)
+	// Replace the user-defined capabilities of an agent.
+	UpdateAgentUserCapabilities(context.Context, UpdateAgentUserCapabilitiesArgs) (*taskagent.TaskAgent, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Update the properties of a task group
+func (client *ClientImpl) UpdateTaskGroupProperties(ctx context.Context, args UpdateTaskGroupPropertiesArgs) (*[]taskagent.TaskGroup, error) {
+	if args.TaskGroupUpdateProperties == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.TaskGroupUpdateProperties"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.TaskGroupId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.TaskGroupId"}
+	}
+	routeValues["taskGroupId"] = (*args.TaskGroupId).String()
+
+	queryParams := url.Values{}
+	if args.DisablePriorVersions != nil {
+		queryParams.Add("disablePriorVersions", strconv.FormatBool(*args.DisablePriorVersions))
+	}
+	body, marshalErr := json.Marshal(*args.TaskGroupUpdateProperties)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("6c08ffbf-dbf1-4f9a-94e5-a1cbd47005e7") //nolint:errcheck
+	resp, err := client.Client.Send(ctx, http.MethodPatch, locationId, "7.1-preview.1", routeValues, queryParams, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []taskagent.TaskGroup
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Replace the user-defined capabilities of an agent
+func (client *ClientImpl) UpdateAgentUserCapabilities(ctx context.Context, args UpdateAgentUserCapabilitiesArgs) (*taskagent.TaskAgent, error) {
+	if args.UserCapabilities == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.UserCapabilities"}
+	}
+	routeValues := make(map[string]string)
+	if args.PoolId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.PoolId"}
+	}
+	routeValues["poolId"] = strconv.Itoa(*args.PoolId)
+	if args.AgentId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.AgentId"}
+	}
+	routeValues["agentId"] = strconv.Itoa(*args.AgentId)
+
+	body, marshalErr := json.Marshal(*args.UserCapabilities)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("30ba3ada-fedf-4da8-bbb5-dacf2f82e176") //nolint:errcheck
+	resp, err := client.Client.Send(ctx, http.MethodPut, locationId, "7.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.TaskAgent
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
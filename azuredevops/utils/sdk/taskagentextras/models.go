@@ -0,0 +1,32 @@
+// This file contains task agent models that are missing from github.com/microsoft/azure-devops-go-api/azuredevops/taskagent/models.go
+
+// This file cannot be under "internal", because azdosdkmocks/taskagentextras_sdk_mock.go depends on it.
+
+package taskagentextras
+
+import (
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+)
+
+// Arguments for the UpdateTaskGroupProperties function
+type UpdateTaskGroupPropertiesArgs struct {
+	// (required) Task group properties to update, e.g. the preview state of a version.
+	TaskGroupUpdateProperties *taskagent.TaskGroupPublishPreviewParameter
+	// (required) Project ID or project name
+	Project *string
+	// (required) Id of the task group.
+	TaskGroupId *uuid.UUID
+	// (optional) 'true' to disable all previous versions of the task group.
+	DisablePriorVersions *bool
+}
+
+// Arguments for the UpdateAgentUserCapabilities function
+type UpdateAgentUserCapabilitiesArgs struct {
+	// (required) The user-defined capabilities, replacing the existing ones.
+	UserCapabilities *map[string]string
+	// (required) The agent pool containing the agent
+	PoolId *int
+	// (required) The agent to update
+	AgentId *int
+}
@@ -0,0 +1,103 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+)
+
+// The PAT lifecycle management API is not part of the Go SDK, the client is generated based on the
+// API: https://vssps.dev.azure.com/<orgName>/_apis/tokens/pats
+
+const (
+	baseUrl    = "https://vssps.dev.azure.com/%s/_apis/tokens/pats"
+	apiVersion = "7.1-preview.1"
+)
+
+type Client interface {
+	// [Preview API] Create a new personal access token for the calling user.
+	CreatePersonalAccessToken(context.Context, CreatePersonalAccessTokenArgs) (*PatTokenResult, error)
+	// [Preview API] Revoke a personal access token of the calling user.
+	RevokePersonalAccessToken(context.Context, RevokePersonalAccessTokenArgs) error
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client: *client,
+	}
+}
+
+// Arguments for the CreatePersonalAccessToken function
+type CreatePersonalAccessTokenArgs struct {
+	// (required) Name of the organization the token is issued in
+	OrganizationName *string
+	// (required) Properties of the token to create
+	Token *PatTokenCreateRequest
+}
+
+func (c *ClientImpl) CreatePersonalAccessToken(ctx context.Context, args CreatePersonalAccessTokenArgs) (*PatTokenResult, error) {
+	if args.OrganizationName == nil || *args.OrganizationName == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.OrganizationName"}
+	}
+	if args.Token == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Token"}
+	}
+
+	body, err := json.Marshal(args.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	fullUrl := fmt.Sprintf(baseUrl, url.PathEscape(*args.OrganizationName))
+	req, err := c.Client.CreateRequestMessage(ctx, http.MethodPost, fullUrl, apiVersion, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Client.SendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue PatTokenResult
+	err = c.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the RevokePersonalAccessToken function
+type RevokePersonalAccessTokenArgs struct {
+	// (required) Name of the organization the token was issued in
+	OrganizationName *string
+	// (required) Authorization ID of the token to revoke
+	AuthorizationId *string
+}
+
+func (c *ClientImpl) RevokePersonalAccessToken(ctx context.Context, args RevokePersonalAccessTokenArgs) error {
+	if args.OrganizationName == nil || *args.OrganizationName == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.OrganizationName"}
+	}
+	if args.AuthorizationId == nil || *args.AuthorizationId == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.AuthorizationId"}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("authorizationId", *args.AuthorizationId)
+	fullUrl := fmt.Sprintf(baseUrl, url.PathEscape(*args.OrganizationName)) + "?" + queryParams.Encode()
+	req, err := c.Client.CreateRequestMessage(ctx, http.MethodDelete, fullUrl, apiVersion, nil, "", "application/json", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Client.SendRequest(req)
+	return err
+}
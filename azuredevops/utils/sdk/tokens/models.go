@@ -0,0 +1,28 @@
+package tokens
+
+import "github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+
+// PatTokenCreateRequest describes a personal access token to create.
+type PatTokenCreateRequest struct {
+	AllOrgs     *bool             `json:"allOrgs,omitempty"`
+	DisplayName *string           `json:"displayName,omitempty"`
+	Scope       *string           `json:"scope,omitempty"`
+	ValidTo     *azuredevops.Time `json:"validTo,omitempty"`
+}
+
+// PatToken is a personal access token. Token is only returned when the token is created.
+type PatToken struct {
+	AuthorizationId *string           `json:"authorizationId,omitempty"`
+	DisplayName     *string           `json:"displayName,omitempty"`
+	Scope           *string           `json:"scope,omitempty"`
+	TargetAccounts  *[]string         `json:"targetAccounts,omitempty"`
+	Token           *string           `json:"token,omitempty"`
+	ValidFrom       *azuredevops.Time `json:"validFrom,omitempty"`
+	ValidTo         *azuredevops.Time `json:"validTo,omitempty"`
+}
+
+// PatTokenResult is the result of a personal access token operation.
+type PatTokenResult struct {
+	PatToken      *PatToken `json:"patToken,omitempty"`
+	PatTokenError *string   `json:"patTokenError,omitempty"`
+}
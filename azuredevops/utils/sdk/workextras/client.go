@@ -0,0 +1,254 @@
+// This file contains work APIs that are missing from github.com/microsoft/azure-devops-go-api/azuredevops/work/client.go
+// The existing version neither exposes the ETag of the board columns and rows nor models the card settings.
+
+// This file cannot be under "internal", because azdosdkmocks/workextras_sdk_mock.go depends on it.
+
+package workextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+)
+
+var ResourceAreaId, _ = uuid.Parse("1d4f49f9-02b9-4e26-b826-2cdb6195f2a9") //nolint:errcheck
+
+var (
+	boardColumnsLocationId, _          = uuid.Parse("c555d7ff-84e1-47df-9923-a3fe0cd8751b") //nolint:errcheck
+	boardRowsLocationId, _             = uuid.Parse("0863355d-aefd-4d63-8669-984c9b7b0e78") //nolint:errcheck
+	boardCardSettingsLocationId, _     = uuid.Parse("07c3b467-bc60-4f05-8e34-599ce288fafc") //nolint:errcheck
+	boardCardRuleSettingsLocationId, _ = uuid.Parse("b044a3d9-02ea-49c7-91a1-b730949cc896") //nolint:errcheck
+)
+
+type Client interface {
+	// [Preview API] Get columns on a board together with their ETag
+	GetBoardColumns(context.Context, GetBoardColumnsArgs) (*BoardColumns, error)
+	// [Preview API] Update columns on a board, guarded by the ETag if provided
+	UpdateBoardColumns(context.Context, UpdateBoardColumnsArgs) (*[]work.BoardColumn, error)
+	// [Preview API] Get rows on a board together with their ETag
+	GetBoardRows(context.Context, GetBoardRowsArgs) (*BoardRows, error)
+	// [Preview API] Update rows on a board, guarded by the ETag if provided
+	UpdateBoardRows(context.Context, UpdateBoardRowsArgs) (*[]work.BoardRow, error)
+	// [Preview API] Get board card settings for the board id or board by name
+	GetBoardCardSettings(context.Context, GetBoardCardSettingsArgs) (*BoardCardSettings, error)
+	// [Preview API] Update board card settings for the board id or board by name
+	UpdateBoardCardSettings(context.Context, UpdateBoardCardSettingsArgs) (*BoardCardSettings, error)
+	// [Preview API] Get board card Rule settings for the board id or board by name
+	GetBoardCardRuleSettings(context.Context, GetBoardCardRuleSettingsArgs) (*BoardCardRuleSettings, error)
+	// [Preview API] Update board card Rule settings for the board id or board by name
+	UpdateBoardCardRuleSettings(context.Context, UpdateBoardCardRuleSettingsArgs) (*BoardCardRuleSettings, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+func boardRouteValues(project *string, team *string, board *string) (map[string]string, error) {
+	routeValues := make(map[string]string)
+	if project == nil || *project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *project
+	if team != nil && *team != "" {
+		routeValues["team"] = *team
+	}
+	if board == nil || *board == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Board"}
+	}
+	routeValues["board"] = *board
+	return routeValues, nil
+}
+
+func ifMatchHeader(etag *string) map[string]string {
+	if etag == nil || *etag == "" {
+		return nil
+	}
+	return map[string]string{"If-Match": *etag}
+}
+
+func responseETag(resp *http.Response) *string {
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		return nil
+	}
+	return &etag
+}
+
+// [Preview API] Get columns on a board together with their ETag
+func (client *ClientImpl) GetBoardColumns(ctx context.Context, args GetBoardColumnsArgs) (*BoardColumns, error) {
+	routeValues, err := boardRouteValues(args.Project, args.Team, args.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, boardColumnsLocationId, "7.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []work.BoardColumn
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &BoardColumns{Columns: &responseValue, ETag: responseETag(resp)}, err
+}
+
+// [Preview API] Update columns on a board, guarded by the ETag if provided
+func (client *ClientImpl) UpdateBoardColumns(ctx context.Context, args UpdateBoardColumnsArgs) (*[]work.BoardColumn, error) {
+	if args.BoardColumns == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.BoardColumns"}
+	}
+	routeValues, err := boardRouteValues(args.Project, args.Team, args.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	body, marshalErr := json.Marshal(*args.BoardColumns)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPut, boardColumnsLocationId, "7.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", ifMatchHeader(args.ETag))
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []work.BoardColumn
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// [Preview API] Get rows on a board together with their ETag
+func (client *ClientImpl) GetBoardRows(ctx context.Context, args GetBoardRowsArgs) (*BoardRows, error) {
+	routeValues, err := boardRouteValues(args.Project, args.Team, args.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, boardRowsLocationId, "7.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []work.BoardRow
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &BoardRows{Rows: &responseValue, ETag: responseETag(resp)}, err
+}
+
+// [Preview API] Update rows on a board, guarded by the ETag if provided
+func (client *ClientImpl) UpdateBoardRows(ctx context.Context, args UpdateBoardRowsArgs) (*[]work.BoardRow, error) {
+	if args.BoardRows == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.BoardRows"}
+	}
+	routeValues, err := boardRouteValues(args.Project, args.Team, args.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	body, marshalErr := json.Marshal(*args.BoardRows)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPut, boardRowsLocationId, "7.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", ifMatchHeader(args.ETag))
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []work.BoardRow
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// [Preview API] Get board card settings for the board id or board by name
+func (client *ClientImpl) GetBoardCardSettings(ctx context.Context, args GetBoardCardSettingsArgs) (*BoardCardSettings, error) {
+	routeValues, err := boardRouteValues(args.Project, args.Team, args.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, boardCardSettingsLocationId, "7.1-preview.2", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue BoardCardSettings
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// [Preview API] Update board card settings for the board id or board by name
+func (client *ClientImpl) UpdateBoardCardSettings(ctx context.Context, args UpdateBoardCardSettingsArgs) (*BoardCardSettings, error) {
+	if args.BoardCardSettingsToSave == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.BoardCardSettingsToSave"}
+	}
+	routeValues, err := boardRouteValues(args.Project, args.Team, args.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	body, marshalErr := json.Marshal(*args.BoardCardSettingsToSave)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPut, boardCardSettingsLocationId, "7.1-preview.2", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue BoardCardSettings
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// [Preview API] Get board card Rule settings for the board id or board by name
+func (client *ClientImpl) GetBoardCardRuleSettings(ctx context.Context, args GetBoardCardRuleSettingsArgs) (*BoardCardRuleSettings, error) {
+	routeValues, err := boardRouteValues(args.Project, args.Team, args.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, boardCardRuleSettingsLocationId, "7.1-preview.2", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue BoardCardRuleSettings
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// [Preview API] Update board card Rule settings for the board id or board by name
+func (client *ClientImpl) UpdateBoardCardRuleSettings(ctx context.Context, args UpdateBoardCardRuleSettingsArgs) (*BoardCardRuleSettings, error) {
+	if args.BoardCardRuleSettings == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.BoardCardRuleSettings"}
+	}
+	routeValues, err := boardRouteValues(args.Project, args.Team, args.Board)
+	if err != nil {
+		return nil, err
+	}
+
+	body, marshalErr := json.Marshal(*args.BoardCardRuleSettings)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPatch, boardCardRuleSettingsLocationId, "7.1-preview.2", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue BoardCardRuleSettings
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
@@ -0,0 +1,138 @@
+// This file contains work models that are incomplete in github.com/microsoft/azure-devops-go-api/azuredevops/work/models.go
+// The card field settings and the card rule settings are generated as empty structs.
+
+// This file cannot be under "internal", because azdosdkmocks/workextras_sdk_mock.go depends on it.
+
+package workextras
+
+import (
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+)
+
+// BoardCardSettings the fields shown on the cards by work item type. Each field setting is a set of attributes,
+// e.g. `fieldIdentifier` and `displayFormat`, or a card level setting such as `showEmptyFields`.
+type BoardCardSettings struct {
+	Cards *map[string][]map[string]interface{} `json:"cards,omitempty"`
+}
+
+// BoardCardRuleSettings the card rules by rule type, e.g. `fill` for card styles and `tagStyle` for tag colors
+type BoardCardRuleSettings struct {
+	Links interface{}        `json:"_links,omitempty"`
+	Rules *map[string][]Rule `json:"rules,omitempty"`
+	Url   *string            `json:"url,omitempty"`
+}
+
+type Rule struct {
+	Clauses   *[]work.FilterClause `json:"clauses,omitempty"`
+	Filter    *string              `json:"filter,omitempty"`
+	IsEnabled *string              `json:"isEnabled,omitempty"`
+	Name      *string              `json:"name,omitempty"`
+	Settings  *map[string]string   `json:"settings,omitempty"`
+}
+
+// BoardColumns the columns of a board and the ETag to update them with
+type BoardColumns struct {
+	Columns *[]work.BoardColumn
+	ETag    *string
+}
+
+// BoardRows the rows of a board and the ETag to update them with
+type BoardRows struct {
+	Rows *[]work.BoardRow
+	ETag *string
+}
+
+type GetBoardColumnsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name or ID of the specific board
+	Board *string
+	// (optional) Team ID or team name
+	Team *string
+}
+
+type UpdateBoardColumnsArgs struct {
+	// (required) List of board columns to update
+	BoardColumns *[]work.BoardColumn
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name or ID of the specific board
+	Board *string
+	// (optional) Team ID or team name
+	Team *string
+	// (optional) The ETag of the columns, the update fails if the columns changed in the meantime
+	ETag *string
+}
+
+type GetBoardRowsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name or ID of the specific board
+	Board *string
+	// (optional) Team ID or team name
+	Team *string
+}
+
+type UpdateBoardRowsArgs struct {
+	// (required) List of board rows to update
+	BoardRows *[]work.BoardRow
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name or ID of the specific board
+	Board *string
+	// (optional) Team ID or team name
+	Team *string
+	// (optional) The ETag of the rows, the update fails if the rows changed in the meantime
+	ETag *string
+}
+
+type GetBoardCardSettingsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name or ID of the specific board
+	Board *string
+	// (optional) Team ID or team name
+	Team *string
+}
+
+type UpdateBoardCardSettingsArgs struct {
+	// (required)
+	BoardCardSettingsToSave *BoardCardSettings
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name or ID of the specific board
+	Board *string
+	// (optional) Team ID or team name
+	Team *string
+}
+
+type GetBoardCardRuleSettingsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name or ID of the specific board
+	Board *string
+	// (optional) Team ID or team name
+	Team *string
+}
+
+type UpdateBoardCardRuleSettingsArgs struct {
+	// (required)
+	BoardCardRuleSettings *BoardCardRuleSettings
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name or ID of the specific board
+	Board *string
+	// (optional) Team ID or team name
+	Team *string
+}
+
+// DeliveryViewPropertyCollection the properties of a delivery plan. Unlike work.DeliveryViewPropertyCollection the card
+// style rules keep their settings.
+type DeliveryViewPropertyCollection struct {
+	CardSettings        *work.CardSettings         `json:"cardSettings,omitempty"`
+	Criteria            *[]work.FilterClause       `json:"criteria,omitempty"`
+	Markers             *[]work.Marker             `json:"markers,omitempty"`
+	StyleSettings       *[]Rule                    `json:"styleSettings,omitempty"`
+	TagStyleSettings    *[]Rule                    `json:"tagStyleSettings,omitempty"`
+	TeamBacklogMappings *[]work.TeamBacklogMapping `json:"teamBacklogMappings,omitempty"`
+}